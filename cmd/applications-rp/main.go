@@ -111,10 +111,11 @@ func main() {
 		hostingSvc = append(hostingSvc, data.NewEmbeddedETCDService(data.EmbeddedETCDServiceOptions{ClientConfigSink: client}))
 	}
 
-	builders, err := builders(options)
+	config, err := controllerconfig.New(options)
 	if err != nil {
 		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
 	}
+	builders := builders(config, options.Config.SoftDelete.Enabled)
 
 	hostingSvc = append(
 		hostingSvc,
@@ -128,6 +129,14 @@ func main() {
 		pr_backend.NewService(prOptions),
 	)
 
+	if options.Config.SoftDelete.Enabled {
+		hostingSvc = append(hostingSvc, server.NewPurgerService(options, config.ResourceClient))
+	}
+
+	if prOptions.Config.DriftDetection.Enabled {
+		hostingSvc = append(hostingSvc, server.NewDriftCheckerService(prOptions))
+	}
+
 	tracerOpts := options.Config.TracerProvider
 	tracerOpts.ServiceName = serviceName
 	hostingSvc = append(hostingSvc, &trace.Service{Options: tracerOpts})
@@ -149,14 +158,9 @@ func main() {
 	}
 }
 
-func builders(options hostoptions.HostOptions) ([]builder.Builder, error) {
-	config, err := controllerconfig.New(options)
-	if err != nil {
-		return nil, err
-	}
-
+func builders(config *controllerconfig.RecipeControllerConfig, softDelete bool) []builder.Builder {
 	return []builder.Builder{
-		corerp_setup.SetupNamespace(config).GenerateBuilder(),
+		corerp_setup.SetupNamespace(config, softDelete).GenerateBuilder(),
 		// Add resource provider builders...
-	}, nil
+	}
 }
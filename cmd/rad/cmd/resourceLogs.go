@@ -24,16 +24,24 @@ import (
 	"os"
 	"strings"
 
+	"time"
+
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
 	"github.com/spf13/cobra"
 )
 
+// latestOperation is the value of the '--operation' option which selects the latest asynchronous operation on the resource.
+const latestOperation = "latest"
+
 var resourceLogsCmd = &cobra.Command{
 	Use:   "logs [resource]",
-	Short: "Read logs from a running containers resource",
+	Short: "Read logs from a running containers resource or from an operation on a resource",
 	Long: `Reads logs from a running resource. Currently only supports the resource type 'Applications.Core/containers'.
 This command allows you to access logs of a deployed application and output those logs to the local console.
 
@@ -41,7 +49,9 @@ This command allows you to access logs of a deployed application and output thos
 
 'rad resource logs' will output logs from the resource's primary container. In scenarios like Dapr where multiple containers are in use, the '--container \<name\>' option can specify the desired container.
 
-Specify the '--follow' option to stream additional logs as they are emitted by the resource. When following, press CTRL+C to exit the command and terminate the stream.`,
+Specify the '--follow' option to stream additional logs as they are emitted by the resource. When following, press CTRL+C to exit the command and terminate the stream.
+
+Specify the '--operation \<id\>' option to read the execution log of an operation on the resource instead, such as the output of the recipe run when the resource was deployed or deleted. The ID of an operation is listed by 'rad resource history'. '--operation latest' selects the latest asynchronous operation on the resource, since synchronous operations have no log. The log of an operation is available for every resource type, and '--follow' streams it until the operation completes.`,
 	Example: `# read logs from the 'webapp' resource of the current default app
 rad resource logs containers webapp
 
@@ -52,7 +62,13 @@ rad resource logs containers orders --application icecream-store
 rad resource logs containers orders --application icecream-store --follow
 
 # read logs from the 'daprd' sidecar container of the 'orders' resource of the 'icecream-store' application
-rad resource logs containers orders --application icecream-store --container daprd`,
+rad resource logs containers orders --application icecream-store --container daprd
+
+# read the recipe log of the latest asynchronous operation on the 'cache' redisCaches resource
+rad resource logs redisCaches cache --operation latest
+
+# stream the log of a running operation on the 'cache' redisCaches resource
+rad resource logs redisCaches cache --operation 3f4a8d9e-2c1b-4f7a-9e5d-6b8c7a1d2e3f --follow`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		workspace, err := cli.RequireWorkspace(cmd, ConfigFromContext(cmd.Context()), DirectoryConfigFromContext(cmd.Context()))
 		if err != nil {
@@ -65,6 +81,19 @@ rad resource logs containers orders --application icecream-store --container dap
 		}
 		workspace.Scope = scope
 
+		follow, err := cmd.Flags().GetBool("follow")
+		if err != nil {
+			return err
+		}
+
+		operation, err := cmd.Flags().GetString("operation")
+		if err != nil {
+			return err
+		}
+		if operation != "" {
+			return showOperationLogs(cmd.Context(), *workspace, args, operation, follow)
+		}
+
 		application, err := cli.RequireApplication(cmd, *workspace)
 		if err != nil {
 			return err
@@ -77,10 +106,6 @@ rad resource logs containers orders --application icecream-store --container dap
 		if !strings.EqualFold(resourceType, ContainerType) {
 			return fmt.Errorf("only %s is supported", ContainerType)
 		}
-		follow, err := cmd.Flags().GetBool("follow")
-		if err != nil {
-			return err
-		}
 
 		container, err := cmd.Flags().GetString("container")
 		if err != nil {
@@ -177,10 +202,78 @@ func captureLogs(info clients.LogStream, logErrors chan<- error, follow bool) {
 	}
 }
 
+// showOperationLogs prints the execution log of an asynchronous operation on the resource. The latest asynchronous
+// operation on the resource is used if operationID is 'latest'.
+func showOperationLogs(ctx context.Context, workspace workspaces.Workspace, args []string, operationID string, follow bool) error {
+	resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := connections.DefaultFactory.CreateApplicationsManagementClient(ctx, workspace)
+	if err != nil {
+		return err
+	}
+
+	if operationID == latestOperation {
+		records, err := client.ListResourceOperationHistory(ctx, resourceType, resourceName)
+		if clients.Is404Error(err) {
+			return clierrors.Message("The resource %q of type %q was not found.", resourceName, resourceType)
+		} else if err != nil {
+			return err
+		}
+
+		latest, later := clients.LatestOperationWithLog(records)
+		if latest == nil && len(records) == 0 {
+			return clierrors.Message("The resource %q of type %q has no operations.", resourceName, resourceType)
+		} else if latest == nil {
+			return clierrors.Message("The resource %q of type %q has no operation with logs. Only asynchronous operations have logs, and its %d operations completed synchronously.", resourceName, resourceType, len(records))
+		}
+
+		operationID = latest.OperationID
+		if later > 0 {
+			fmt.Fprintf(os.Stderr, "Showing the log of operation %s, the latest asynchronous operation on the resource. The %d later operations completed synchronously and have no log.\n", operationID, later)
+		}
+	}
+
+	printEntry := func(entry oplog.Entry) {
+		fmt.Printf("%s %-5s [%s] %s\n", entry.Timestamp.Local().Format(time.RFC3339), entry.Level, entry.Source, entry.Message)
+	}
+
+	var log *oplog.Log
+	if follow {
+		// Sending to stderr so it doesn't interfere with parsing
+		fmt.Fprintf(os.Stderr, "Streaming the log of operation %s until it completes. Press CTRL+C to exit...\n", operationID)
+		log, err = clients.FollowOperationLogs(ctx, client, resourceType, operationID, printEntry)
+	} else {
+		log, err = client.GetOperationLogs(ctx, resourceType, operationID, 0)
+		if err == nil {
+			for _, entry := range log.Entries {
+				printEntry(entry)
+			}
+		}
+	}
+	if clients.Is404Error(err) {
+		return clierrors.Message("The operation %q was not found or has no logs.", operationID)
+	} else if err != nil {
+		return err
+	}
+
+	if log.DroppedEntries > 0 {
+		fmt.Fprintf(os.Stderr, "The %d earliest entries of the log were dropped because the log exceeded its maximum size.\n", log.DroppedEntries)
+	}
+	if !log.Complete {
+		fmt.Fprintln(os.Stderr, "The operation is still running. Specify '--follow' to stream the rest of its log.")
+	}
+
+	return nil
+}
+
 func init() {
 	resourceLogsCmd.Flags().String("container", "", "specify the container from which logs should be streamed")
 	resourceLogsCmd.Flags().BoolP("follow", "f", false, "specify that logs should be stream until the command is canceled")
 	resourceLogsCmd.Flags().String("replica", "", "specify the replica to collect logs from")
+	resourceLogsCmd.Flags().String("operation", "", "specify the ID of the operation on the resource to read the execution log of, or 'latest'")
 	commonflags.AddResourceGroupFlag(resourceLogsCmd)
 	resourceCmd.AddCommand(resourceLogsCmd)
 }
@@ -34,6 +34,7 @@ import (
 	app_connections "github.com/radius-project/radius/pkg/cli/cmd/app/connections"
 	app_delete "github.com/radius-project/radius/pkg/cli/cmd/app/delete"
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
+	app_restore "github.com/radius-project/radius/pkg/cli/cmd/app/restore"
 	app_show "github.com/radius-project/radius/pkg/cli/cmd/app/show"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
@@ -44,6 +45,7 @@ import (
 	env_switch "github.com/radius-project/radius/pkg/cli/cmd/env/envswitch"
 	env_list "github.com/radius-project/radius/pkg/cli/cmd/env/list"
 	"github.com/radius-project/radius/pkg/cli/cmd/env/namespace"
+	env_restore "github.com/radius-project/radius/pkg/cli/cmd/env/restore"
 	env_show "github.com/radius-project/radius/pkg/cli/cmd/env/show"
 	env_update "github.com/radius-project/radius/pkg/cli/cmd/env/update"
 	group "github.com/radius-project/radius/pkg/cli/cmd/group"
@@ -55,6 +57,8 @@ import (
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_drift "github.com/radius-project/radius/pkg/cli/cmd/resource/drift"
+	resource_history "github.com/radius-project/radius/pkg/cli/cmd/resource/history"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
 	"github.com/radius-project/radius/pkg/cli/cmd/run"
@@ -221,6 +225,12 @@ func initSubCommands() {
 	deleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(deleteCmd)
 
+	historyCmd, _ := resource_history.NewCommand(framework)
+	resourceCmd.AddCommand(historyCmd)
+
+	driftCmd, _ := resource_drift.NewCommand(framework)
+	resourceCmd.AddCommand(driftCmd)
+
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
@@ -251,6 +261,9 @@ func initSubCommands() {
 	envListCmd, _ := env_list.NewCommand(framework)
 	envCmd.AddCommand(envListCmd)
 
+	envRestoreCmd, _ := env_restore.NewCommand(framework)
+	envCmd.AddCommand(envRestoreCmd)
+
 	envShowCmd, _ := env_show.NewCommand(framework)
 	envCmd.AddCommand(envShowCmd)
 
@@ -278,6 +291,9 @@ func initSubCommands() {
 	appListCmd, _ := app_list.NewCommand(framework)
 	applicationCmd.AddCommand(appListCmd)
 
+	appRestoreCmd, _ := app_restore.NewCommand(framework)
+	applicationCmd.AddCommand(appRestoreCmd)
+
 	appShowCmd, _ := app_show.NewCommand(framework)
 	applicationCmd.AddCommand(appShowCmd)
 
@@ -39,4 +39,7 @@ type AsyncOperationStatus struct {
 
 	// Error represents the error occured during provisioning.
 	Error *ErrorDetails `json:"error,omitempty"`
+
+	// Properties represents the result of an operation that does not change the resource, such as an action.
+	Properties any `json:"properties,omitempty"`
 }
@@ -53,6 +53,9 @@ var operationMethodToHTTPMethod = map[OperationMethod]string{
 
 	// ARM RPC specific operations.
 	OperationPutSubscriptions: http.MethodPut,
+	OperationHistory:          http.MethodGet,
+	OperationLogs:             http.MethodGet,
+	OperationListDeadLetter:   http.MethodGet,
 
 	// Non-idempotent lifecycle operations.
 	OperationGetImperative:    http.MethodPost,
@@ -87,6 +90,31 @@ const (
 	OperationPutSubscriptions OperationMethod = "PUTSUBSCRIPTIONS"
 	OperationPost             OperationMethod = "POST"
 
+	// OperationCancel is used to cancel an async operation. It is a custom action of operationStatuses using POST.
+	OperationCancel OperationMethod = "CANCEL"
+
+	// OperationLogs is used to get the execution logs of an async operation. It is a custom action of operationStatuses
+	// using GET.
+	OperationLogs OperationMethod = "LOGS"
+
+	// OperationListDeadLetter is used to list the async operations in the dead-letter queue of a resource provider. It is
+	// served by GET {rootScope}/providers/{namespace}/locations/{location}/deadletteredoperations.
+	OperationListDeadLetter OperationMethod = "LISTDEADLETTER"
+
+	// OperationReplayDeadLetter is used to queue a dead-lettered async operation again. It is a custom action of
+	// deadLetteredOperations using POST.
+	OperationReplayDeadLetter OperationMethod = "REPLAYDEADLETTER"
+
+	// OperationHistory is used to list the operation history of a resource. It is served by GET {resourceId}/operations.
+	OperationHistory OperationMethod = "HISTORY"
+
+	// OperationRestore is used to restore a soft-deleted resource. It is a custom action of the resource using POST.
+	OperationRestore OperationMethod = "RESTORE"
+
+	// OperationPlanRecipe is used to plan the changes of the recipe of a portable resource. It is served by
+	// POST {resourceId}/planRecipe.
+	OperationPlanRecipe OperationMethod = "PLANRECIPE"
+
 	// Imperative operation methods for non-idempotent lifecycle operations.
 	// UCP extends the ARM resource lifecycle to support using POST for non-idempotent resource types.
 	//
@@ -181,6 +209,8 @@ type InternalMetadata struct {
 	UpdatedAPIVersion string `json:"updatedApiVersion,omitempty"`
 	// AsyncProvisioningState is the provisioning state for async operation.
 	AsyncProvisioningState ProvisioningState `json:"provisioningState,omitempty"`
+	// DeletedTime is the time when the resource was soft-deleted. The resource is a tombstone if it is set.
+	DeletedTime *time.Time `json:"deletedTime,omitempty"`
 }
 
 // BaseResource represents common resource properties used for all resources.
@@ -231,6 +261,11 @@ func (b *BaseResource) ProvisioningState() ProvisioningState {
 	return b.InternalMetadata.AsyncProvisioningState
 }
 
+// IsDeleted returns true if the resource is a soft-deleted tombstone.
+func (b *BaseResource) IsDeleted() bool {
+	return b.InternalMetadata.DeletedTime != nil
+}
+
 // SetProvisioningState sets the privisioning state of the resource.
 func (b *BaseResource) SetProvisioningState(state ProvisioningState) {
 	b.InternalMetadata.AsyncProvisioningState = state
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -56,6 +57,12 @@ type Request struct {
 
 	// OperationTimeout represents the timeout duration of async operation.
 	OperationTimeout *time.Duration `json:"asyncOperationTimeout"`
+
+	// Input represents the input of the operation, such as the resource in the request body of an action.
+	Input json.RawMessage `json:"input,omitempty"`
+	// ReadOnly represents whether the operation leaves the resource unchanged. The provisioning state of the resource is
+	// not updated for read-only operations.
+	ReadOnly bool `json:"readOnly,omitempty"`
 }
 
 // Timeout gets the operation timeout and returns the default timeout unless it specifies.
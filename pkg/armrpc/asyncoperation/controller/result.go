@@ -28,6 +28,10 @@ type Result struct {
 	// Error represents the error when status is Cancelled or Failed.
 	Error *v1.ErrorDetails
 
+	// Properties represents the result of an operation that does not change the resource, such as an action. It is
+	// returned to the client when the operation succeeds.
+	Properties any
+
 	// state represents the provisioning status.
 	state *v1.ProvisioningState
 }
@@ -43,4 +43,12 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime,omitempty"`
+
+	// CancelRequested is set when the user requests the cancellation of the operation. The worker processing the
+	// operation watches for it and cancels the operation.
+	CancelRequested bool `json:"cancelRequested,omitempty"`
+
+	// LastGoodResource is the resource as it was before the operation started. The resource is rolled back to it when
+	// the operation is canceled. It is unset if the resource did not exist or was not in the Succeeded state.
+	LastGoodResource any `json:"lastGoodResource,omitempty"`
 }
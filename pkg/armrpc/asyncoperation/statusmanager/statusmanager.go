@@ -18,6 +18,7 @@ package statusmanager
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -27,10 +28,12 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/trace"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/google/uuid"
 )
@@ -48,6 +51,19 @@ type QueueOperationOptions struct {
 	OperationTimeout time.Duration
 	// RetryAfter specifies the value of the Retry-After header that will be used for async operations.
 	RetryAfter time.Duration
+	// ResourceOps specifies the writes to the resource that the operation is for, such as saving its initial provisioning
+	// state. They are committed in the same transaction as the operation status when both are stored by the same
+	// storage client, otherwise they are committed before the operation status is saved.
+	ResourceOps []store.Op
+	// LastGoodResource specifies the resource as it was before the operation, which is restored if the operation is
+	// canceled.
+	LastGoodResource any
+	// Input specifies the input of the operation, such as the resource in the request body of an action. It is passed
+	// to the async controller in the request message.
+	Input any
+	// ReadOnly specifies that the operation leaves the resource unchanged, so the worker does not update the provisioning
+	// state of the resource.
+	ReadOnly bool
 }
 
 //go:generate mockgen -destination=./mock_statusmanager.go -package=statusmanager -self_package github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager StatusManager
@@ -58,8 +74,8 @@ type StatusManager interface {
 	Get(ctx context.Context, id resources.ID, operationID uuid.UUID) (*Status, error)
 	// QueueAsyncOperation creates an async operation status object and queue async operation.
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
-	// Update updates an async operation status.
-	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// Update updates an async operation status. The properties are the result of the operation, if any.
+	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, properties any) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 }
@@ -82,8 +98,9 @@ func (aom *statusManager) getClient(ctx context.Context, id resources.ID) (store
 	return aom.storeProvider.GetStorageClient(ctx, id.ProviderNamespace()+"/operationstatuses")
 }
 
-// QueueAsyncOperation creates and saves a new status resource with the given parameters in datastore, and queues
-// a request message. If an error occurs, the status is deleted using the storeClient.
+// QueueAsyncOperation creates and saves a new status resource with the given parameters in datastore along with the
+// writes to the resource in options.ResourceOps, and queues a request message. If queueing fails, the status is deleted
+// using the storeClient.
 func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error {
 	ctx, span := trace.StartProducerSpan(ctx, "statusmanager.QueueAsyncOperation publish", trace.FrontendTracerName)
 	defer span.End()
@@ -109,6 +126,7 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		RetryAfter:       options.RetryAfter,
 		HomeTenantID:     sCtx.HomeTenantID,
 		ClientObjectID:   sCtx.ClientObjectID,
+		LastGoodResource: options.LastGoodResource,
 	}
 
 	storeClient, err := aom.getClient(ctx, sCtx.ResourceID)
@@ -116,16 +134,16 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		return err
 	}
 
-	err = storeClient.Save(ctx, &store.Object{
+	err = aom.saveStatus(ctx, storeClient, sCtx.ResourceID, &store.Object{
 		Metadata: store.Metadata{ID: opID},
 		Data:     aos,
-	})
+	}, options.ResourceOps)
 
 	if err != nil {
 		return err
 	}
 
-	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout); err != nil {
+	if err = aom.queueRequestMessage(ctx, sCtx, aos, options); err != nil {
 		delErr := storeClient.Delete(ctx, opID)
 		if delErr != nil {
 			return delErr
@@ -138,6 +156,30 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 	return nil
 }
 
+// saveStatus saves the operation status together with the writes to the resource. The writes are committed in a single
+// transaction when the resource and the operation status share a storage client.
+func (aom *statusManager) saveStatus(ctx context.Context, storeClient store.StorageClient, id resources.ID, status *store.Object, resourceOps []store.Op) error {
+	if len(resourceOps) == 0 {
+		return storeClient.Save(ctx, status)
+	}
+
+	resourceClient, err := aom.storeProvider.GetStorageClient(ctx, id.Type())
+	if err != nil {
+		return err
+	}
+
+	if resourceClient == storeClient {
+		ops := append([]store.Op{}, resourceOps...)
+		return store.Transact(ctx, storeClient, append(ops, store.SaveOp(status)))
+	}
+
+	if err := store.Transact(ctx, resourceClient, resourceOps); err != nil {
+		return err
+	}
+
+	return storeClient.Save(ctx, status)
+}
+
 // Get gets a status object from the datastore or an error if the retrieval fails.
 func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID uuid.UUID) (*Status, error) {
 	storeClient, err := aom.getClient(ctx, id)
@@ -160,7 +202,7 @@ func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID
 
 // Update retrieves an existing operation status resource from the store, updates its fields with the
 // given parameters, and saves it back to the store.
-func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
+func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, properties any) error {
 	opID := aom.operationStatusResourceID(id, operationID)
 	storeClient, err := aom.getClient(ctx, id)
 	if err != nil {
@@ -186,11 +228,61 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 		s.Error = opError
 	}
 
+	if properties != nil {
+		s.Properties = properties
+	}
+
 	s.LastUpdatedTime = time.Now().UTC()
 
 	obj.Data = s
 
-	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+	if err := storeClient.Save(ctx, obj, store.WithETag(obj.ETag)); err != nil {
+		return err
+	}
+
+	if state.IsTerminal() {
+		aom.completeAuditRecord(ctx, id, operationID, s)
+	}
+
+	return nil
+}
+
+// completeAuditRecord records the outcome of the completed operation in the operation history of the resource. Failures
+// are logged and do not fail the operation.
+func (aom *statusManager) completeAuditRecord(ctx context.Context, id resources.ID, operationID uuid.UUID, s *Status) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	err := func() error {
+		resourceClient, err := aom.storeProvider.GetStorageClient(ctx, id.Type())
+		if err != nil {
+			return err
+		}
+
+		afterHash := ""
+		obj, err := resourceClient.Get(ctx, id.String())
+		if err == nil {
+			if afterHash, err = audit.Hash(obj); err != nil {
+				return err
+			}
+		} else if !errors.Is(&store.ErrNotFound{ID: id.String()}, err) {
+			return err
+		}
+
+		endTime := s.LastUpdatedTime
+		if s.EndTime != nil {
+			endTime = *s.EndTime
+		}
+
+		errorMessage := ""
+		if s.Error != nil {
+			errorMessage = s.Error.Message
+		}
+
+		return audit.NewClient(resourceClient).Complete(ctx, id, operationID.String(), string(s.Status), endTime, errorMessage, afterHash)
+	}()
+	if err != nil {
+		logger.Error(err, "failed to record the outcome of the operation in the operation history", "resourceId", id.String())
+	}
 }
 
 // Delete deletes the operation status resource associated with the given ID and
@@ -204,7 +296,16 @@ func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operation
 }
 
 // queueRequestMessage function is to put the async operation message to the queue to be worked on.
-func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, operationTimeout time.Duration) error {
+func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, options QueueOperationOptions) error {
+	var input json.RawMessage
+	if options.Input != nil {
+		b, err := json.Marshal(options.Input)
+		if err != nil {
+			return err
+		}
+		input = b
+	}
+
 	msg := &ctrl.Request{
 		APIVersion:       sCtx.APIVersion,
 		OperationID:      sCtx.OperationID,
@@ -215,7 +316,9 @@ func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMR
 		AcceptLanguage:   sCtx.AcceptLanguage,
 		HomeTenantID:     sCtx.HomeTenantID,
 		ClientObjectID:   sCtx.ClientObjectID,
-		OperationTimeout: &operationTimeout,
+		OperationTimeout: &options.OperationTimeout,
+		Input:            input,
+		ReadOnly:         options.ReadOnly,
 	}
 
 	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
@@ -19,6 +19,8 @@ package statusmanager
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -26,10 +28,13 @@ import (
 	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
 
@@ -191,6 +196,50 @@ func TestCreateAsyncOperationStatus(t *testing.T) {
 	}
 }
 
+func TestCreateAsyncOperationStatus_ResourceOps(t *testing.T) {
+	resourceObj := &store.Object{Metadata: store.Metadata{ID: reqCtx.ResourceID.String()}}
+	options := QueueOperationOptions{
+		OperationTimeout: operationTimeoutDuration,
+		RetryAfter:       opererationRetryAfterDuration,
+		ResourceOps:      []store.Op{store.SaveOp(resourceObj, store.WithETag("resource-etag"))},
+	}
+
+	t.Run("shared_storage_client", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.storeProvider.EXPECT().GetStorageClient(gomock.Any(), reqCtx.ResourceID.Type()).Return(aomTest.storeClient, nil)
+
+		// The mock doesn't implement store.Transactor, so the writes are committed in order with the status last.
+		gomock.InOrder(
+			aomTest.storeClient.EXPECT().Save(gomock.Any(), resourceObj, gomock.Any()).Return(nil),
+			aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+					require.IsType(t, &Status{}, obj.Data)
+					return nil
+				}),
+		)
+		aomTest.queue.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		err := aomTest.manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+		require.NoError(t, err)
+	})
+
+	t.Run("separate_storage_client", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		resourceClient := store.NewMockStorageClient(mctrl)
+		aomTest.storeProvider.EXPECT().GetStorageClient(gomock.Any(), reqCtx.ResourceID.Type()).Return(resourceClient, nil)
+
+		resourceClient.EXPECT().Save(gomock.Any(), resourceObj, gomock.Any()).Return(&store.ErrConcurrency{})
+
+		// The status is not saved when the resource can't be saved.
+		err := aomTest.manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+	})
+}
+
 func TestDeleteAsyncOperationStatus(t *testing.T) {
 	deleteCases := []struct {
 		Desc      string
@@ -312,7 +361,7 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 			testAos.Status = v1.ProvisioningStateSucceeded
 			rid, err := resources.ParseResource(azureEnvResourceID)
 			require.NoError(t, err)
-			err = aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateAccepted, nil, nil)
+			err = aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateAccepted, nil, nil, nil)
 
 			if tt.GetErr == nil && tt.SaveErr == nil {
 				require.NoError(t, err)
@@ -328,3 +377,64 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateAsyncOperationStatus_CompletesAuditRecord(t *testing.T) {
+	ctx := testcontext.New(t)
+	mctrl := gomock.NewController(t)
+
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	sc, err := boltstore.NewBoltClient(db)
+	require.NoError(t, err)
+
+	dp := dataprovider.NewMockDataStorageProvider(mctrl)
+	dp.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(sc, nil).AnyTimes()
+	manager := New(dp, queue.NewMockClient(mctrl), "test-location")
+
+	rid := resources.MustParse(ucpEnvResourceID)
+	operationID := uuid.New()
+	startTime := time.Now().Add(-time.Minute).UTC()
+
+	err = audit.NewClient(sc).Append(ctx, &audit.Record{
+		ResourceID:    rid.String(),
+		OperationID:   operationID.String(),
+		OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+		StatusCode:    http.StatusCreated,
+		Result:        audit.ResultAccepted,
+		StartTime:     startTime,
+	})
+	require.NoError(t, err)
+
+	err = sc.Save(ctx, &store.Object{Metadata: store.Metadata{ID: rid.String()}, Data: map[string]any{"name": "env0"}})
+	require.NoError(t, err)
+
+	statusID := manager.(*statusManager).operationStatusResourceID(rid, operationID)
+	err = sc.Save(ctx, &store.Object{
+		Metadata: store.Metadata{ID: statusID},
+		Data:     &Status{AsyncOperationStatus: v1.AsyncOperationStatus{ID: statusID, Name: operationID.String(), Status: v1.ProvisioningStateUpdating, StartTime: startTime}},
+	})
+	require.NoError(t, err)
+
+	// The record is not completed while the operation is running.
+	err = manager.Update(ctx, rid, operationID, v1.ProvisioningStateUpdating, nil, nil, nil)
+	require.NoError(t, err)
+	records, err := audit.NewClient(sc).List(ctx, rid)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, audit.ResultAccepted, records[0].Result)
+	require.Nil(t, records[0].EndTime)
+
+	endTime := time.Now().UTC()
+	err = manager.Update(ctx, rid, operationID, v1.ProvisioningStateFailed, &endTime, &v1.ErrorDetails{Code: v1.CodeInternal, Message: "deployment failed"}, nil)
+	require.NoError(t, err)
+
+	records, err = audit.NewClient(sc).List(ctx, rid)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, audit.ResultFailed, records[0].Result)
+	require.Equal(t, "deployment failed", records[0].ErrorMessage)
+	require.NotNil(t, records[0].EndTime)
+	require.True(t, endTime.Equal(*records[0].EndTime))
+	require.Equal(t, endTime.Sub(startTime).Milliseconds(), records[0].DurationMilliseconds)
+	require.NotEmpty(t, records[0].AfterHash)
+}
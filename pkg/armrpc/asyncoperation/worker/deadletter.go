@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+)
+
+var (
+	// ErrDeadLetterNotSupported represents the error when the queue client does not support dead-lettering.
+	ErrDeadLetterNotSupported = errors.New("queue does not support dead-lettering")
+)
+
+// DeadLetteredOperation represents an async operation whose message has been moved to the dead-letter queue
+// after exceeding MaxOperationRetryCount.
+type DeadLetteredOperation struct {
+	// MessageID is the id of the dead-lettered queue message.
+	MessageID string `json:"messageId"`
+	// Reason is the reason why the message was dead-lettered.
+	Reason string `json:"reason,omitempty"`
+	// DequeueCount is the number of times the message was dequeued before it was dead-lettered.
+	DequeueCount int `json:"dequeueCount"`
+	// EnqueueAt is the time when the operation was queued.
+	EnqueueAt time.Time `json:"enqueueAt"`
+	// Request is the async operation request.
+	Request *ctrl.Request `json:"request"`
+}
+
+// DeadLetteredOperationList represents the list of dead-lettered operations returned by the API.
+type DeadLetteredOperationList struct {
+	Value []DeadLetteredOperation `json:"value"`
+}
+
+// ListDeadLetteredOperations returns the async operations in the dead-letter queue of the given queue client. It
+// returns ErrDeadLetterNotSupported if the queue client does not support dead-lettering.
+func ListDeadLetteredOperations(ctx context.Context, qu queue.Client) ([]DeadLetteredOperation, error) {
+	dl, ok := qu.(queue.DeadLetterClient)
+	if !ok {
+		return nil, ErrDeadLetterNotSupported
+	}
+
+	messages, err := dl.ListDeadLetterMessages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []DeadLetteredOperation{}
+	for _, msg := range messages {
+		req := &ctrl.Request{}
+		if err := json.Unmarshal(msg.Data, req); err != nil {
+			return nil, err
+		}
+
+		result = append(result, DeadLetteredOperation{
+			MessageID:    msg.ID,
+			Reason:       msg.DeadLetterReason,
+			DequeueCount: msg.DequeueCount,
+			EnqueueAt:    msg.EnqueueAt,
+			Request:      req,
+		})
+	}
+
+	return result, nil
+}
+
+// ReplayDeadLetteredOperation queues the dead-lettered operation again and removes its message from the dead-letter
+// queue. The original operation has already been marked as failed, so the operation is replayed with a new operation
+// id, which is returned.
+func ReplayDeadLetteredOperation(ctx context.Context, sm manager.StatusManager, qu queue.Client, messageID string) (uuid.UUID, error) {
+	dl, ok := qu.(queue.DeadLetterClient)
+	if !ok {
+		return uuid.Nil, ErrDeadLetterNotSupported
+	}
+
+	operations, err := ListDeadLetteredOperations(ctx, qu)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var op *DeadLetteredOperation
+	for i := range operations {
+		if operations[i].MessageID == messageID {
+			op = &operations[i]
+			break
+		}
+	}
+	if op == nil {
+		return uuid.Nil, queue.ErrInvalidMessage
+	}
+
+	armReqCtx, err := op.Request.ARMRequestContext()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	armReqCtx.OperationID = uuid.New()
+
+	options := manager.QueueOperationOptions{OperationTimeout: op.Request.Timeout(), ReadOnly: op.Request.ReadOnly}
+	if len(op.Request.Input) > 0 {
+		options.Input = op.Request.Input
+	}
+
+	err = sm.QueueAsyncOperation(ctx, armReqCtx, options)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := dl.DeleteDeadLetterMessage(ctx, messageID); err != nil {
+		return uuid.Nil, err
+	}
+
+	return armReqCtx.OperationID, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	qbolt "github.com/radius-project/radius/pkg/ucp/queue/bolt"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/queue/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeadLetterQueue(t *testing.T) *qbolt.Client {
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "queue.db"))
+	require.NoError(t, err)
+
+	qu, err := qbolt.New(db, qbolt.Options{Name: "applications.core"})
+	require.NoError(t, err)
+
+	return qu
+}
+
+func TestDeadLetteredOperations(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	ctx := context.Background()
+	qu := newDeadLetterQueue(t)
+
+	opID := uuid.New()
+	err := qu.Enqueue(ctx, genTestMessage(opID, ctrl.DefaultAsyncOperationTimeout))
+	require.NoError(t, err)
+
+	msg, err := qu.Dequeue(ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	err = queue.DeadLetter(ctx, qu, msg, "exceeded max retry count")
+	require.NoError(t, err)
+
+	operations, err := ListDeadLetteredOperations(ctx, qu)
+	require.NoError(t, err)
+	require.Len(t, operations, 1)
+	require.Equal(t, msg.ID, operations[0].MessageID)
+	require.Equal(t, "exceeded max retry count", operations[0].Reason)
+	require.Equal(t, 1, operations[0].DequeueCount)
+	require.Equal(t, opID, operations[0].Request.OperationID)
+
+	t.Run("replay unknown message", func(t *testing.T) {
+		sm := manager.NewMockStatusManager(mctrl)
+		_, err := ReplayDeadLetteredOperation(ctx, sm, qu, "unknown")
+		require.ErrorIs(t, err, queue.ErrInvalidMessage)
+	})
+
+	t.Run("replay message", func(t *testing.T) {
+		sm := manager.NewMockStatusManager(mctrl)
+		sm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options manager.QueueOperationOptions) error {
+				require.NotEqual(t, opID, sCtx.OperationID)
+				require.Equal(t, operations[0].Request.ResourceID, sCtx.ResourceID.String())
+				require.Equal(t, ctrl.DefaultAsyncOperationTimeout, options.OperationTimeout)
+				require.Nil(t, options.Input)
+				require.False(t, options.ReadOnly)
+				return nil
+			})
+
+		newOpID, err := ReplayDeadLetteredOperation(ctx, sm, qu, msg.ID)
+		require.NoError(t, err)
+		require.NotEqual(t, opID, newOpID)
+
+		operations, err := ListDeadLetteredOperations(ctx, qu)
+		require.NoError(t, err)
+		require.Empty(t, operations)
+	})
+}
+
+func TestDeadLetteredOperations_NotSupported(t *testing.T) {
+	qu := inmemory.New(inmemory.NewInMemQueue(time.Minute))
+
+	_, err := ListDeadLetteredOperations(context.Background(), qu)
+	require.ErrorIs(t, err, ErrDeadLetterNotSupported)
+
+	_, err = ReplayDeadLetteredOperation(context.Background(), nil, qu, "id")
+	require.ErrorIs(t, err, ErrDeadLetterNotSupported)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/google/uuid"
+)
+
+// resourceLease is the lease on a resource held by the worker processing an operation on the resource. The lease is
+// stored with the resources so that only one operation on a resource runs at a time across all worker replicas.
+type resourceLease struct {
+	// ResourceID is the ID of the resource.
+	ResourceID string `json:"resourceId"`
+
+	// OperationID is the ID of the operation holding the lease.
+	OperationID uuid.UUID `json:"operationId"`
+
+	// MessageID is the ID of the queue message of the operation. The lease is owned by the message rather than the
+	// operation so that a message delivered twice does not run the operation twice.
+	MessageID string `json:"messageId"`
+
+	// ExpireAt is the time when the lease expires. It follows the lock of the queue message, so the lease is
+	// released when the message becomes visible again after the worker holding it stops.
+	ExpireAt time.Time `json:"expireAt"`
+}
+
+// leaseID returns the ID of the lease object for the resource.
+func leaseID(id resources.ID) string {
+	// The resource ID is hashed since the lease object needs a single name segment.
+	h := sha1.Sum([]byte(strings.ToLower(id.String())))
+	return fmt.Sprintf("%s/providers/%s/locations/%s/operationleases/%s", id.PlaneScope(), strings.ToLower(id.ProviderNamespace()), v1.LocationGlobal, hex.EncodeToString(h[:]))
+}
+
+// acquireLease acquires or renews the lease on the resource. It returns false if the lease is held by another message
+// and has not expired, or if another worker acquired it concurrently.
+func acquireLease(ctx context.Context, sc store.StorageClient, id resources.ID, lease *resourceLease) (bool, error) {
+	lid := leaseID(id)
+	lease.ResourceID = id.String()
+
+	obj, err := sc.Get(ctx, lid)
+	if errors.Is(&store.ErrNotFound{ID: lid}, err) {
+		err = sc.Save(ctx, &store.Object{Metadata: store.Metadata{ID: lid}, Data: lease}, store.WithCreateOnly())
+	} else if err != nil {
+		return false, err
+	} else {
+		current := &resourceLease{}
+		if err := obj.As(current); err != nil {
+			return false, err
+		}
+
+		if current.MessageID != lease.MessageID && current.ExpireAt.After(time.Now()) {
+			return false, nil
+		}
+
+		obj.Data = lease
+		err = sc.Save(ctx, obj, store.WithETag(obj.ETag))
+	}
+
+	if errors.Is(&store.ErrConcurrency{}, err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// releaseLease releases the lease on the resource if it is held by the message.
+func releaseLease(ctx context.Context, sc store.StorageClient, id resources.ID, messageID string) error {
+	lid := leaseID(id)
+
+	obj, err := sc.Get(ctx, lid)
+	if errors.Is(&store.ErrNotFound{ID: lid}, err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	current := &resourceLease{}
+	if err := obj.As(current); err != nil {
+		return err
+	}
+
+	if current.MessageID != messageID {
+		return nil
+	}
+
+	// The lease was taken over by another message if the ETag does not match, in which case it is left alone.
+	err = sc.Delete(ctx, lid, store.WithETag(obj.ETag))
+	if errors.Is(&store.ErrConcurrency{}, err) || errors.Is(&store.ErrNotFound{ID: lid}, err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLease(expireAt time.Time) *resourceLease {
+	return &resourceLease{OperationID: uuid.New(), MessageID: uuid.NewString(), ExpireAt: expireAt}
+}
+
+func TestLeaseID(t *testing.T) {
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container")
+	lid := leaseID(id)
+
+	parsed, err := resources.ParseResource(lid)
+	require.NoError(t, err)
+	require.Equal(t, "applications.core/locations/operationleases", parsed.Type())
+	require.True(t, strings.HasPrefix(lid, "/planes/radius/local/providers/applications.core/locations/global/operationleases/"))
+
+	// IDs are case-insensitive, so they share the lease.
+	require.Equal(t, lid, leaseID(resources.MustParse("/planes/radius/local/resourceGroups/TEST-RG/providers/Applications.Core/containers/TEST-CONTAINER")))
+}
+
+func TestAcquireLease(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	sc, err := boltstore.NewBoltClient(db)
+	require.NoError(t, err)
+
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container")
+	other := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/other-container")
+
+	first := newTestLease(time.Now().Add(time.Hour))
+	acquired, err := acquireLease(ctx, sc, id, first)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	t.Run("held by another message", func(t *testing.T) {
+		acquired, err := acquireLease(ctx, sc, id, newTestLease(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+		require.False(t, acquired)
+	})
+
+	t.Run("other resource", func(t *testing.T) {
+		acquired, err := acquireLease(ctx, sc, other, newTestLease(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+		require.True(t, acquired)
+	})
+
+	t.Run("renew", func(t *testing.T) {
+		first.ExpireAt = time.Now().Add(2 * time.Hour)
+		acquired, err := acquireLease(ctx, sc, id, first)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		obj, err := sc.Get(ctx, leaseID(id))
+		require.NoError(t, err)
+		stored := &resourceLease{}
+		require.NoError(t, obj.As(stored))
+		require.Equal(t, first.MessageID, stored.MessageID)
+		require.True(t, first.ExpireAt.Equal(stored.ExpireAt))
+	})
+
+	t.Run("release by another message is ignored", func(t *testing.T) {
+		err := releaseLease(ctx, sc, id, uuid.NewString())
+		require.NoError(t, err)
+
+		acquired, err := acquireLease(ctx, sc, id, newTestLease(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+		require.False(t, acquired)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		err := releaseLease(ctx, sc, id, first.MessageID)
+		require.NoError(t, err)
+
+		_, err = sc.Get(ctx, leaseID(id))
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: leaseID(id)})
+
+		// Releasing a lease that is not held is a no-op.
+		err = releaseLease(ctx, sc, id, first.MessageID)
+		require.NoError(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		acquired, err := acquireLease(ctx, sc, id, newTestLease(time.Now().Add(-time.Minute)))
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		// The lease has expired, so another message can take it over.
+		acquired, err = acquireLease(ctx, sc, id, newTestLease(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+		require.True(t, acquired)
+	})
+}
@@ -59,6 +59,15 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultCancellationPollingInterval is the default duration between checks for the cancellation of the running operation.
+	defaultCancellationPollingInterval = time.Duration(5) * time.Second
+
+	// defaultControllerStopTimeout is the default duration to wait for a canceled controller to return.
+	defaultControllerStopTimeout = time.Duration(30) * time.Second
+
+	// defaultLeaseRetryInterval is the default delay before an operation waiting for the resource lease is processed again.
+	defaultLeaseRetryInterval = time.Duration(5) * time.Second
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -80,6 +89,17 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// CancellationPollingInterval is the duration between checks of the operation status for a cancellation request.
+	CancellationPollingInterval time.Duration
+
+	// LeaseRetryInterval is the delay before an operation is processed again when another operation on the same
+	// resource is in progress.
+	LeaseRetryInterval time.Duration
+
+	// ControllerStopTimeout is the maximum duration to wait for the controller of a canceled or timed out operation to
+	// return before the operation is completed.
+	ControllerStopTimeout time.Duration
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -116,6 +136,15 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.CancellationPollingInterval == time.Duration(0) {
+		options.CancellationPollingInterval = defaultCancellationPollingInterval
+	}
+	if options.LeaseRetryInterval == time.Duration(0) {
+		options.LeaseRetryInterval = defaultLeaseRetryInterval
+	}
+	if options.ControllerStopTimeout == time.Duration(0) {
+		options.ControllerStopTimeout = defaultControllerStopTimeout
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
@@ -185,7 +214,7 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 					Code:    v1.CodeInternal,
 					Message: errMsg,
 				})
-				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.StorageClient())
+				w.deadLetterOperation(reqCtx, msgreq, failed, asyncCtrl.StorageClient())
 				return
 			}
 
@@ -203,7 +232,31 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 				return
 			}
 
-			if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.StorageClient(), op, v1.ProvisioningStateUpdating, nil); err != nil {
+			// Operations on the same resource are serialized by the lease on the resource, which is held until the
+			// operation completes.
+			rID, err := resources.ParseResource(op.ResourceID)
+			if err != nil {
+				opLogger.Error(err, "failed to parse resource ID")
+				return
+			}
+			lease := &resourceLease{OperationID: op.OperationID, MessageID: msgreq.ID, ExpireAt: msgreq.NextVisibleAt}
+			acquired, err := acquireLease(reqCtx, asyncCtrl.StorageClient(), rID, lease)
+			if err != nil {
+				opLogger.Error(err, "failed to acquire the resource lease.")
+				return
+			}
+			if !acquired {
+				opLogger.Info("another operation is in progress on the resource, postponing the operation.")
+				w.postponeOperation(reqCtx, msgreq)
+				return
+			}
+			defer func() {
+				if err := releaseLease(reqCtx, asyncCtrl.StorageClient(), rID, msgreq.ID); err != nil {
+					opLogger.Error(err, "failed to release the resource lease.")
+				}
+			}()
+
+			if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.StorageClient(), op, v1.ProvisioningStateUpdating, nil, nil); err != nil {
 				return
 			}
 
@@ -245,7 +298,7 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 				// When backend controller has a critical bug such as nil reference, asyncCtrl.Run() is panicking.
 				// If this happens, the message is requeued after message lock time (5 mins).
 				// After message lock is expired, message will be reprocessed 'w.options.MaxOperationRetryCount' times and
-				// then complete the message and change provisioningState to 'Failed'. Meanwhile, PUT request will
+				// then dead-letter the message and change provisioningState to 'Failed'. Meanwhile, PUT request will
 				// be blocked.
 			}
 		}(opDone)
@@ -268,29 +321,55 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}()
 
 	operationTimeoutAfter := time.After(asyncReq.Timeout())
-	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	// Use a timer rather than time.After in the loop so that the other cases do not restart the wait for extending the lock.
+	messageExtendTimer := time.NewTimer(w.getMessageExtendDuration(message.NextVisibleAt))
+	defer messageExtendTimer.Stop()
+
+	// The cancellation of the operation is requested through the operation status, so poll it while the operation is running.
+	cancelPolling := time.NewTicker(w.options.CancellationPollingInterval)
+	defer cancelPolling.Stop()
 
 	for {
 		select {
-		case <-time.After(messageExtendAfter):
+		case <-messageExtendTimer.C:
 			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
 				logger.Error(err, "fails to extend message lock")
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
+				w.renewLease(ctx, message, asyncReq, asyncCtrl.StorageClient())
 			}
-			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
+			messageExtendTimer.Reset(w.getMessageExtendDuration(message.NextVisibleAt))
 
 		case <-operationTimeoutAfter:
 			logger.Info("Cancelling async operation.")
 
 			opCancel()
+			w.waitForController(ctx, opDone)
 			errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
 			result := ctrl.NewCanceledResult(errMessage)
 			result.Error.Target = asyncReq.ResourceID
 			w.completeOperation(ctx, message, result, asyncCtrl.StorageClient())
 			return
 
+		case <-cancelPolling.C:
+			status, err := w.getOperationStatus(ctx, asyncReq)
+			if err != nil {
+				logger.Error(err, "failed to check the cancellation of the operation")
+				continue
+			}
+			if !status.CancelRequested {
+				continue
+			}
+
+			logger.Info("Cancelling async operation as requested.")
+
+			opCancel()
+			// The controller may still be writing the resource, so wait for it to return before rolling the resource back.
+			w.waitForController(ctx, opDone)
+			w.cancelOperation(ctx, message, status, asyncCtrl.StorageClient())
+			return
+
 		case <-ctx.Done():
 			logger.Info("Stopping processing async operation. This operation will be reprocessed.")
 			return
@@ -306,6 +385,22 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}
 }
 
+// waitForController waits for the goroutine running the canceled controller to close opDone, for at most
+// ControllerStopTimeout.
+func (w *AsyncRequestProcessWorker) waitForController(ctx context.Context, opDone <-chan struct{}) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	timer := time.NewTimer(w.options.ControllerStopTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-opDone:
+	case <-timer.C:
+		logger.Info("Controller did not return after the operation was canceled.", "timeout", w.options.ControllerStopTimeout.String())
+	case <-ctx.Done():
+	}
+}
+
 func extractError(err error) v1.ErrorDetails {
 	if clientErr, ok := err.(*v1.ErrClientRP); ok {
 		return v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
@@ -322,7 +417,7 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 		return
 	}
 
-	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error)
+	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error, result.Properties)
 	if err != nil {
 		logger.Error(err, "failed to update resource and/or operation status")
 		return
@@ -338,7 +433,119 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
-func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc store.StorageClient, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
+// cancelOperation completes the operation that was canceled by the user. The resource is rolled back to the last good state
+// kept in the operation status, or set to Canceled if there is none.
+func (w *AsyncRequestProcessWorker) cancelOperation(ctx context.Context, message *queue.Message, status *manager.Status, sc store.StorageClient) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	result := ctrl.NewCanceledResult("Operation was canceled by the user.")
+	if status.LastGoodResource == nil {
+		w.completeOperation(ctx, message, result, sc)
+		return
+	}
+
+	req := &ctrl.Request{}
+	if err := json.Unmarshal(message.Data, req); err != nil {
+		logger.Error(err, "failed to unmarshal queue message.")
+		return
+	}
+
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		logger.Error(err, "failed to parse resource ID")
+		return
+	}
+
+	err = sc.Save(ctx, &store.Object{
+		Metadata: store.Metadata{ID: rID.String()},
+		Data:     status.LastGoodResource,
+	})
+	if err != nil {
+		logger.Error(err, "failed to roll back the resource")
+		return
+	}
+
+	now := time.Now().UTC()
+	if err := w.sm.Update(ctx, rID, req.OperationID, result.ProvisioningState(), &now, result.Error, nil); err != nil {
+		logger.Error(err, "failed to update operationstatus", "operationID", req.OperationID.String())
+		return
+	}
+
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+
+	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+}
+
+// renewLease extends the resource lease held by the message to the new message lock.
+func (w *AsyncRequestProcessWorker) renewLease(ctx context.Context, message *queue.Message, req *ctrl.Request, sc store.StorageClient) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		logger.Error(err, "failed to parse resource ID")
+		return
+	}
+
+	lease := &resourceLease{OperationID: req.OperationID, MessageID: message.ID, ExpireAt: message.NextVisibleAt}
+	renewed, err := acquireLease(ctx, sc, rID, lease)
+	if err != nil {
+		logger.Error(err, "failed to renew the resource lease.")
+	} else if !renewed {
+		logger.Error(nil, "the resource lease was taken over by another operation.")
+	}
+}
+
+// postponeOperation requeues the operation to be processed after LeaseRetryInterval and finishes the message. The
+// requeued message starts with no dequeue count, so waiting for another operation does not count as a retry.
+func (w *AsyncRequestProcessWorker) postponeOperation(ctx context.Context, message *queue.Message) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	msg := &queue.Message{ContentType: message.ContentType, Data: message.Data}
+	if err := w.requestQueue.Enqueue(ctx, msg, queue.WithDelay(w.options.LeaseRetryInterval)); err != nil {
+		logger.Error(err, "failed to requeue the message")
+		return
+	}
+
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+}
+
+// getOperationStatus gets the status of the operation for the request.
+func (w *AsyncRequestProcessWorker) getOperationStatus(ctx context.Context, req *ctrl.Request) (*manager.Status, error) {
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.sm.Get(ctx, rID, req.OperationID)
+}
+
+// deadLetterOperation completes the operation with the failed result and moves the message to the dead-letter queue,
+// so that operators can inspect and replay it. The message is finished if the queue does not support dead-lettering.
+func (w *AsyncRequestProcessWorker) deadLetterOperation(ctx context.Context, message *queue.Message, result ctrl.Result, sc store.StorageClient) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	req := &ctrl.Request{}
+	if err := json.Unmarshal(message.Data, req); err != nil {
+		logger.Error(err, "failed to unmarshal queue message.")
+		return
+	}
+
+	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error, nil)
+	if err != nil {
+		logger.Error(err, "failed to update resource and/or operation status")
+		return
+	}
+
+	if err := queue.DeadLetter(ctx, w.requestQueue, message, result.Error.Message); err != nil {
+		logger.Error(err, "failed to dead-letter the message")
+	}
+
+	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+}
+
+func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc store.StorageClient, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails, properties any) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	rID, err := resources.ParseResource(req.ResourceID)
@@ -349,15 +556,18 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 
 	opType, _ := v1.ParseOperationType(req.OperationType)
 
-	err = updateResourceState(ctx, sc, rID.String(), state)
-	if err != nil && !(opType.Method == http.MethodDelete && errors.Is(&store.ErrNotFound{ID: rID.String()}, err)) {
-		logger.Error(err, "failed to update the provisioningState in resource.")
-		return err
+	// Read-only operations, such as planning the changes to the resource, leave the provisioning state of the resource as is.
+	if !req.ReadOnly {
+		err = updateResourceState(ctx, sc, rID.String(), state)
+		if err != nil && !(opType.Method == http.MethodDelete && errors.Is(&store.ErrNotFound{ID: rID.String()}, err)) {
+			logger.Error(err, "failed to update the provisioningState in resource.")
+			return err
+		}
 	}
 
 	// Otherwise we update the operationStatus to the result.
 	now := time.Now().UTC()
-	err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr)
+	err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr, properties)
 	if err != nil {
 		logger.Error(err, "failed to update operationstatus", "operationID", req.OperationID.String())
 		return err
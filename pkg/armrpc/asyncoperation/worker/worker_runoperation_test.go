@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -214,7 +215,7 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
 
 	expectedDequeueCount := 2
@@ -278,7 +279,7 @@ func TestStart_MaxConcurrency(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
 
 	registry := NewControllerRegistry(tCtx.mockSP)
@@ -357,7 +358,7 @@ func TestStart_RunOperation(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
 
 	registry := NewControllerRegistry(tCtx.mockSP)
@@ -418,6 +419,89 @@ func TestStart_RunOperation(t *testing.T) {
 	require.Equal(t, 1, testMessage.DequeueCount)
 }
 
+func TestStart_ResourceLeaseHeld(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// The lease is held by another operation when the message is dequeued the first time, and released afterwards.
+	leaseChecks := atomic.NewInt32(0)
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			if !strings.Contains(id, "/operationleases/") {
+				return newTestResourceObject(), nil
+			}
+
+			if leaseChecks.Inc() == 1 {
+				return &store.Object{
+					Metadata: store.Metadata{ID: id, ETag: "lease-etag"},
+					Data: &resourceLease{
+						OperationID: uuid.New(),
+						MessageID:   uuid.NewString(),
+						ExpireAt:    time.Now().Add(time.Hour),
+					},
+				}, nil
+			}
+			return nil, &store.ErrNotFound{ID: id}
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
+
+	registry := NewControllerRegistry(tCtx.mockSP)
+	worker := New(Options{
+		DequeueIntervalDuration: defaultTestDequeueInterval,
+		LeaseRetryInterval:      10 * time.Millisecond,
+	}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+	}
+
+	called := atomic.NewInt32(0)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			called.Inc()
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(time.Duration(0))
+	err := registry.Register(
+		ctx,
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	// Queue async operation.
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err = tCtx.testQueue.Enqueue(ctx, testMessage)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return called.Load() == 1 }, 10*time.Second, 10*time.Millisecond)
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+
+	// The operation ran once, from a new message that was queued when the lease was held.
+	require.Equal(t, int32(1), called.Load())
+	require.GreaterOrEqual(t, leaseChecks.Load(), int32(2))
+	require.Equal(t, 1, testMessage.DequeueCount)
+}
+
 func TestRunOperation_Successfully(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -428,7 +512,7 @@ func TestRunOperation_Successfully(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -457,6 +541,42 @@ func TestRunOperation_Successfully(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_ReadOnly(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// The resource is not read or written by read-only operations, and the result is saved in the operation status.
+	result := map[string]any{"changes": []any{}}
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateSucceeded), gomock.Any(), gomock.Any(), gomock.Eq(result)).Return(nil).Times(1)
+
+	opTimeout := ctrl.DefaultAsyncOperationTimeout
+	testMessage := queue.NewMessage(&ctrl.Request{
+		OperationID:      uuid.New(),
+		OperationType:    "APPLICATIONS.CORE/ENVIRONMENTS|PLANRECIPE",
+		ResourceID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+		CorrelationID:    uuid.NewString(),
+		OperationTimeout: &opTimeout,
+		ReadOnly:         true,
+	})
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC, DataProvider: tCtx.mockSP}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			return ctrl.Result{Properties: result}, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	// Ensure that message is finished.
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_ExtendMessageLock(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -467,7 +587,8 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -555,8 +676,8 @@ func TestRunOperation_Timeout(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails, _ any) error {
 			if state == v1.ProvisioningStateCanceled && strings.HasPrefix(opError.Message, "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) has timed out because it was processing longer than") &&
 				strings.HasPrefix(opError.Target, "/subscriptions/00000000-0000-0000-0000-000000000000") {
 				return nil
@@ -595,6 +716,141 @@ func TestRunOperation_Timeout(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_CancelRequested(t *testing.T) {
+	lastGood := map[string]any{
+		"name":              "env0",
+		"provisioningState": "Succeeded",
+	}
+
+	tests := []struct {
+		desc          string
+		lastGood      any
+		expectedState string
+	}{
+		{
+			desc:          "rollback to last good resource",
+			lastGood:      lastGood,
+			expectedState: "Succeeded",
+		},
+		{
+			desc:          "no last good resource",
+			expectedState: "Canceled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+			defer mctrl.Finish()
+
+			status := &manager.Status{CancelRequested: true, LastGoodResource: tt.lastGood}
+
+			saved := make(chan *store.Object, 1)
+			tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					return newTestResourceObject(), nil
+				}).AnyTimes()
+			tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+					saved <- obj
+					return nil
+				}).Times(1)
+			tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(status, nil).Times(1)
+			tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateCanceled), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, _ v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails, _ any) error {
+					require.Equal(t, "Operation was canceled by the user.", opError.Message)
+					return nil
+				}).Times(1)
+
+			testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+			err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+			require.NoError(t, err)
+			worker := New(Options{CancellationPollingInterval: 10 * time.Millisecond}, tCtx.mockSM, tCtx.testQueue, nil)
+
+			opts := ctrl.Options{
+				StorageClient: tCtx.mockSC,
+				DataProvider:  tCtx.mockSP,
+			}
+
+			done := make(chan struct{}, 1)
+			testCtrl := &testAsyncController{
+				BaseController: ctrl.NewBaseAsyncController(opts),
+				fn: func(ctx context.Context) (ctrl.Result, error) {
+					<-ctx.Done()
+					close(done)
+					return ctrl.Result{}, nil
+				},
+			}
+
+			msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+			require.NoError(t, err)
+			worker.runOperation(context.Background(), msg, testCtrl)
+			<-done
+
+			obj := <-saved
+			data, ok := obj.Data.(map[string]any)
+			require.True(t, ok)
+			require.Equal(t, tt.expectedState, data["provisioningState"])
+			require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+		})
+	}
+}
+
+func TestRunOperation_CancelRequested_ControllerStillWriting(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	lastGood := map[string]any{
+		"name":              "env0",
+		"provisioningState": "Succeeded",
+	}
+	status := &manager.Status{CancelRequested: true, LastGoodResource: lastGood}
+
+	var mu sync.Mutex
+	saved := []string{}
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(newTestResourceObject(), nil).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+			mu.Lock()
+			defer mu.Unlock()
+			saved = append(saved, obj.Data.(map[string]any)["provisioningState"].(string))
+			return nil
+		}).Times(2)
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(status, nil).Times(1)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateCanceled), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{CancellationPollingInterval: 10 * time.Millisecond}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+	}
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			<-ctx.Done()
+
+			// The controller keeps writing the resource for a while after the cancellation.
+			time.Sleep(50 * time.Millisecond)
+			err := opts.StorageClient.Save(context.Background(), &store.Object{Data: map[string]any{"provisioningState": "Updating"}})
+			return ctrl.Result{}, err
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{"Updating", "Succeeded"}, saved, "the last good resource is restored after the controller returns")
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_PanicController(t *testing.T) {
 	tCtx, _ := newTestContext(t, defaultTestLockTime)
 
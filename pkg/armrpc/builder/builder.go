@@ -30,6 +30,7 @@ import (
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
 	"github.com/radius-project/radius/pkg/validator"
 	"github.com/radius-project/radius/swagger"
 )
@@ -40,8 +41,8 @@ type Builder struct {
 	registrations []*OperationRegistration
 }
 
-// defaultHandlerOptions returns HandlerOption for the default operations such as getting operationStatuses and
-// operationResults.
+// defaultHandlerOptions returns HandlerOption for the default operations such as getting, canceling or getting the logs of
+// operationStatuses, listing and replaying dead-lettered operations, and getting operationResults.
 func defaultHandlerOptions(
 	ctx context.Context,
 	rootRouter chi.Router,
@@ -75,6 +76,40 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/%s", rootScopePath, namespace, defaultoperation.CancelOperationAction),
+		ResourceType:      statusType,
+		Method:            v1.OperationCancel,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	})
+
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/%s", rootScopePath, namespace, oplog.LogsAction),
+		ResourceType:      statusType,
+		Method:            v1.OperationLogs,
+		ControllerFactory: defaultoperation.NewGetOperationLogs,
+	})
+
+	deadLetterType := namespace + "/" + defaultoperation.DeadLetteredOperationsSegment
+	deadLetterPath := fmt.Sprintf("%s/providers/%s/locations/{location}/%s", rootScopePath, namespace, defaultoperation.DeadLetteredOperationsSegment)
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              deadLetterPath,
+		ResourceType:      deadLetterType,
+		Method:            v1.OperationListDeadLetter,
+		ControllerFactory: defaultoperation.NewListDeadLetteredOperations,
+	})
+
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/{%s}/%s", deadLetterPath, defaultoperation.DeadLetteredMessageIDParam, defaultoperation.ReplayDeadLetteredOperationAction),
+		ResourceType:      deadLetterType,
+		Method:            v1.OperationReplayDeadLetter,
+		ControllerFactory: defaultoperation.NewReplayDeadLetteredOperation,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
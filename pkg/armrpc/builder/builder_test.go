@@ -60,6 +60,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/virtualMachines", Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.compute/virtualmachines/vm0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/virtualMachines", Method: v1.OperationHistory},
+		Path:          "/resourcegroups/testrg/providers/applications.compute/virtualmachines/vm0/operations",
+		Method:        http.MethodGet,
 	}, {
 		OperationType: v1.OperationType{Type: "Applications.Compute/virtualMachines", Method: "ACTIONSTART"},
 		Path:          "/resourcegroups/testrg/providers/applications.compute/virtualmachines/vm0/start",
@@ -94,6 +98,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/containers", Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.compute/containers/container0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/containers", Method: v1.OperationHistory},
+		Path:          "/resourcegroups/testrg/providers/applications.compute/containers/container0/operations",
+		Method:        http.MethodGet,
 	}, {
 		OperationType: v1.OperationType{Type: "Applications.Compute/containers", Method: "ACTIONGETRESOURCE"},
 		Path:          "/resourcegroups/testrg/providers/applications.compute/containers/container0/getresource",
@@ -160,6 +168,22 @@ var defaultHandlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationCancel},
+		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000/cancel",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationLogs},
+		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000/logs",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/deadLetteredOperations", Method: v1.OperationListDeadLetter},
+		Path:          "/providers/applications.compute/locations/global/deadletteredoperations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/deadLetteredOperations", Method: v1.OperationReplayDeadLetter},
+		Path:          "/providers/applications.compute/locations/global/deadletteredoperations/message/replay",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationResults", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationresults/00000000-0000-0000-0000-000000000000",
@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 )
 
 const customActionPrefix = "ACTION"
@@ -88,12 +89,24 @@ type ResourceOption[P interface {
 	// Put defines the operation for creating or updating a resource.
 	Put Operation[T]
 
-	// Patch defines the operation for updating a resource.
+	// Patch defines the operation for updating a resource. By default, the request body is applied to the existing
+	// resource as a JSON merge patch (RFC 7396) using the versioned model returned by ResponseConverter.
 	Patch Operation[T]
 
 	// Delete defines the operation for deleting a resource.
 	Delete Operation[T]
 
+	// History defines the operation for listing the operation history of a resource.
+	History Operation[T]
+
+	// SoftDelete enables soft deletion of the resource. Deleted resources are kept as tombstones which are hidden from
+	// GET and LIST, and can be restored with the restore action until they are purged. Delete must not use
+	// AsyncJobController when SoftDelete is enabled.
+	SoftDelete bool
+
+	// Restore defines the operation for restoring a soft-deleted resource. It is registered only if SoftDelete is enabled.
+	Restore Operation[T]
+
 	// Custom defines the custom actions.
 	Custom map[string]Operation[T]
 }
@@ -127,6 +140,8 @@ func (r *ResourceOption[P, T]) BuildHandlerOutputs(opts BuildOptions) []*Operati
 		r.putOutput,
 		r.patchOutput,
 		r.deleteOutput,
+		r.historyOutput,
+		r.restoreOutput,
 	}
 
 	hs := []*OperationRegistration{}
@@ -295,11 +310,11 @@ func (r *ResourceOption[P, T]) patchOutput(opts BuildOptions) *OperationRegistra
 
 		if r.Patch.AsyncJobController == nil {
 			h.APIController = func(opt controller.Options) (controller.Controller, error) {
-				return defaultoperation.NewDefaultSyncPut[P, T](opt, ro)
+				return defaultoperation.NewDefaultSyncPatch[P, T](opt, ro)
 			}
 		} else {
 			h.APIController = func(opt controller.Options) (controller.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut[P, T](opt, ro)
+				return defaultoperation.NewDefaultAsyncPatch[P, T](opt, ro)
 			}
 		}
 	}
@@ -330,7 +345,11 @@ func (r *ResourceOption[P, T]) deleteOutput(opts BuildOptions) *OperationRegistr
 			AsyncOperationRetryAfter: getOrDefaultRetryAfter(r.Delete.AsyncOperationRetryAfter),
 		}
 
-		if r.Delete.AsyncJobController == nil {
+		if r.SoftDelete {
+			h.APIController = func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSoftDelete[P, T](opt, ro)
+			}
+		} else if r.Delete.AsyncJobController == nil {
 			h.APIController = func(opt controller.Options) (controller.Controller, error) {
 				return defaultoperation.NewDefaultSyncDelete[P, T](opt, ro)
 			}
@@ -344,6 +363,55 @@ func (r *ResourceOption[P, T]) deleteOutput(opts BuildOptions) *OperationRegistr
 	return h
 }
 
+func (r *ResourceOption[P, T]) historyOutput(opts BuildOptions) *OperationRegistration {
+	if r.History.Disabled {
+		return nil
+	}
+
+	h := &OperationRegistration{
+		ResourceType:        opts.ResourceType,
+		ResourceNamePattern: opts.ResourceNamePattern + "/" + opts.ParameterName,
+		Path:                "/" + audit.OperationsTypeSegment,
+		Method:              v1.OperationHistory,
+	}
+
+	if r.History.APIController != nil {
+		h.APIController = r.History.APIController
+	} else {
+		h.APIController = defaultoperation.NewListOperationHistory
+	}
+
+	return h
+}
+
+func (r *ResourceOption[P, T]) restoreOutput(opts BuildOptions) *OperationRegistration {
+	if !r.SoftDelete || r.Restore.Disabled {
+		return nil
+	}
+
+	h := &OperationRegistration{
+		ResourceType:        opts.ResourceType,
+		ResourceNamePattern: opts.ResourceNamePattern + "/" + opts.ParameterName,
+		Path:                "/" + defaultoperation.RestoreAction,
+		Method:              v1.OperationRestore,
+	}
+
+	if r.Restore.APIController != nil {
+		h.APIController = r.Restore.APIController
+	} else {
+		h.APIController = func(opt controller.Options) (controller.Controller, error) {
+			return defaultoperation.NewRestoreResource[P, T](opt,
+				controller.ResourceOptions[T]{
+					RequestConverter:  r.RequestConverter,
+					ResponseConverter: r.ResponseConverter,
+				},
+			)
+		}
+	}
+
+	return h
+}
+
 func (r *ResourceOption[P, T]) customActionOutputs(opts BuildOptions) []*OperationRegistration {
 	handlers := []*OperationRegistration{}
 
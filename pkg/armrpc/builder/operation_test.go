@@ -350,7 +350,7 @@ func TestResourceOption_PatchOutput(t *testing.T) {
 
 		api, err := h.APIController(controller.Options{})
 		require.NoError(t, err)
-		_, ok := api.(*defaultoperation.DefaultSyncPut[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
+		_, ok := api.(*defaultoperation.DefaultSyncPatch[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
 		require.True(t, ok)
 		require.Equal(t, "Applications.Compute/virtualMachines", h.ResourceType)
 		require.Equal(t, "applications.compute/virtualmachines/{virtualMachineName}", h.ResourceNamePattern)
@@ -372,7 +372,7 @@ func TestResourceOption_PatchOutput(t *testing.T) {
 
 		api, err := h.APIController(controller.Options{})
 		require.NoError(t, err)
-		_, ok := api.(*defaultoperation.DefaultAsyncPut[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
+		_, ok := api.(*defaultoperation.DefaultAsyncPatch[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
 		require.True(t, ok)
 		require.Equal(t, "Applications.Compute/virtualMachines", h.ResourceType)
 		require.Equal(t, "applications.compute/virtualmachines/{virtualMachineName}", h.ResourceNamePattern)
@@ -451,6 +451,78 @@ func TestResourceOption_DeleteOutput(t *testing.T) {
 		require.Equal(t, "applications.compute/virtualmachines/{virtualMachineName}", h.ResourceNamePattern)
 		require.Empty(t, h.Path)
 	})
+
+	t.Run("default soft delete controller", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+			SoftDelete: true,
+		}
+		h := option.deleteOutput(testBuildOptionsWithName)
+		require.NotNil(t, h)
+		require.Equal(t, v1.OperationDelete, h.Method)
+
+		api, err := h.APIController(controller.Options{})
+		require.NoError(t, err)
+		_, ok := api.(*defaultoperation.DefaultSoftDelete[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
+		require.True(t, ok)
+	})
+}
+
+func TestResourceOption_RestoreOutput(t *testing.T) {
+	node := &ResourceNode{Name: "virtualMachines", Kind: TrackedResourceKind}
+
+	t.Run("soft delete is disabled", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+		}
+		require.Nil(t, option.restoreOutput(testBuildOptionsWithName))
+	})
+
+	t.Run("default controller", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+			SoftDelete: true,
+		}
+		h := option.restoreOutput(testBuildOptionsWithName)
+		require.NotNil(t, h)
+		require.Equal(t, v1.OperationRestore, h.Method)
+		require.Equal(t, "Applications.Compute/virtualMachines", h.ResourceType)
+		require.Equal(t, "applications.compute/virtualmachines/{virtualMachineName}", h.ResourceNamePattern)
+		require.Equal(t, "/restore", h.Path)
+
+		api, err := h.APIController(controller.Options{})
+		require.NoError(t, err)
+		_, ok := api.(*defaultoperation.RestoreResource[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel])
+		require.True(t, ok)
+	})
+}
+
+func TestResourceOption_HistoryOutput(t *testing.T) {
+	node := &ResourceNode{Name: "virtualMachines", Kind: TrackedResourceKind}
+
+	t.Run("disabled is true", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+			History: Operation[rpctest.TestResourceDataModel]{
+				Disabled: true,
+			},
+		}
+		require.Nil(t, option.historyOutput(BuildOptions{}))
+	})
+
+	t.Run("default controller", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+			History:    Operation[rpctest.TestResourceDataModel]{},
+		}
+		h := option.historyOutput(testBuildOptionsWithName)
+		require.NotNil(t, h)
+		require.NotNil(t, h.APIController)
+		require.Equal(t, v1.OperationHistory, h.Method)
+		require.Equal(t, "Applications.Compute/virtualMachines", h.ResourceType)
+		require.Equal(t, "applications.compute/virtualmachines/{virtualMachineName}", h.ResourceNamePattern)
+		require.Equal(t, "/operations", h.Path)
+	})
 }
 
 func TestResourceOption_CustomActionOutput(t *testing.T) {
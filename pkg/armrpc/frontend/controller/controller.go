@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/store"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -67,6 +68,9 @@ type Options struct {
 
 	// StatusManager is the async operation status manager.
 	StatusManager sm.StatusManager
+
+	// QueueClient is the client of the queue that the async operations are queued to.
+	QueueClient queue.Client
 }
 
 // ResourceOptions represents the options and filters for resource.
@@ -142,6 +146,11 @@ func (b *BaseController) StatusManager() sm.StatusManager {
 	return b.options.StatusManager
 }
 
+// QueueClient gets the queue client of the async operations for this controller.
+func (b *BaseController) QueueClient() queue.Client {
+	return b.options.QueueClient
+}
+
 // GetResource gets a resource from data store for id, set the retrieved resource to out argument and returns
 // the ETag of the resource and an error if one occurs.
 func (c *BaseController) GetResource(ctx context.Context, id string, out any) (etag string, err error) {
@@ -19,4 +19,12 @@ package controller
 const (
 	// InProgressStateMessageFormat represents the message when resource is in progress state.
 	InProgressStateMessageFormat = "The target resource is in progress state: %s."
+
+	// DeletedResourceMessageFormat represents the message when a resource is created over the tombstone of a
+	// soft-deleted resource.
+	DeletedResourceMessageFormat = "The target resource %s was deleted. Restore it, or wait until it is purged, before creating it again."
+
+	// ConcurrentOperationMessage represents the message when the resource was changed by another operation while the
+	// operation was being queued.
+	ConcurrentOperationMessage = "The target resource was modified by another operation. Please retry the request."
 )
@@ -18,11 +18,13 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
@@ -95,13 +97,95 @@ func (c *Operation[P, T]) GetResourceFromRequest(ctx context.Context, req *http.
 	return dm, nil
 }
 
-// GetResource is the helper to get the resource via storage client.
+// GetPatchedResourceFromRequest applies the JSON merge patch (RFC 7396) in the HTTP request body to the existing resource
+// and returns the patched datamodel.
+//
+// The patch is written against the versioned model, which does not include the secrets, write-only and internal
+// properties of the stored datamodel. So the patch is applied to the versioned model of the existing resource, both the
+// original and the patched versioned models are converted to datamodel, and only the difference between the two is
+// merged into the stored datamodel. Properties that the patch does not change are kept as stored.
+func (c *Operation[P, T]) GetPatchedResourceFromRequest(ctx context.Context, req *http.Request, oldResource *T) (*T, error) {
+	patch, err := ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	versioned, err := c.resourceOptions.ResponseConverter(oldResource, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := json.Marshal(versioned)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := jsonpatch.MergePatch(original, patch)
+	if err != nil {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("The request body is not a valid JSON merge patch: %s", err.Error()))
+	}
+
+	originalModel, err := c.resourceOptions.RequestConverter(original, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedModel, err := c.resourceOptions.RequestConverter(patched, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	modelPatch, err := createMergePatch(originalModel, patchedModel)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := json.Marshal(oldResource)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := jsonpatch.MergePatch(stored, modelPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(T)
+	if err := json.Unmarshal(merged, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// createMergePatch returns the JSON merge patch which turns the original datamodel into the patched datamodel.
+func createMergePatch(original any, patched any) ([]byte, error) {
+	a, err := json.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(patched)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonpatch.CreateMergePatch(a, b)
+}
+
+// GetResource is the helper to get the resource via storage client. A soft-deleted resource is treated as if it
+// does not exist, but the ETag of its tombstone is returned so that PrepareResource does not let it be overwritten.
 func (c *Operation[P, T]) GetResource(ctx context.Context, id resources.ID) (out *T, etag string, err error) {
 	etag = ""
 	out = new(T)
 	var res *store.Object
 	if res, err = c.StorageClient().Get(ctx, id.String()); err == nil {
 		if err = res.As(out); err == nil {
+			if IsDeleted[P](out) {
+				return nil, res.ETag, nil
+			}
 			etag = res.ETag
 			return
 		}
@@ -137,6 +221,12 @@ func (c *Operation[P, T]) PrepareResource(ctx context.Context, req *http.Request
 		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
 	}
 
+	// GetResource returns the ETag of the tombstone of a soft-deleted resource, which must be restored instead of
+	// being overwritten.
+	if oldResource == nil && etag != "" {
+		return rest.NewConflictResponse(fmt.Sprintf(DeletedResourceMessageFormat, serviceCtx.ResourceID.String())), nil
+	}
+
 	if err := ValidateETag(*serviceCtx, etag); err != nil {
 		return rest.NewPreconditionFailedResponse(serviceCtx.ResourceID.String(), err.Error()), nil
 	}
@@ -163,38 +253,90 @@ func (c *Operation[P, T]) PrepareResource(ctx context.Context, req *http.Request
 	return nil, nil
 }
 
-// PrepareAsyncOperation saves the initial state and queue the async operation.
-func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource *T, initialState v1.ProvisioningState, asyncTimeout time.Duration, etag *string) (rest.Response, error) {
+// PrepareAsyncOperation saves the initial state and queue the async operation. The resource is saved in the same
+// transaction as the operation status when the storage client supports it. oldResource is the stored resource, if any;
+// it is kept with the operation status so that the resource can be rolled back if the operation is canceled.
+func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource *T, oldResource *T, initialState v1.ProvisioningState, asyncTimeout time.Duration, etag *string) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
-	P(newResource).SetProvisioningState(initialState)
-
-	var err error
-	*etag, err = c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, *etag)
+	// Take the snapshot before updating the provisioning state, since newResource and oldResource can be the same.
+	lastGood, err := lastGoodResource[P](oldResource)
 	if err != nil {
 		return nil, err
 	}
 
+	P(newResource).SetProvisioningState(initialState)
+
+	nr := &store.Object{
+		Metadata: store.Metadata{
+			ID: serviceCtx.ResourceID.String(),
+		},
+		Data: newResource,
+	}
+
 	options := sm.QueueOperationOptions{
 		OperationTimeout: asyncTimeout,
 		RetryAfter:       v1.DefaultRetryAfterDuration,
+		ResourceOps:      []store.Op{store.SaveOp(nr, store.WithETag(*etag))},
+		LastGoodResource: lastGood,
 	}
 	if c.resourceOptions.AsyncOperationRetryAfter != 0 {
 		options.RetryAfter = c.resourceOptions.AsyncOperationRetryAfter
 	}
 
 	if err := c.StatusManager().QueueAsyncOperation(ctx, serviceCtx, options); err != nil {
+		// The resource has no ETag if it was not saved, in which case there is nothing to roll back.
+		if nr.ETag == "" {
+			// The ETag of the stored resource does not match if another operation was queued concurrently.
+			if errors.Is(&store.ErrConcurrency{}, err) {
+				return rest.NewConflictResponse(ConcurrentOperationMessage), nil
+			}
+			return nil, err
+		}
+
 		P(newResource).SetProvisioningState(v1.ProvisioningStateFailed)
-		_, rbErr := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, *etag)
+		_, rbErr := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, nr.ETag)
 		if rbErr != nil {
 			return nil, rbErr
 		}
 		return nil, err
 	}
 
+	*etag = nr.ETag
 	return nil, nil
 }
 
+// IsDeleted returns true if the resource is a soft-deleted tombstone.
+func IsDeleted[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](resource *T) bool {
+	base := P(resource).GetBaseResource()
+	return base != nil && base.IsDeleted()
+}
+
+// lastGoodResource returns a copy of the resource if it is in the Succeeded state, and nil otherwise.
+func lastGoodResource[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](resource *T) (map[string]any, error) {
+	if resource == nil || P(resource).ProvisioningState() != v1.ProvisioningStateSucceeded {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := map[string]any{}
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
 // ConstructSyncResponse constructs synchronous API response.
 func (c *Operation[P, T]) ConstructSyncResponse(ctx context.Context, method, etag string, resource *T) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -257,3 +399,11 @@ func (b *Operation[P, T]) AsyncOperationTimeout() time.Duration {
 	}
 	return b.resourceOptions.AsyncOperationTimeout
 }
+
+// AsyncOperationRetryAfter returns the value of the Retry-After header for the async operation.
+func (b *Operation[P, T]) AsyncOperationRetryAfter() time.Duration {
+	if b.resourceOptions.AsyncOperationRetryAfter == 0 {
+		return v1.DefaultRetryAfterDuration
+	}
+	return b.resourceOptions.AsyncOperationRetryAfter
+}
@@ -49,7 +49,7 @@ var (
 )
 
 // ReadJSONBody extracts the content from request - it reads the body of the request if the content type
-// is "application/json". It returns the body as a byte array or an error if the content type is not supported
+// is "application/json" or "application/merge-patch+json". It returns the body as a byte array or an error if the content type is not supported
 // or an error occurs while reading the body.
 func ReadJSONBody(r *http.Request) ([]byte, error) {
 	defer r.Body.Close()
@@ -59,7 +59,7 @@ func ReadJSONBody(r *http.Request) ([]byte, error) {
 		contentType = contentType[0:i]
 	}
 
-	if contentType != "application/json" {
+	if contentType != "application/json" && contentType != "application/merge-patch+json" {
 		return nil, ErrUnsupportedContentType
 	}
 	data, err := io.ReadAll(r.Body)
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// CancelOperationAction is the name of the action to cancel an async operation.
+	CancelOperationAction = "cancel"
+)
+
+var _ ctrl.Controller = (*CancelOperation)(nil)
+
+// CancelOperation is the controller implementation to cancel an async operation.
+type CancelOperation struct {
+	ctrl.BaseController
+}
+
+// NewCancelOperation creates a new CancelOperation.
+func NewCancelOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &CancelOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run requests the cancellation of an asynchronous operation by marking its operation status. The worker processing the
+// operation cancels it and the operation completes with the Canceled status. It returns 202 Accepted with the Location of
+// the operation status, NotFound if the operation is not found, and Conflict if the operation has already completed.
+func (e *CancelOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	id := serviceCtx.ResourceID.String()
+
+	os := &manager.Status{}
+	etag, err := e.GetResource(ctx, id, os)
+	if err != nil && errors.Is(&store.ErrNotFound{ID: id}, err) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if os.Status.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf("Operation %s has already completed with status %s.", os.Name, os.Status)), nil
+	}
+
+	if !os.CancelRequested {
+		os.CancelRequested = true
+		if _, err := e.SaveResource(ctx, id, os, etag); err != nil {
+			if errors.Is(&store.ErrConcurrency{}, err) {
+				return rest.NewConflictResponse(fmt.Sprintf("Operation %s was updated while requesting the cancellation. Please retry.", os.Name)), nil
+			}
+			return nil, err
+		}
+	}
+
+	location := strings.TrimSuffix(req.URL.Path, "/"+CancelOperationAction)
+	return rest.NewAcceptedAsyncResponse(os.AsyncOperationStatus, location, req.URL.Scheme), nil
+}
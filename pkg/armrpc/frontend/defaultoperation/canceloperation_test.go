@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelOperationRun(t *testing.T) {
+	newRequest := func(t *testing.T) (context.Context, *http.Request) {
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, operationStatusTestHeaderFile, nil)
+		require.NoError(t, err)
+		req.URL.Path += "/" + CancelOperationAction
+		return rpctest.NewARMRequestContext(req), req
+	}
+
+	newStatus := func(state v1.ProvisioningState) *manager.Status {
+		return &manager.Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				Name:   "00000000-0000-0000-0000-000000000000",
+				Status: state,
+			},
+		}
+	}
+
+	getStatus := func(mStorageClient *store.MockStorageClient, status *manager.Status, err error) {
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				require.False(t, strings.HasSuffix(id, CancelOperationAction))
+				if err != nil {
+					return nil, err
+				}
+				return &store.Object{Metadata: store.Metadata{ID: id, ETag: "etag"}, Data: status}, nil
+			})
+	}
+
+	tests := []struct {
+		desc       string
+		status     *manager.Status
+		getErr     error
+		saveErr    error
+		save       bool
+		statusCode int
+	}{
+		{
+			desc:       "not found",
+			getErr:     &store.ErrNotFound{},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			desc:       "completed",
+			status:     newStatus(v1.ProvisioningStateSucceeded),
+			statusCode: http.StatusConflict,
+		},
+		{
+			desc:       "running",
+			status:     newStatus(v1.ProvisioningStateUpdating),
+			save:       true,
+			statusCode: http.StatusAccepted,
+		},
+		{
+			desc:       "concurrent update",
+			status:     newStatus(v1.ProvisioningStateUpdating),
+			save:       true,
+			saveErr:    &store.ErrConcurrency{},
+			statusCode: http.StatusConflict,
+		},
+		{
+			desc: "already requested",
+			status: func() *manager.Status {
+				s := newStatus(v1.ProvisioningStateAccepted)
+				s.CancelRequested = true
+				return s
+			}(),
+			statusCode: http.StatusAccepted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+
+			getStatus(mStorageClient, tt.status, tt.getErr)
+			if tt.save {
+				mStorageClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+						saved, ok := obj.Data.(*manager.Status)
+						require.True(t, ok)
+						require.True(t, saved.CancelRequested)
+						return tt.saveErr
+					})
+			}
+
+			ctl, err := NewCancelOperation(ctrl.Options{StorageClient: mStorageClient})
+			require.NoError(t, err)
+
+			ctx, req := newRequest(t)
+			w := httptest.NewRecorder()
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.statusCode == http.StatusAccepted {
+				location := w.Header().Get("Location")
+				require.True(t, strings.HasSuffix(location, "/operationStatuses/00000000-0000-0000-0000-000000000000"), location)
+			}
+		})
+	}
+}
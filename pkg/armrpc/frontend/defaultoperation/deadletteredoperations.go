@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// DeadLetteredOperationsSegment is the type segment of the dead-lettered operations of a resource provider.
+	DeadLetteredOperationsSegment = "deadletteredoperations"
+
+	// ReplayDeadLetteredOperationAction is the name of the action to replay a dead-lettered operation.
+	ReplayDeadLetteredOperationAction = "replay"
+
+	// DeadLetteredMessageIDParam is the name of the route parameter of the dead-lettered message id.
+	DeadLetteredMessageIDParam = "messageId"
+)
+
+var _ ctrl.Controller = (*ListDeadLetteredOperations)(nil)
+
+// ListDeadLetteredOperations is the controller implementation to list the async operations in the dead-letter queue.
+type ListDeadLetteredOperations struct {
+	ctrl.BaseController
+}
+
+// NewListDeadLetteredOperations creates a new ListDeadLetteredOperations.
+func NewListDeadLetteredOperations(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListDeadLetteredOperations{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the dead-lettered async operations of the resources of the provider namespace in the request URL. It
+// returns BadRequest if the queue of the resource provider does not support dead-lettering.
+func (e *ListDeadLetteredOperations) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	operations, err := listDeadLetteredOperations(ctx, &e.BaseController, serviceCtx.ResourceID.ProviderNamespace())
+	if errors.Is(err, worker.ErrDeadLetterNotSupported) {
+		return rest.NewBadRequestResponse("The queue of the resource provider does not support dead-lettering."), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(&worker.DeadLetteredOperationList{Value: operations}), nil
+}
+
+var _ ctrl.Controller = (*ReplayDeadLetteredOperation)(nil)
+
+// ReplayDeadLetteredOperation is the controller implementation to queue a dead-lettered async operation again.
+type ReplayDeadLetteredOperation struct {
+	ctrl.BaseController
+}
+
+// NewReplayDeadLetteredOperation creates a new ReplayDeadLetteredOperation.
+func NewReplayDeadLetteredOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ReplayDeadLetteredOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run queues the dead-lettered operation again with a new operation id and removes it from the dead-letter queue. It
+// returns 202 Accepted with the Location of the new operation status, NotFound if the message is not in the dead-letter
+// queue of the provider namespace, and BadRequest if the queue does not support dead-lettering.
+func (e *ReplayDeadLetteredOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	messageID := chi.URLParam(req, DeadLetteredMessageIDParam)
+
+	operations, err := listDeadLetteredOperations(ctx, &e.BaseController, serviceCtx.ResourceID.ProviderNamespace())
+	if errors.Is(err, worker.ErrDeadLetterNotSupported) {
+		return rest.NewBadRequestResponse("The queue of the resource provider does not support dead-lettering."), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var op *worker.DeadLetteredOperation
+	for i := range operations {
+		if operations[i].MessageID == messageID {
+			op = &operations[i]
+			break
+		}
+	}
+	if op == nil {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Dead-lettered operation %q was not found.", messageID)), nil
+	}
+
+	resourceID, err := resources.ParseResource(op.Request.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	operationID, err := worker.ReplayDeadLetteredOperation(ctx, e.StatusManager(), e.QueueClient(), messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	os, err := e.StatusManager().Get(ctx, resourceID, operationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The request URL is .../locations/{location}/deadletteredoperations/{messageId}/replay and the operation status is
+	// served by .../locations/{location}/operationstatuses/{operationId}.
+	prefix := req.URL.Path[:strings.Index(strings.ToLower(req.URL.Path), "/"+DeadLetteredOperationsSegment+"/")]
+	location := prefix + "/operationstatuses/" + operationID.String()
+	return rest.NewAcceptedAsyncResponse(os.AsyncOperationStatus, location, req.URL.Scheme), nil
+}
+
+// listDeadLetteredOperations lists the dead-lettered operations of the resources in the given provider namespace. The
+// queue of a resource provider can be shared by several provider namespaces.
+func listDeadLetteredOperations(ctx context.Context, c *ctrl.BaseController, namespace string) ([]worker.DeadLetteredOperation, error) {
+	if c.QueueClient() == nil {
+		return nil, worker.ErrDeadLetterNotSupported
+	}
+
+	operations, err := worker.ListDeadLetteredOperations(ctx, c.QueueClient())
+	if err != nil {
+		return nil, err
+	}
+
+	result := []worker.DeadLetteredOperation{}
+	for _, op := range operations {
+		id, err := resources.Parse(op.Request.ResourceID)
+		if err == nil && strings.EqualFold(id.ProviderNamespace(), namespace) {
+			result = append(result, op)
+		}
+	}
+
+	return result, nil
+}
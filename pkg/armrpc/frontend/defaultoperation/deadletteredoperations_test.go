@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/queue/bolt"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/queue/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/stretchr/testify/require"
+)
+
+const deadLetterTestPath = "/planes/radius/local/providers/applications.core/locations/global/deadletteredoperations"
+
+func newDeadLetterTestQueue(t *testing.T, resourceIDs ...string) (*bolt.Client, []string) {
+	ctx := context.Background()
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "queue.db"))
+	require.NoError(t, err)
+
+	qu, err := bolt.New(db, bolt.Options{Name: "applications.core"})
+	require.NoError(t, err)
+
+	messageIDs := []string{}
+	for _, id := range resourceIDs {
+		err := qu.Enqueue(ctx, queue.NewMessage(&asyncctrl.Request{
+			OperationID:   uuid.New(),
+			OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT",
+			ResourceID:    id,
+		}))
+		require.NoError(t, err)
+
+		msg, err := qu.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.NoError(t, queue.DeadLetter(ctx, qu, msg, "exceeded max retry count"))
+		messageIDs = append(messageIDs, msg.ID)
+	}
+
+	return qu, messageIDs
+}
+
+func newDeadLetterTestRequest(t *testing.T, method string, path string, messageID string) (context.Context, *http.Request) {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set(v1.RefererHeader, "https://radapp.io"+path)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(DeadLetteredMessageIDParam, messageID)
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+	return rpctest.NewARMRequestContext(req), req
+}
+
+func TestListDeadLetteredOperationsRun(t *testing.T) {
+	containerID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+	redisID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/test-redis"
+
+	t.Run("lists operations of the provider namespace", func(t *testing.T) {
+		qu, messageIDs := newDeadLetterTestQueue(t, containerID, redisID)
+
+		ctl, err := NewListDeadLetteredOperations(ctrl.Options{QueueClient: qu})
+		require.NoError(t, err)
+
+		ctx, req := newDeadLetterTestRequest(t, http.MethodGet, deadLetterTestPath, "")
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := &worker.DeadLetteredOperationList{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Len(t, actual.Value, 1)
+		require.Equal(t, messageIDs[0], actual.Value[0].MessageID)
+		require.Equal(t, "exceeded max retry count", actual.Value[0].Reason)
+		require.Equal(t, containerID, actual.Value[0].Request.ResourceID)
+	})
+
+	t.Run("dead-lettering not supported", func(t *testing.T) {
+		ctl, err := NewListDeadLetteredOperations(ctrl.Options{QueueClient: inmemory.NewNamedQueue("test")})
+		require.NoError(t, err)
+
+		ctx, req := newDeadLetterTestRequest(t, http.MethodGet, deadLetterTestPath, "")
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+}
+
+func TestReplayDeadLetteredOperationRun(t *testing.T) {
+	containerID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+	redisID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/test-redis"
+
+	t.Run("replays the operation", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		qu, messageIDs := newDeadLetterTestQueue(t, containerID)
+
+		var operationID uuid.UUID
+		sm := manager.NewMockStatusManager(mctrl)
+		sm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options manager.QueueOperationOptions) error {
+				require.Equal(t, containerID, sCtx.ResourceID.String())
+				operationID = sCtx.OperationID
+				return nil
+			})
+		sm.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id resources.ID, opID uuid.UUID) (*manager.Status, error) {
+				require.Equal(t, operationID, opID)
+				return &manager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{Name: opID.String(), Status: v1.ProvisioningStateAccepted}}, nil
+			})
+
+		ctl, err := NewReplayDeadLetteredOperation(ctrl.Options{QueueClient: qu, StatusManager: sm})
+		require.NoError(t, err)
+
+		path := deadLetterTestPath + "/" + messageIDs[0] + "/" + ReplayDeadLetteredOperationAction
+		ctx, req := newDeadLetterTestRequest(t, http.MethodPost, path, messageIDs[0])
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+		require.Contains(t, w.Header().Get("Location"), "/locations/global/operationstatuses/"+operationID.String())
+
+		remaining, err := qu.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, remaining)
+	})
+
+	t.Run("message of another provider namespace", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		qu, messageIDs := newDeadLetterTestQueue(t, redisID)
+
+		ctl, err := NewReplayDeadLetteredOperation(ctrl.Options{QueueClient: qu, StatusManager: manager.NewMockStatusManager(mctrl)})
+		require.NoError(t, err)
+
+		path := deadLetterTestPath + "/" + messageIDs[0] + "/" + ReplayDeadLetteredOperationAction
+		ctx, req := newDeadLetterTestRequest(t, http.MethodPost, path, messageIDs[0])
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+}
@@ -82,16 +82,16 @@ func TestDefaultAsyncDelete(t *testing.T) {
 				Times(1)
 
 			if tt.getErr == nil && !tt.rejectedByFilter && appDataModel.InternalMetadata.AsyncProvisioningState.IsTerminal() {
-				expectedOptions := statusmanager.QueueOperationOptions{
-					OperationTimeout: asyncOperationTimeout,
-					RetryAfter:       asyncOperationRetryAfter,
-				}
-				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), expectedOptions).
-					Return(tt.qErr).
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						require.Equal(t, asyncOperationTimeout, options.OperationTimeout)
+						require.Equal(t, asyncOperationRetryAfter, options.RetryAfter)
+						return queueAsyncOperation(mds, tt.qErr)(ctx, sCtx, options)
+					}).
 					Times(1)
 
 				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(tt.saveErr).
+					DoAndReturn(saveWithETag(tt.saveErr)).
 					Times(1)
 			}
 
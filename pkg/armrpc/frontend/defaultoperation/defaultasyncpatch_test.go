@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultAsyncPatch(t *testing.T) {
+	patchCases := []struct {
+		desc     string
+		curState v1.ProvisioningState
+		patch    string
+		notFound bool
+		save     bool
+		rCode    int
+	}{
+		{
+			desc:     "async-patch-success",
+			curState: v1.ProvisioningStateSucceeded,
+			patch:    `{"properties":{"propertyA":"patchedValue","propertyB":null}}`,
+			save:     true,
+			rCode:    http.StatusAccepted,
+		},
+		{
+			desc:     "async-patch-not-found",
+			patch:    `{"properties":{"propertyA":"patchedValue"}}`,
+			notFound: true,
+			rCode:    http.StatusNotFound,
+		},
+		{
+			desc:     "async-patch-in-progress",
+			curState: v1.ProvisioningStateUpdating,
+			patch:    `{"properties":{"propertyA":"patchedValue"}}`,
+			rCode:    http.StatusConflict,
+		},
+	}
+
+	for _, tt := range patchCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			dm := &TestResourceDataModel{}
+			_ = json.Unmarshal(testutil.ReadFixture("resource-datamodel.json"), dm)
+			dm.InternalMetadata.AsyncProvisioningState = tt.curState
+
+			ctx, req := newPatchRequest(t, tt.patch, "")
+			sCtx := v1.ARMRequestContextFromContext(ctx)
+
+			if tt.notFound {
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, &store.ErrNotFound{ID: sCtx.ResourceID.String()}).Times(1)
+			} else {
+				so := &store.Object{Metadata: store.Metadata{ID: sCtx.ResourceID.String(), ETag: "etag"}, Data: dm}
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).Return(so, nil).Times(1)
+			}
+
+			if tt.save {
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						return queueAsyncOperation(mds, nil)(ctx, sCtx, options)
+					}).
+					Times(1)
+
+				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						saved := obj.Data.(*TestResourceDataModel)
+						require.Equal(t, "patchedValue", saved.Properties.PropertyA)
+						// null removes the property.
+						require.Empty(t, saved.Properties.PropertyB)
+						require.Equal(t, v1.ProvisioningStateAccepted, saved.InternalMetadata.AsyncProvisioningState)
+						return saveWithETag(nil)(ctx, obj, options...)
+					}).
+					Times(1)
+			}
+
+			opts := ctrl.Options{
+				StorageClient: mds,
+				StatusManager: msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+				UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+					testValidateRequest,
+				},
+			}
+
+			ctl, err := NewDefaultAsyncPatch(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, nil, req)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.rCode, w.Result().StatusCode)
+		})
+	}
+}
@@ -99,19 +99,20 @@ func TestDefaultAsyncPut_Create(t *testing.T) {
 				Times(1)
 
 			if tt.getErr == nil || errors.Is(&store.ErrNotFound{}, tt.getErr) {
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						require.Equal(t, asyncOperationTimeout, options.OperationTimeout)
+						require.Equal(t, asyncOperationRetryAfter, options.RetryAfter)
+						require.Nil(t, options.LastGoodResource)
+						return queueAsyncOperation(mds, tt.qErr)(ctx, sCtx, options)
+					}).
+					Times(1)
+
 				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(tt.saveErr).
+					DoAndReturn(saveWithETag(tt.saveErr)).
 					Times(1)
 
 				if tt.saveErr == nil {
-					expectedOptions := statusmanager.QueueOperationOptions{
-						OperationTimeout: asyncOperationTimeout,
-						RetryAfter:       asyncOperationRetryAfter,
-					}
-					msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), expectedOptions).
-						Return(tt.qErr).
-						Times(1)
-
 					if tt.qErr != nil {
 						mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
 							Return(tt.rbErr).
@@ -214,8 +215,8 @@ func TestDefaultAsyncPut_Update(t *testing.T) {
 			&store.ErrConcurrency{},
 			nil,
 			nil,
-			http.StatusInternalServerError,
-			&store.ErrConcurrency{},
+			http.StatusConflict,
+			nil,
 		},
 		{
 			"async-update-existing-resource-save-error",
@@ -275,15 +276,21 @@ func TestDefaultAsyncPut_Update(t *testing.T) {
 				Times(1)
 
 			if tt.getErr == nil && !tt.skipSave {
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						// The stored resource was succeeded, so it is kept to roll back a canceled operation.
+						lastGood, ok := options.LastGoodResource.(map[string]any)
+						require.True(t, ok)
+						require.Equal(t, string(v1.ProvisioningStateSucceeded), lastGood["provisioningState"])
+						return queueAsyncOperation(mds, tt.qErr)(ctx, sCtx, options)
+					}).
+					Times(1)
+
 				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(tt.saveErr).
+					DoAndReturn(saveWithETag(tt.saveErr)).
 					Times(1)
 
 				if tt.saveErr == nil {
-					msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
-						Return(tt.qErr).
-						Times(1)
-
 					if tt.qErr != nil {
 						mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
 							Return(tt.rbErr).
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// DefaultSoftDelete is the controller implementation to soft-delete resource synchronously. The resource is kept as a
+// tombstone which can be restored until it is purged.
+type DefaultSoftDelete[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+}
+
+// NewDefaultSoftDelete creates a new DefaultSoftDelete.
+func NewDefaultSoftDelete[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T]) (ctrl.Controller, error) {
+	return &DefaultSoftDelete[P, T]{ctrl.NewOperation[P](opts, resourceOpts)}, nil
+}
+
+// Run executes soft deletion operation. It retrieves the resource from the store, runs custom delete filters, and then
+// marks the resource as deleted in the data store. If the resource is not found or is already deleted, a No Content
+// response is returned.
+func (e *DefaultSoftDelete[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	old, etag, err := e.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil {
+		return rest.NewNoContentResponse(), nil
+	}
+
+	if r, err := e.PrepareResource(ctx, req, nil, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	for _, filter := range e.DeleteFilters() {
+		if resp, err := filter(ctx, old, e.Options()); resp != nil || err != nil {
+			return resp, err
+		}
+	}
+
+	deletedTime := time.Now().UTC()
+	P(old).GetBaseResource().DeletedTime = &deletedTime
+	*P(old).GetSystemData() = v1.UpdateSystemData(P(old).GetSystemData(), serviceCtx.SystemData())
+
+	_, err = e.SaveResource(ctx, serviceCtx.ResourceID.String(), old, etag)
+	if errors.Is(&store.ErrConcurrency{}, err) {
+		return rest.NewConflictResponse(ctrl.ConcurrentOperationMessage), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(nil), nil
+}
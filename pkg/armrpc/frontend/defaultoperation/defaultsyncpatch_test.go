@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// newPatchRequest creates a PATCH request with the raw JSON merge patch body and the If-Match header.
+func newPatchRequest(t *testing.T, patch string, ifMatch string) (context.Context, *http.Request) {
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPatch, resourceTestHeaderFile, json.RawMessage(patch))
+	require.NoError(t, err)
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+	return rpctest.NewARMRequestContext(req), req
+}
+
+func TestDefaultSyncPatch(t *testing.T) {
+	patchCases := []struct {
+		desc     string
+		patch    string
+		ifMatch  string
+		notFound bool
+		save     bool
+		saveErr  error
+		rCode    int
+		rErr     bool
+		secret   string
+	}{
+		{
+			desc:  "sync-patch-success",
+			patch: `{"properties":{"propertyA":"patchedValue"}}`,
+			save:  true,
+			rCode: http.StatusOK,
+		},
+		{
+			desc:   "sync-patch-write-only-property",
+			patch:  `{"properties":{"propertyA":"patchedValue","secret":"newSecret"}}`,
+			save:   true,
+			rCode:  http.StatusOK,
+			secret: "newSecret",
+		},
+		{
+			desc:     "sync-patch-not-found",
+			patch:    `{"properties":{"propertyA":"patchedValue"}}`,
+			notFound: true,
+			rCode:    http.StatusNotFound,
+		},
+		{
+			desc:    "sync-patch-if-match-mismatch",
+			patch:   `{"properties":{"propertyA":"patchedValue"}}`,
+			ifMatch: "other-etag",
+			rCode:   http.StatusPreconditionFailed,
+		},
+		{
+			desc:  "sync-patch-validation-failure",
+			patch: `{"properties":{"application":"/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/applications/other"}}`,
+			rCode: http.StatusBadRequest,
+		},
+		{
+			desc:    "sync-patch-concurrency-error",
+			patch:   `{"properties":{"propertyA":"patchedValue"}}`,
+			save:    true,
+			saveErr: &store.ErrConcurrency{},
+			rCode:   http.StatusConflict,
+		},
+		{
+			desc:  "sync-patch-invalid-patch",
+			patch: `["not", "a", "merge", "patch"]`,
+			rErr:  true,
+		},
+	}
+
+	for _, tt := range patchCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			dm := &TestResourceDataModel{}
+			_ = json.Unmarshal(testutil.ReadFixture("resource-datamodel.json"), dm)
+			dm.Properties.Secret = "storedSecret"
+
+			expectedSecret := tt.secret
+			if expectedSecret == "" {
+				expectedSecret = "storedSecret"
+			}
+
+			ctx, req := newPatchRequest(t, tt.patch, tt.ifMatch)
+			sCtx := v1.ARMRequestContextFromContext(ctx)
+
+			if tt.notFound {
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, &store.ErrNotFound{ID: sCtx.ResourceID.String()}).Times(1)
+			} else {
+				so := &store.Object{Metadata: store.Metadata{ID: sCtx.ResourceID.String(), ETag: "etag"}, Data: dm}
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).Return(so, nil).Times(1)
+			}
+
+			if tt.save {
+				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						saved := obj.Data.(*TestResourceDataModel)
+						require.Equal(t, "patchedValue", saved.Properties.PropertyA)
+						// Properties that are not in the patch are kept.
+						require.Equal(t, "propertyBValue", saved.Properties.PropertyB)
+						require.Equal(t, dm.Properties.Application, saved.Properties.Application)
+						// Write-only properties are not returned by the response converter but are kept unless patched.
+						require.Equal(t, expectedSecret, saved.Properties.Secret)
+						require.Equal(t, dm.SystemData.CreatedBy, saved.SystemData.CreatedBy)
+						require.Equal(t, "etag", store.NewSaveConfig(options...).ETag)
+						return saveWithETag(tt.saveErr)(ctx, obj, options...)
+					}).
+					Times(1)
+			}
+
+			opts := ctrl.Options{
+				StorageClient: mds,
+				StatusManager: msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+				UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+					testValidateRequest,
+				},
+			}
+
+			ctl, err := NewDefaultSyncPatch(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, nil, req)
+			if tt.rErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.rCode, w.Result().StatusCode)
+
+			if tt.rCode == http.StatusOK {
+				actual := &TestResource{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+				require.Equal(t, "patchedValue", *actual.Properties.PropertyA)
+				require.Equal(t, "new-etag", w.Header().Get("ETag"))
+			}
+		})
+	}
+}
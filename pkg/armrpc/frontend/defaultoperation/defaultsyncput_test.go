@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -239,3 +240,40 @@ func TestDefaultSyncPut_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultSyncPut_Tombstone(t *testing.T) {
+	teardownTest, mds, msm := setupTest(t)
+	defer teardownTest(t)
+
+	reqModel, dataModel, _ := loadTestResurce()
+	deletedTime := time.Now()
+	dataModel.DeletedTime = &deletedTime
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, resourceTestHeaderFile, reqModel)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+		Return(&store.Object{Metadata: store.Metadata{ID: dataModel.ID, ETag: "etag"}, Data: dataModel}, nil).
+		Times(1)
+
+	opts := ctrl.Options{
+		StorageClient: mds,
+		StatusManager: msm,
+	}
+
+	resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+		RequestConverter:  testResourceDataModelFromVersioned,
+		ResponseConverter: testResourceDataModelToVersioned,
+	}
+
+	ctl, err := NewDefaultSyncPut(opts, resourceOpts)
+	require.NoError(t, err)
+
+	// The tombstone of a soft-deleted resource must be restored instead of being overwritten, so Save is not called.
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+}
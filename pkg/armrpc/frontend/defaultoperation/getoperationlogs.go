@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*GetOperationLogs)(nil)
+
+// GetOperationLogs is the controller implementation to get the execution logs of an async operation.
+type GetOperationLogs struct {
+	ctrl.BaseController
+}
+
+// NewGetOperationLogs creates a new GetOperationLogs.
+func NewGetOperationLogs(opts ctrl.Options) (ctrl.Controller, error) {
+	return &GetOperationLogs{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the execution log of an asynchronous operation, which is stored with the resource of the operation. Only
+// the entries with a sequence number greater than the value of the "since" query parameter are returned, so that the
+// log can be followed while the operation is running. It returns NotFound if the operation is not found or has no log.
+func (e *GetOperationLogs) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	// The request URL is the operation status ID followed by the "logs" action segment.
+	statusID := serviceCtx.ResourceID.Truncate()
+	id := statusID.String()
+
+	var since int64
+	if value := req.URL.Query().Get(oplog.SinceQueryParameter); value != "" {
+		var err error
+		if since, err = strconv.ParseInt(value, 10, 64); err != nil || since < 0 {
+			return rest.NewBadRequestResponse(fmt.Sprintf("The value %q of the query parameter %q must be a non-negative integer.", value, oplog.SinceQueryParameter)), nil
+		}
+	}
+
+	os := &manager.Status{}
+	_, err := e.GetResource(ctx, id, os)
+	if err != nil && errors.Is(&store.ErrNotFound{ID: id}, err) {
+		return rest.NewNotFoundResponse(statusID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	resourceID, err := resources.ParseResource(os.LinkedResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	storageClient, err := e.DataProvider().GetStorageClient(ctx, resourceID.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	log, err := oplog.NewClient(storageClient).Get(ctx, resourceID, os.Name)
+	if err != nil && errors.Is(&store.ErrNotFound{ID: oplog.LogID(resourceID, os.Name).String()}, err) {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Operation %s has no logs.", os.Name)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	entries := []oplog.Entry{}
+	for _, entry := range log.Entries {
+		if entry.Sequence > since {
+			entries = append(entries, entry)
+		}
+	}
+	log.Entries = entries
+
+	return rest.NewOKResponse(log), nil
+}
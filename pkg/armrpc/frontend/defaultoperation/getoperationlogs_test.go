@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOperationLogsRun(t *testing.T) {
+	const (
+		operationID = "00000000-0000-0000-0000-000000000000"
+		resourceID  = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/test-redis"
+		statusID    = "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Applications.Core/locations/westus/operationStatuses/" + operationID
+	)
+
+	status := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{Name: operationID, Status: v1.ProvisioningStateUpdating},
+		LinkedResourceID:     resourceID,
+	}
+
+	log := &oplog.Log{
+		ID:          resourceID + "/operationlogs/" + operationID,
+		ResourceID:  resourceID,
+		OperationID: operationID,
+		Entries: []oplog.Entry{
+			{Sequence: 1, Level: oplog.LevelInfo, Message: "Deploying recipe"},
+			{Sequence: 2, Level: oplog.LevelError, Message: "Deployment failed"},
+		},
+	}
+
+	tests := []struct {
+		desc       string
+		since      string
+		status     *manager.Status
+		log        *oplog.Log
+		statusCode int
+		sequences  []int64
+	}{
+		{
+			desc:       "operation not found",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			desc:       "log not found",
+			status:     status,
+			statusCode: http.StatusNotFound,
+		},
+		{
+			desc:       "all entries",
+			status:     status,
+			log:        log,
+			statusCode: http.StatusOK,
+			sequences:  []int64{1, 2},
+		},
+		{
+			desc:       "entries since",
+			since:      "1",
+			status:     status,
+			log:        log,
+			statusCode: http.StatusOK,
+			sequences:  []int64{2},
+		},
+		{
+			desc:       "invalid since",
+			since:      "-1",
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStatusClient := store.NewMockStorageClient(mctrl)
+			mResourceClient := store.NewMockStorageClient(mctrl)
+			mDataProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodGet, operationStatusTestHeaderFile, nil)
+			require.NoError(t, err)
+			req.URL.Path += "/" + oplog.LogsAction
+			req.Header.Set(v1.RefererHeader, "https://radapp.io"+statusID+"/"+oplog.LogsAction)
+			if tt.since != "" {
+				req.URL.RawQuery = oplog.SinceQueryParameter + "=" + tt.since
+			}
+			ctx := rpctest.NewARMRequestContext(req)
+
+			if tt.statusCode != http.StatusBadRequest {
+				mStatusClient.
+					EXPECT().
+					Get(gomock.Any(), statusID).
+					DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+						if tt.status == nil {
+							return nil, &store.ErrNotFound{ID: id}
+						}
+						return &store.Object{Metadata: store.Metadata{ID: id}, Data: tt.status}, nil
+					})
+			}
+
+			if tt.status != nil {
+				mDataProvider.
+					EXPECT().
+					GetStorageClient(gomock.Any(), "Applications.Datastores/redisCaches").
+					Return(mResourceClient, nil)
+				mResourceClient.
+					EXPECT().
+					Get(gomock.Any(), log.ID).
+					DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+						if tt.log == nil {
+							return nil, &store.ErrNotFound{ID: id}
+						}
+						return &store.Object{Metadata: store.Metadata{ID: id}, Data: tt.log}, nil
+					})
+			}
+
+			ctl, err := NewGetOperationLogs(ctrl.Options{StorageClient: mStatusClient, DataProvider: mDataProvider})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.statusCode == http.StatusOK {
+				actual := &oplog.Log{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+				sequences := []int64{}
+				for _, entry := range actual.Entries {
+					sequences = append(sequences, entry.Sequence)
+				}
+				require.Equal(t, tt.sequences, sequences)
+			}
+		})
+	}
+}
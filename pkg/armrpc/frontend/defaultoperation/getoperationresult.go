@@ -45,7 +45,7 @@ func NewGetOperationResult(opts ctrl.Options) (ctrl.Controller, error) {
 
 // Run returns the response with necessary headers about the async operation - it checks if the operation is in a terminal state,
 // and if not, returns an AsyncOperationResultResponse with the Location and Retry-After headers set. If the operation is in a
-// terminal state, it returns a NoContentResponse. If the operation is not found, it returns a NotFoundResponse. If an error occurs,
+// terminal state, it returns the result of the operation if it succeeded with one, and a NoContentResponse otherwise. If the operation is not found, it returns a NotFoundResponse. If an error occurs,
 // it returns a BadRequestResponse.
 // Spec: https://github.com/Azure/azure-resource-manager-rpc/blob/master/v1.0/async-api-reference.md#azure-asyncoperation-resource-format
 func (e *GetOperationResult) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
@@ -82,6 +82,11 @@ func (e *GetOperationResult) Run(ctx context.Context, w http.ResponseWriter, req
 		return rest.NewAsyncOperationResultResponse(headers), nil
 	}
 
+	// Operations that leave the resource unchanged, such as actions, return their result instead of the resource.
+	if os.Status == v1.ProvisioningStateSucceeded && os.Properties != nil {
+		return rest.NewOKResponse(os.Properties), nil
+	}
+
 	return rest.NewNoContentResponse(), nil
 }
 
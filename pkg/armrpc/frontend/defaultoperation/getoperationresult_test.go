@@ -141,6 +141,7 @@ func TestGetOperationResultRun(t *testing.T) {
 
 			osDataModel.Status = tt.provisioningState
 			osDataModel.RetryAfter = time.Second * 5
+			osDataModel.Properties = nil
 
 			operationStatusStoreClient.
 				EXPECT().
@@ -172,4 +173,45 @@ func TestGetOperationResultRun(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("succeeded-state-with-result", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		operationStatusStoreClient := store.NewMockStorageClient(mctrl)
+
+		dataProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+		dataProvider.EXPECT().
+			GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").
+			Return(operationStatusStoreClient, nil).
+			Times(1)
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(testcontext.New(t), http.MethodGet, operationStatusTestHeaderFile, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		status := *osDataModel
+		status.Status = v1.ProvisioningStateSucceeded
+		status.Properties = map[string]any{"changes": []any{}}
+
+		operationStatusStoreClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return &store.Object{
+					Metadata: store.Metadata{ID: id},
+					Data:     &status,
+				}, nil
+			})
+
+		ctl, err := NewGetOperationResult(ctrl.Options{
+			DataProvider: dataProvider,
+		})
+
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		require.JSONEq(t, `{"changes":[]}`, w.Body.String())
+	})
 }
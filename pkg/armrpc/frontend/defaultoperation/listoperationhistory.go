@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*ListOperationHistory)(nil)
+
+// ListOperationHistory is the controller implementation to list the operation history of a resource.
+type ListOperationHistory struct {
+	ctrl.BaseController
+}
+
+// NewListOperationHistory creates a new ListOperationHistory.
+func NewListOperationHistory(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListOperationHistory{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the audit records of the mutating requests on the resource ordered by the start time. The history of a
+// deleted resource is still returned. It returns NotFound if the resource has neither history nor exists.
+func (e *ListOperationHistory) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The request URL is {resourceId}/operations.
+	resourceID := serviceCtx.ResourceID.Truncate()
+
+	records, err := audit.NewClient(e.StorageClient()).List(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		_, err := e.StorageClient().Get(ctx, resourceID.String())
+		if errors.Is(&store.ErrNotFound{ID: resourceID.String()}, err) {
+			return rest.NewNotFoundResponse(resourceID), nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	return rest.NewOKResponse(&audit.RecordList{Value: records}), nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOperationHistoryRun(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	sc, err := boltstore.NewBoltClient(db)
+	require.NoError(t, err)
+
+	const (
+		withHistory = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/testResources/with-history"
+		noHistory   = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/testResources/no-history"
+		notFound    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/testResources/not-found"
+	)
+
+	err = audit.NewClient(sc).Append(ctx, &audit.Record{ResourceID: withHistory, OperationID: "op-1", StartTime: time.Now().UTC()})
+	require.NoError(t, err)
+	err = sc.Save(ctx, &store.Object{Metadata: store.Metadata{ID: noHistory}, Data: map[string]any{}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc       string
+		id         string
+		statusCode int
+		count      int
+	}{
+		{desc: "with history", id: withHistory, statusCode: http.StatusOK, count: 1},
+		{desc: "without history", id: noHistory, statusCode: http.StatusOK, count: 0},
+		{desc: "not found", id: notFound, statusCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			url := tt.id + "/operations"
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			rpcCtx := &v1.ARMRequestContext{ResourceID: resources.MustParse(url)}
+			reqCtx := v1.WithARMRequestContext(ctx, rpcCtx)
+
+			ctl, err := NewListOperationHistory(ctrl.Options{StorageClient: sc})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(reqCtx, nil, req)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			require.NoError(t, resp.Apply(reqCtx, w, req))
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.statusCode == http.StatusOK {
+				list := &audit.RecordList{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), list))
+				require.Len(t, list.Value, tt.count)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package defaultoperation
 import (
 	"context"
 	"net/http"
+	"strconv"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -26,6 +27,11 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
+const (
+	// IncludeDeletedQueryParam is the query parameter to include soft-deleted resources in the list.
+	IncludeDeletedQueryParam = "includeDeleted"
+)
+
 // ListResources is the controller implementation to get the list of resources in resource group.
 type ListResources[P interface {
 	*T
@@ -43,8 +49,8 @@ func NewListResources[P interface {
 	return &ListResources[P, T]{ctrl.NewOperation[P](opts, ctrlOpts), ctrlOpts.ListRecursiveQuery}, nil
 }
 
-// Run queries the resource data store with a given type and scope and returns the paginated resource list. An internal error
-// is returned if the query fails.
+// Run queries the resource data store with a given type and scope and returns the paginated resource list. Soft-deleted
+// resources are omitted unless the includeDeleted query parameter is true. An internal error is returned if the query fails.
 func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
@@ -67,6 +73,8 @@ func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, re
 func (e *ListResources[P, T]) createPaginationResponse(ctx context.Context, req *http.Request, result *store.ObjectQueryResult) (*v1.PaginatedList, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
+	includeDeleted, _ := strconv.ParseBool(req.URL.Query().Get(IncludeDeletedQueryParam))
+
 	items := []any{}
 	for _, item := range result.Items {
 		resource := new(T)
@@ -74,6 +82,10 @@ func (e *ListResources[P, T]) createPaginationResponse(ctx context.Context, req
 			return nil, err
 		}
 
+		if !includeDeleted && ctrl.IsDeleted[P](resource) {
+			continue
+		}
+
 		versioned, err := e.ResponseConverter()(resource, serviceCtx.APIVersion)
 		if err != nil {
 			return nil, err
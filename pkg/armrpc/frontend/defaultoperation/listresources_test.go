@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -184,3 +185,58 @@ func TestListResourcesRun(t *testing.T) {
 		})
 	}
 }
+
+func TestListResourcesRun_SoftDeleted(t *testing.T) {
+	_, dataModel, _ := loadTestResurce()
+	_, deletedModel, _ := loadTestResurce()
+	deletedTime := time.Now()
+	deletedModel.DeletedTime = &deletedTime
+
+	listCases := []struct {
+		desc           string
+		includeDeleted string
+		count          int
+	}{
+		{"list-without-deleted", "", 1},
+		{"list-include-deleted", "true", 2},
+	}
+
+	for _, tt := range listCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, _ := setupTest(t)
+			defer teardownTest(t)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodGet, resourceTestHeaderFile, nil)
+			require.NoError(t, err)
+
+			q := req.URL.Query()
+			q.Add(IncludeDeletedQueryParam, tt.includeDeleted)
+			req.URL.RawQuery = q.Encode()
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mds.EXPECT().
+				Query(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&store.ObjectQueryResult{
+					Items: []store.Object{
+						{Metadata: store.Metadata{ID: dataModel.ID}, Data: dataModel},
+						{Metadata: store.Metadata{ID: deletedModel.ID}, Data: deletedModel},
+					},
+				}, nil)
+
+			ctl, err := NewListResources(ctrl.Options{StorageClient: mds}, ctrl.ResourceOptions[TestResourceDataModel]{
+				ResponseConverter: testResourceDataModelToVersioned,
+			})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+			actualOutput := &v1.PaginatedList{}
+			_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+			require.Len(t, actualOutput.Value, tt.count)
+		})
+	}
+}
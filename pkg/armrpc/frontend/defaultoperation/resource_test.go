@@ -60,6 +60,8 @@ type TestResourceDataModelProperties struct {
 	Environment string `json:"environment"`
 	PropertyA   string `json:"propertyA,omitempty"`
 	PropertyB   string `json:"propertyB,omitempty"`
+	// Secret is write-only, so it is not returned by the response converter.
+	Secret string `json:"secret,omitempty"`
 }
 
 // TestResource represents test resource for api version.
@@ -80,6 +82,7 @@ type TestResourceProperties struct {
 	Application       *string               `json:"application,omitempty"`
 	PropertyA         *string               `json:"propertyA,omitempty"`
 	PropertyB         *string               `json:"propertyB,omitempty"`
+	Secret            *string               `json:"secret,omitempty"`
 }
 
 // ConvertTo converts a version specific TestResource into a version-agnostic resource, TestResourceDataModel.
@@ -103,6 +106,7 @@ func (src *TestResource) ConvertTo() (v1.DataModelInterface, error) {
 			Environment: to.String(src.Properties.Environment),
 			PropertyA:   to.String(src.Properties.PropertyA),
 			PropertyB:   to.String(src.Properties.PropertyB),
+			Secret:      to.String(src.Properties.Secret),
 		},
 	}
 	return converted, nil
@@ -217,6 +221,29 @@ func setupTest(tb testing.TB) (func(testing.TB), *store.MockStorageClient, *stat
 	}, mds, msm
 }
 
+// queueAsyncOperation returns a mock implementation of QueueAsyncOperation that commits the resource writes in the
+// options using the storage client, as the status manager does, and then returns qErr.
+func queueAsyncOperation(mds store.StorageClient, qErr error) func(context.Context, *v1.ARMRequestContext, statusmanager.QueueOperationOptions) error {
+	return func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+		if err := store.Transact(ctx, mds, options.ResourceOps); err != nil {
+			return err
+		}
+
+		return qErr
+	}
+}
+
+// saveWithETag returns a mock implementation of Save that sets the ETag of the object when err is nil.
+func saveWithETag(err error) func(context.Context, *store.Object, ...store.SaveOptions) error {
+	return func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+		if err == nil {
+			obj.ETag = "new-etag"
+		}
+
+		return err
+	}
+}
+
 // TODO: Use Referer header instead of X-Forwarded-Proto by following ARM RPC spec - https://github.com/radius-project/radius/issues/3068
 func getAsyncLocationPath(sCtx *v1.ARMRequestContext, location string, resourceType string, req *http.Request) string {
 	dest := url.URL{
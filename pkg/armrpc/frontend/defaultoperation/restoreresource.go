@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// RestoreAction is the name of the action to restore a soft-deleted resource.
+	RestoreAction = "restore"
+)
+
+// RestoreResource is the controller implementation to restore a soft-deleted resource.
+type RestoreResource[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+}
+
+// NewRestoreResource creates a new RestoreResource.
+func NewRestoreResource[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T]) (ctrl.Controller, error) {
+	return &RestoreResource[P, T]{ctrl.NewOperation[P](opts, resourceOpts)}, nil
+}
+
+// Run restores the soft-deleted resource and returns it. If the resource is not deleted, it is returned as is. NotFound
+// is returned if the resource does not exist or has been purged.
+func (e *RestoreResource[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	obj, err := e.StorageClient().Get(ctx, serviceCtx.ResourceID.String())
+	if errors.Is(&store.ErrNotFound{ID: serviceCtx.ResourceID.String()}, err) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	resource := new(T)
+	if err := obj.As(resource); err != nil {
+		return nil, err
+	}
+
+	if err := ctrl.ValidateETag(*serviceCtx, obj.ETag); err != nil {
+		return rest.NewPreconditionFailedResponse(serviceCtx.ResourceID.String(), err.Error()), nil
+	}
+
+	if !ctrl.IsDeleted[P](resource) {
+		return e.ConstructSyncResponse(ctx, req.Method, obj.ETag, resource)
+	}
+
+	P(resource).GetBaseResource().DeletedTime = nil
+	*P(resource).GetSystemData() = v1.UpdateSystemData(P(resource).GetSystemData(), serviceCtx.SystemData())
+
+	etag, err := e.SaveResource(ctx, serviceCtx.ResourceID.String(), resource, obj.ETag)
+	if errors.Is(&store.ErrConcurrency{}, err) {
+		return rest.NewConflictResponse(ctrl.ConcurrentOperationMessage), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return e.ConstructSyncResponse(ctx, req.Method, etag, resource)
+}
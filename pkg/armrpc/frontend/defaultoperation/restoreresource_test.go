@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreResource(t *testing.T) {
+	restoreCases := []struct {
+		desc    string
+		deleted bool
+		getErr  error
+		code    int
+	}{
+		{"restore-deleted-resource", true, nil, http.StatusOK},
+		{"restore-not-deleted-resource", false, nil, http.StatusOK},
+		{"restore-non-existing-resource", false, &store.ErrNotFound{}, http.StatusNotFound},
+	}
+
+	for _, tt := range restoreCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, resourceTestHeaderFile, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			_, dataModel, _ := loadTestResurce()
+			if tt.deleted {
+				deletedTime := time.Now()
+				dataModel.DeletedTime = &deletedTime
+			}
+
+			if tt.getErr != nil {
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, tt.getErr).Times(1)
+			} else {
+				mds.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(&store.Object{
+						Metadata: store.Metadata{ID: dataModel.ID, ETag: "etag"},
+						Data:     dataModel,
+					}, nil).
+					Times(1)
+			}
+
+			if tt.deleted {
+				mds.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+						require.Equal(t, "etag", store.NewSaveConfig(opts...).ETag)
+						require.Nil(t, obj.Data.(*TestResourceDataModel).DeletedTime)
+						obj.ETag = "new-etag"
+						return nil
+					}).
+					Times(1)
+			}
+
+			opts := ctrl.Options{
+				StorageClient: mds,
+				StatusManager: msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+			}
+
+			ctl, err := NewRestoreResource(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+
+			err = resp.Apply(ctx, w, req)
+			require.NoError(t, err)
+			require.Equal(t, tt.code, w.Result().StatusCode)
+
+			if tt.code == http.StatusOK {
+				actual := &TestResource{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+				require.Equal(t, dataModel.ID, *actual.ID)
+			}
+			if tt.deleted {
+				require.Equal(t, "new-etag", w.Header().Get("ETag"))
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// auditedMethods is the set of operation methods that mutate resources and are recorded in the operation history.
+var auditedMethods = map[v1.OperationMethod]struct{}{
+	v1.OperationPut:              {},
+	v1.OperationPatch:            {},
+	v1.OperationDelete:           {},
+	v1.OperationPutImperative:    {},
+	v1.OperationDeleteImperative: {},
+}
+
+// statusRecorder records the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+// WriteHeader records the status code and writes it to the underlying response writer.
+func (s *statusRecorder) WriteHeader(statusCode int) {
+	if s.statusCode == 0 {
+		s.statusCode = statusCode
+	}
+	s.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write writes the data to the underlying response writer. The status code is 200 if the header was not written.
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.statusCode == 0 {
+		s.statusCode = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying response writer.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// auditHandler wraps the handler of a mutating operation to append a record to the operation history of the resource.
+// The record captures the hash of the stored resource before and after the request. Async operations are recorded
+// when they are accepted, and the status manager records their outcome when they complete. The expired records and the
+// records exceeding the maximum history of the resource type are swept in the background.
+//
+// Failures to record the history are logged and do not fail the request.
+func auditHandler(storageClient store.StorageClient, handler http.HandlerFunc) http.HandlerFunc {
+	auditClient := audit.NewClient(storageClient)
+	return func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		rpcCtx := v1.ARMRequestContextFromContext(ctx)
+		if rpcCtx == nil || !rpcCtx.ResourceID.IsResource() {
+			handler(w, req)
+			return
+		}
+
+		startTime := time.Now()
+		beforeHash, beforeErr := resourceHash(ctx, storageClient, rpcCtx.ResourceID.String())
+
+		recorder := &statusRecorder{ResponseWriter: w}
+		handler(recorder, req)
+
+		logger := ucplog.FromContextOrDiscard(ctx)
+		afterHash, afterErr := resourceHash(ctx, storageClient, rpcCtx.ResourceID.String())
+		if err := errors.Join(beforeErr, afterErr); err != nil {
+			logger.Error(err, "failed to compute the resource hash for the operation history")
+		}
+
+		statusCode := recorder.statusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+
+		record := &audit.Record{
+			ResourceID:    rpcCtx.ResourceID.String(),
+			OperationID:   rpcCtx.OperationID.String(),
+			OperationType: rpcCtx.OperationType.String(),
+			Caller: audit.Caller{
+				PrincipalName: rpcCtx.ClientPrincipalName,
+				PrincipalID:   rpcCtx.ClientPrincipalID,
+				ObjectID:      rpcCtx.ClientObjectID,
+				TenantID:      rpcCtx.HomeTenantID,
+				UserAgent:     rpcCtx.UserAgent,
+			},
+			BeforeHash:           beforeHash,
+			AfterHash:            afterHash,
+			StatusCode:           statusCode,
+			Result:               audit.ResultFromResponse(statusCode, recorder.Header()),
+			StartTime:            startTime.UTC(),
+			DurationMilliseconds: time.Since(startTime).Milliseconds(),
+		}
+
+		// The request context may be canceled once the response is written, so the record is saved without it.
+		if err := auditClient.Append(context.WithoutCancel(ctx), record); err != nil {
+			logger.Error(err, "failed to append the operation history record", "resourceId", record.ResourceID)
+		}
+
+		go func(ctx context.Context) {
+			if err := auditClient.Sweep(ctx, rpcCtx.ResourceID.PlaneScope(), rpcCtx.ResourceID.Type()); err != nil {
+				logger.Error(err, "failed to sweep the operation history records", "resourceType", rpcCtx.ResourceID.Type())
+			}
+		}(context.WithoutCancel(ctx))
+	}
+}
+
+// resourceHash returns the hash of the stored resource, or empty string if the resource does not exist.
+func resourceHash(ctx context.Context, storageClient store.StorageClient, id string) (string, error) {
+	obj, err := storageClient.Get(ctx, id)
+	if errors.Is(&store.ErrNotFound{ID: id}, err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return audit.Hash(obj)
+}
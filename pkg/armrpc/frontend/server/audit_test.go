@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AuditHandler(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	sc, err := boltstore.NewBoltClient(db)
+	require.NoError(t, err)
+
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/testResources/test-resource")
+
+	serve := func(t *testing.T, method v1.OperationMethod, handler http.HandlerFunc) {
+		rpcCtx := &v1.ARMRequestContext{
+			ResourceID:          id,
+			OperationID:         uuid.New(),
+			OperationType:       v1.OperationType{Type: "Applications.Test/testResources", Method: method},
+			ClientPrincipalName: "test-user",
+		}
+		req := httptest.NewRequest(method.HTTPMethod(), id.String(), nil).WithContext(v1.WithARMRequestContext(ctx, rpcCtx))
+		auditHandler(sc, handler).ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	serve(t, v1.OperationPut, func(w http.ResponseWriter, req *http.Request) {
+		err := sc.Save(req.Context(), &store.Object{Metadata: store.Metadata{ID: id.String()}, Data: map[string]any{"name": "test-resource"}})
+		require.NoError(t, err)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	serve(t, v1.OperationPatch, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	serve(t, v1.OperationDelete, func(w http.ResponseWriter, req *http.Request) {
+		err := sc.Delete(req.Context(), id.String())
+		require.NoError(t, err)
+		w.Header().Set("Azure-AsyncOperation", "http://localhost/operationStatuses/1")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	records, err := audit.NewClient(sc).List(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	put, patch, del := records[0], records[1], records[2]
+	require.Equal(t, "APPLICATIONS.TEST/TESTRESOURCES|PUT", put.OperationType)
+	require.Equal(t, "test-user", put.Caller.PrincipalName)
+	require.Equal(t, http.StatusOK, put.StatusCode)
+	require.Equal(t, audit.ResultSucceeded, put.Result)
+	require.Empty(t, put.BeforeHash)
+	require.NotEmpty(t, put.AfterHash)
+
+	require.Equal(t, audit.ResultFailed, patch.Result)
+	require.Equal(t, put.AfterHash, patch.BeforeHash)
+	require.Equal(t, patch.BeforeHash, patch.AfterHash)
+
+	require.Equal(t, audit.ResultAccepted, del.Result)
+	require.Equal(t, put.AfterHash, del.BeforeHash)
+	require.Empty(t, del.AfterHash)
+}
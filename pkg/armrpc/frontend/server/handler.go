@@ -29,6 +29,7 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
@@ -165,6 +166,12 @@ func RegisterHandler(ctx context.Context, opts HandlerOptions, ctrlOpts ctrl.Opt
 	}
 
 	handler := HandlerForController(ctrl, *opts.OperationType)
+
+	// Mutating operations on a resource type are recorded in the operation history of the resource.
+	if _, ok := auditedMethods[opts.OperationType.Method]; ok && opts.ResourceType != "" && storageClient != nil {
+		handler = auditHandler(storageClient, handler)
+	}
+
 	namedRouter := opts.ParentRouter.With(opts.Middlewares...)
 	if opts.Path == CatchAllPath {
 		namedRouter.HandleFunc(opts.Path, handler)
@@ -189,8 +196,9 @@ func withOtelLabelsForRequest(req *http.Request) {
 	}
 }
 
-// ConfigureDefaultHandlers registers handlers for the default operations such as getting operationStatuses and
-// operationResults, and updating a subscription lifecycle. It returns an error if any of the handler registrations fail.
+// ConfigureDefaultHandlers registers handlers for the default operations such as getting, canceling or getting the logs of
+// operationStatuses, getting operationResults, listing and replaying dead-lettered operations, and updating a
+// subscription lifecycle. It returns an error if any of the handler registrations fail.
 func ConfigureDefaultHandlers(
 	ctx context.Context,
 	rootRouter chi.Router,
@@ -241,6 +249,52 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              opStatus + "/" + defaultoperation.CancelOperationAction,
+		ResourceType:      statusRT,
+		Method:            v1.OperationCancel,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              opStatus + "/" + oplog.LogsAction,
+		ResourceType:      statusRT,
+		Method:            v1.OperationLogs,
+		ControllerFactory: defaultoperation.NewGetOperationLogs,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
+	deadLetterRT := providerNamespace + "/" + defaultoperation.DeadLetteredOperationsSegment
+	deadLetter := fmt.Sprintf("%s/providers/%s/locations/{location}/%s", rootScopePath, providerNamespace, defaultoperation.DeadLetteredOperationsSegment)
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              deadLetter,
+		ResourceType:      deadLetterRT,
+		Method:            v1.OperationListDeadLetter,
+		ControllerFactory: defaultoperation.NewListDeadLetteredOperations,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/{%s}/%s", deadLetter, defaultoperation.DeadLetteredMessageIDParam, defaultoperation.ReplayDeadLetteredOperationAction),
+		ResourceType:      deadLetterRT,
+		Method:            v1.OperationReplayDeadLetter,
+		ControllerFactory: defaultoperation.NewReplayDeadLetteredOperation,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
 	opResult := fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, providerNamespace)
 	err = RegisterHandler(ctx, HandlerOptions{
 		ParentRouter:      rootRouter,
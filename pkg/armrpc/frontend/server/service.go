@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	controller_runtime "sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,6 +46,9 @@ type Service struct {
 	// OperationStatusManager is the manager of the operation status.
 	OperationStatusManager manager.StatusManager
 
+	// QueueClient is the client of the queue that the async operations are queued to.
+	QueueClient queue.Client
+
 	// ARMCertManager is the certificate manager of client cert authentication.
 	ARMCertManager *authentication.ArmCertManager
 
@@ -63,6 +67,7 @@ func (s *Service) Init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	s.QueueClient = reqQueueClient
 	s.OperationStatusManager = manager.New(s.StorageProvider, reqQueueClient, s.Options.Config.Env.RoleLocation)
 	s.KubeClient, err = kubeutil.NewRuntimeClient(s.Options.K8sConfig)
 	if err != nil {
@@ -17,6 +17,8 @@ limitations under the License.
 package hostoptions
 
 import (
+	"time"
+
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	profilerprovider "github.com/radius-project/radius/pkg/profiler/provider"
 	"github.com/radius-project/radius/pkg/trace"
@@ -43,6 +45,8 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                    `yaml:"logging"`
 	Bicep            BicepOptions                             `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                         `yaml:"terraform,omitempty"`
+	SoftDelete       SoftDeleteOptions                        `yaml:"softDelete,omitempty"`
+	DriftDetection   DriftDetectionOptions                    `yaml:"driftDetection,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -83,4 +87,35 @@ type BicepOptions struct {
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string `yaml:"path,omitempty"`
+
+	// Cache configures the cache of Terraform provider plugins and modules shared by the recipe executions.
+	Cache TerraformCacheOptions `yaml:"cache,omitempty"`
+}
+
+// TerraformCacheOptions includes options for the cache of Terraform provider plugins and modules.
+type TerraformCacheOptions struct {
+	// Enabled enables the cache.
+	Enabled bool `yaml:"enabled,omitempty"`
+	// MaxSizeMB is the maximum size of the cache in megabytes. The least recently used entries are evicted when
+	// the cache exceeds it. The cache is unbounded if it is zero.
+	MaxSizeMB int64 `yaml:"maxSizeMB,omitempty"`
+}
+
+// SoftDeleteOptions includes options for soft deletion of applications and environments.
+type SoftDeleteOptions struct {
+	// Enabled keeps deleted applications and environments as restorable tombstones and runs the background purger.
+	// Deleted applications and environments are removed right away if it is not set.
+	Enabled bool `yaml:"enabled,omitempty"`
+	// RetentionPeriod is how long soft-deleted resources are kept before they are purged, for example "168h".
+	RetentionPeriod time.Duration `yaml:"retentionPeriod,omitempty"`
+	// PurgeInterval is how often soft-deleted resources are checked for expiry, for example "1h".
+	PurgeInterval time.Duration `yaml:"purgeInterval,omitempty"`
+}
+
+// DriftDetectionOptions includes options for detecting drift of the resources deployed by recipes.
+type DriftDetectionOptions struct {
+	// Enabled enables the background drift checker for portable resources.
+	Enabled bool `yaml:"enabled,omitempty"`
+	// Interval is how often the resources deployed by recipes are checked for drift, for example "1h".
+	Interval time.Duration `yaml:"interval,omitempty"`
 }
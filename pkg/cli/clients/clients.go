@@ -23,7 +23,10 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
 	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
 	ucpresources "github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -108,6 +111,23 @@ type GatewayStatus struct {
 	Endpoint string
 }
 
+// ResourceDriftStatus is the result of the last drift check of the resources deployed by the recipe of a resource.
+type ResourceDriftStatus struct {
+	Name            string                `json:"name"`
+	Type            string                `json:"type"`
+	State           string                `json:"state"`
+	Changes         []ResourceDriftChange `json:"changes,omitempty"`
+	Message         string                `json:"message,omitempty"`
+	LastCheckedTime string                `json:"lastCheckedTime,omitempty"`
+}
+
+// ResourceDriftChange is a drifted resource deployed by the recipe of a resource.
+type ResourceDriftChange struct {
+	ID         string `json:"id"`
+	Type       string `json:"type,omitempty"`
+	ChangeType string `json:"changeType"`
+}
+
 type EndpointOptions struct {
 	ResourceID ucpresources.ID
 }
@@ -144,6 +164,18 @@ type ApplicationsManagementClient interface {
 	ListAllResourcesByEnvironment(ctx context.Context, environmentName string) ([]generated.GenericResource, error)
 	ShowResource(ctx context.Context, resourceType string, resourceName string) (generated.GenericResource, error)
 	DeleteResource(ctx context.Context, resourceType string, resourceName string) (bool, error)
+
+	// ListResourceOperationHistory lists the operation history of a resource ordered by the start time.
+	ListResourceOperationHistory(ctx context.Context, resourceType string, resourceName string) ([]*audit.Record, error)
+
+	// GetOperationLogs gets the execution log of an asynchronous operation on a resource of the given type. Only the
+	// entries with a sequence number greater than since are returned.
+	GetOperationLogs(ctx context.Context, resourceType string, operationID string, since int64) (*oplog.Log, error)
+
+	// PlanResourceRecipe plans the changes that the recipe of the resource would make. The resource definition is
+	// planned if it is not nil, otherwise the recipe of the deployed resource is planned.
+	PlanResourceRecipe(ctx context.Context, resourceType string, resourceName string, resource map[string]any) (*recipes.RecipePlan, error)
+
 	ListApplications(ctx context.Context) ([]corerp.ApplicationResource, error)
 	ShowApplication(ctx context.Context, applicationName string) (corerp.ApplicationResource, error)
 
@@ -153,7 +185,12 @@ type ApplicationsManagementClient interface {
 	// CreateApplicationIfNotFound creates an application if it does not exist.
 	CreateApplicationIfNotFound(ctx context.Context, applicationName string, resource corerp.ApplicationResource) error
 
+	// DeleteApplication deletes an application. Its resources are deleted too unless the server has soft delete enabled.
 	DeleteApplication(ctx context.Context, applicationName string) (bool, error)
+
+	// RestoreApplication restores a soft-deleted application.
+	RestoreApplication(ctx context.Context, applicationName string) (corerp.ApplicationResource, error)
+
 	CreateEnvironment(ctx context.Context, envName string, location string, envProperties *corerp.EnvironmentProperties) error
 
 	// ListEnvironmentsInResourceGroup lists all environments in the configured scope (assumes configured scope is a resource group)
@@ -162,7 +199,13 @@ type ApplicationsManagementClient interface {
 	// ListEnvironmentsAll lists all environments across resource groups.
 	ListEnvironmentsAll(ctx context.Context) ([]corerp.EnvironmentResource, error)
 	GetEnvDetails(ctx context.Context, envName string) (corerp.EnvironmentResource, error)
+
+	// DeleteEnv deletes an environment. Its applications are deleted too unless the server has soft delete enabled.
 	DeleteEnv(ctx context.Context, envName string) (bool, error)
+
+	// RestoreEnv restores a soft-deleted environment.
+	RestoreEnv(ctx context.Context, envName string) (corerp.EnvironmentResource, error)
+
 	CreateUCPGroup(ctx context.Context, planeType string, planeName string, resourceGroupName string, resourceGroup ucp_v20231001preview.ResourceGroupResource) error
 	DeleteUCPGroup(ctx context.Context, planeType string, planeName string, resourceGroupName string) (bool, error)
 	ShowUCPGroup(ctx context.Context, planeType string, planeName string, resourceGroupName string) (ucp_v20231001preview.ResourceGroupResource, error)
@@ -18,13 +18,21 @@ package clients
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	armruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"golang.org/x/sync/errgroup"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
@@ -37,7 +45,10 @@ import (
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
 	ucpv20231001 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
@@ -64,6 +75,18 @@ var (
 		cntr_ctrl.ResourceTypeName,
 		sstr_ctrl.ResourceTypeName,
 	}
+
+	// RecipeResourceTypesList is the list of resource types that can be provisioned by recipes.
+	RecipeResourceTypesList = []string{
+		ds_ctrl.MongoDatabasesResourceType,
+		msg_ctrl.RabbitMQQueuesResourceType,
+		ds_ctrl.RedisCachesResourceType,
+		ds_ctrl.SqlDatabasesResourceType,
+		dapr_ctrl.DaprStateStoresResourceType,
+		dapr_ctrl.DaprSecretStoresResourceType,
+		dapr_ctrl.DaprPubSubBrokersResourceType,
+		ext_ctrl.ResourceTypeName,
+	}
 )
 
 // ListAllResourcesByType lists the all the resources within a scope
@@ -210,6 +233,138 @@ func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context,
 	return respFromCtx.StatusCode != 204, nil
 }
 
+// ListResourceOperationHistory retrieves the operation history of the resource, which includes the deleted resources,
+// and returns the records ordered by the start time, or an error if one occurs.
+func (amc *UCPApplicationsManagementClient) ListResourceOperationHistory(ctx context.Context, resourceType string, resourceName string) ([]*audit.Record, error) {
+	pipeline, req, err := amc.newResourceActionRequest(ctx, http.MethodGet, resourceType, resourceName, audit.OperationsTypeSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	result := audit.RecordList{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+// GetOperationLogs gets the execution log of an asynchronous operation on a resource of the given type. Only the
+// entries with a sequence number greater than since are returned.
+func (amc *UCPApplicationsManagementClient) GetOperationLogs(ctx context.Context, resourceType string, operationID string, since int64) (*oplog.Log, error) {
+	scope, err := resources.ParseScope("/" + amc.RootScope)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, _, _ := strings.Cut(resourceType, "/")
+	urlPath := scope.PlaneScope() + "/providers/" + namespace + "/locations/" + v1.LocationGlobal + "/operationstatuses/" + url.PathEscape(operationID) + "/" + oplog.LogsAction
+	pipeline, req, err := amc.newRequest(ctx, http.MethodGet, urlPath)
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set(oplog.SinceQueryParameter, strconv.FormatInt(since, 10))
+	req.Raw().URL.RawQuery = reqQP.Encode()
+
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	result := &oplog.Log{}
+	if err := runtime.UnmarshalAsJSON(resp, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// PlanResourceRecipe plans the changes that the recipe of the resource would make. The resource definition is planned
+// if it is not nil, otherwise the recipe of the deployed resource is planned. The plan is computed in an async operation,
+// which is polled until it completes.
+func (amc *UCPApplicationsManagementClient) PlanResourceRecipe(ctx context.Context, resourceType string, resourceName string, resource map[string]any) (*recipes.RecipePlan, error) {
+	pipeline, req, err := amc.newResourceActionRequest(ctx, http.MethodPost, resourceType, resourceName, recipes.PlanRecipeAction)
+	if err != nil {
+		return nil, err
+	}
+	if resource != nil {
+		if err := runtime.MarshalAsJSON(req, resource); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusAccepted) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	poller, err := runtime.NewPoller(resp, pipeline, &runtime.NewPollerOptions[recipes.RecipePlan]{
+		FinalStateVia: runtime.FinalStateViaLocation,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// newResourceActionRequest creates the pipeline and the request for an action of the resource that is not described
+// by the generated clients.
+func (amc *UCPApplicationsManagementClient) newResourceActionRequest(ctx context.Context, method string, resourceType string, resourceName string, action string) (runtime.Pipeline, *policy.Request, error) {
+	urlPath := "/" + amc.RootScope + "/providers/" + resourceType + "/" + url.PathEscape(resourceName) + "/" + action
+	return amc.newRequest(ctx, method, urlPath)
+}
+
+// newRequest creates a request for the given path relative to the endpoint of the management client, and the
+// pipeline to send it.
+func (amc *UCPApplicationsManagementClient) newRequest(ctx context.Context, method string, urlPath string) (runtime.Pipeline, *policy.Request, error) {
+	options := amc.ClientOptions
+	if options == nil {
+		options = &arm.ClientOptions{}
+	}
+
+	pipeline, err := armruntime.NewPipeline(clientv2.ModuleName, clientv2.ModuleVersion, &aztoken.AnonymousCredential{}, runtime.PipelineOptions{}, options)
+	if err != nil {
+		return runtime.Pipeline{}, nil, err
+	}
+
+	host := cloud.AzurePublic.Services[cloud.ResourceManager].Endpoint
+	if c, ok := options.Cloud.Services[cloud.ResourceManager]; ok {
+		host = c.Endpoint
+	}
+
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(host, urlPath))
+	if err != nil {
+		return runtime.Pipeline{}, nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", corerpv20231001.Version)
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	return pipeline, req, nil
+}
+
 // ListApplications() retrieves a list of ApplicationResource objects from the Azure API
 // and returns them in a slice, or an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListApplications(ctx context.Context) ([]corerpv20231001.ApplicationResource, error) {
@@ -269,34 +424,44 @@ func (amc *UCPApplicationsManagementClient) ShowApplication(ctx context.Context,
 	return result, nil
 }
 
-// DeleteApplication deletes an application and all its associated resources, and returns an error if any of the operations fail.
+// DeleteApplication deletes an application, and returns an error if any of the operations fail. If the server has soft
+// delete enabled, the application is kept as deleted until the server's retention period expires and its resources keep
+// running until then, so it can be brought back with RestoreApplication. Otherwise all its associated resources are
+// deleted before the application.
 func (amc *UCPApplicationsManagementClient) DeleteApplication(ctx context.Context, applicationName string) (bool, error) {
-	// This handles the case where the application doesn't exist.
-	resourcesWithApplication, err := amc.ListAllResourcesByApplication(ctx, applicationName)
-	if err != nil && !clientv2.Is404Error(err) {
+	client, err := corerpv20231001.NewApplicationsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
 		return false, err
 	}
 
-	g, groupCtx := errgroup.WithContext(ctx)
-	for _, resource := range resourcesWithApplication {
-		resource := resource
-		g.Go(func() error {
-			_, err := amc.DeleteResource(groupCtx, *resource.Type, *resource.Name)
-			if err != nil {
-				return err
-			}
-			return nil
-		})
-	}
-
-	err = g.Wait()
+	softDelete, err := amc.applicationSoftDeleteEnabled(ctx, client, applicationName)
 	if err != nil {
 		return false, err
 	}
 
-	client, err := corerpv20231001.NewApplicationsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
-	if err != nil {
-		return false, err
+	if !softDelete {
+		// This handles the case where the application doesn't exist.
+		resourcesWithApplication, err := amc.ListAllResourcesByApplication(ctx, applicationName)
+		if err != nil && !clientv2.Is404Error(err) {
+			return false, err
+		}
+
+		g, groupCtx := errgroup.WithContext(ctx)
+		for _, resource := range resourcesWithApplication {
+			resource := resource
+			g.Go(func() error {
+				_, err := amc.DeleteResource(groupCtx, *resource.Type, *resource.Name)
+				if err != nil {
+					return err
+				}
+				return nil
+			})
+		}
+
+		err = g.Wait()
+		if err != nil {
+			return false, err
+		}
 	}
 
 	var respFromCtx *http.Response
@@ -310,6 +475,43 @@ func (amc *UCPApplicationsManagementClient) DeleteApplication(ctx context.Contex
 	return respFromCtx.StatusCode != 204, nil
 }
 
+// applicationSoftDeleteEnabled returns true if the server keeps deleted applications for restore. The restore action is
+// registered only when soft delete is enabled, and it returns a live application as is. An application that does not
+// exist or is already deleted is reported as soft-deleted so that the resources of a deleted application are kept
+// for restore.
+func (amc *UCPApplicationsManagementClient) applicationSoftDeleteEnabled(ctx context.Context, client *corerpv20231001.ApplicationsClient, applicationName string) (bool, error) {
+	_, err := client.Get(ctx, applicationName, nil)
+	if clientv2.Is404Error(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	_, err = client.Restore(ctx, applicationName, map[string]any{}, nil)
+	if isActionNotFoundError(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RestoreApplication restores a soft-deleted application and returns the restored resource.
+func (amc *UCPApplicationsManagementClient) RestoreApplication(ctx context.Context, applicationName string) (corerpv20231001.ApplicationResource, error) {
+	client, err := corerpv20231001.NewApplicationsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return corerpv20231001.ApplicationResource{}, err
+	}
+
+	response, err := client.Restore(ctx, applicationName, map[string]any{}, nil)
+	if err != nil {
+		return corerpv20231001.ApplicationResource{}, err
+	}
+
+	return response.ApplicationResource, nil
+}
+
 // CreateOrUpdateApplication creates or updates an application.
 //
 
@@ -505,24 +707,33 @@ func (amc *UCPApplicationsManagementClient) GetEnvDetails(ctx context.Context, e
 
 }
 
-// DeleteEnv function checks if there are any applications associated with the given environment, deletes them if found,
-// and then deletes the environment itself. It returns a boolean and an error if one occurs.
+// DeleteEnv deletes an environment, and returns an error if any of the operations fail. If the server has soft delete
+// enabled, the environment is kept as deleted until the server's retention period expires and its applications and
+// resources keep running until then, so it can be brought back with RestoreEnv. Otherwise the applications in the
+// environment are deleted before the environment.
 func (amc *UCPApplicationsManagementClient) DeleteEnv(ctx context.Context, envName string) (bool, error) {
-	applicationsWithEnv, err := amc.ListApplicationsByEnv(ctx, envName)
+	envClient, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return false, err
+	}
+
+	softDelete, err := amc.environmentSoftDeleteEnabled(ctx, envClient, envName)
 	if err != nil {
 		return false, err
 	}
 
-	for _, application := range applicationsWithEnv {
-		_, err := amc.DeleteApplication(ctx, *application.Name)
+	if !softDelete {
+		applicationsWithEnv, err := amc.ListApplicationsByEnv(ctx, envName)
 		if err != nil {
 			return false, err
 		}
-	}
 
-	envClient, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
-	if err != nil {
-		return false, err
+		for _, application := range applicationsWithEnv {
+			_, err := amc.DeleteApplication(ctx, *application.Name)
+			if err != nil {
+				return false, err
+			}
+		}
 	}
 
 	var respFromCtx *http.Response
@@ -536,6 +747,51 @@ func (amc *UCPApplicationsManagementClient) DeleteEnv(ctx context.Context, envNa
 	return respFromCtx.StatusCode != 204, nil
 }
 
+// environmentSoftDeleteEnabled returns true if the server keeps deleted environments for restore. See
+// applicationSoftDeleteEnabled for how this is detected.
+func (amc *UCPApplicationsManagementClient) environmentSoftDeleteEnabled(ctx context.Context, envClient *corerpv20231001.EnvironmentsClient, envName string) (bool, error) {
+	_, err := envClient.Get(ctx, envName, nil)
+	if clientv2.Is404Error(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	_, err = envClient.Restore(ctx, envName, map[string]any{}, nil)
+	if isActionNotFoundError(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// isActionNotFoundError returns true if the error reports that the server does not serve the requested action.
+func isActionNotFoundError(err error) bool {
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+
+	return respErr.StatusCode == http.StatusNotFound || respErr.StatusCode == http.StatusMethodNotAllowed
+}
+
+// RestoreEnv restores a soft-deleted environment and returns the restored resource.
+func (amc *UCPApplicationsManagementClient) RestoreEnv(ctx context.Context, envName string) (corerpv20231001.EnvironmentResource, error) {
+	envClient, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return corerpv20231001.EnvironmentResource{}, err
+	}
+
+	response, err := envClient.Restore(ctx, envName, map[string]any{}, nil)
+	if err != nil {
+		return corerpv20231001.EnvironmentResource{}, err
+	}
+
+	return response.EnvironmentResource, nil
+}
+
 // CreateUCPGroup creates a new resource group in the specified plane type and plane name using the provided resource
 // group resource and returns an error if one occurs.
 func (amc *UCPApplicationsManagementClient) CreateUCPGroup(ctx context.Context, planeType string, planeName string, resourceGroupName string, resourceGroup ucpv20231001.ResourceGroupResource) error {
@@ -11,7 +11,10 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	recipes "github.com/radius-project/radius/pkg/recipes"
 	v20231001preview0 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	audit "github.com/radius-project/radius/pkg/ucp/audit"
+	oplog "github.com/radius-project/radius/pkg/ucp/oplog"
 )
 
 // MockApplicationsManagementClient is a mock of ApplicationsManagementClient interface.
@@ -168,6 +171,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) GetEnvDetails(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvDetails", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetEnvDetails), arg0, arg1)
 }
 
+// GetOperationLogs mocks base method.
+func (m *MockApplicationsManagementClient) GetOperationLogs(arg0 context.Context, arg1, arg2 string, arg3 int64) (*oplog.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationLogs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*oplog.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationLogs indicates an expected call of GetOperationLogs.
+func (mr *MockApplicationsManagementClientMockRecorder) GetOperationLogs(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationLogs", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetOperationLogs), arg0, arg1, arg2, arg3)
+}
+
 // ListAllResourcesByApplication mocks base method.
 func (m *MockApplicationsManagementClient) ListAllResourcesByApplication(arg0 context.Context, arg1 string) ([]generated.GenericResource, error) {
 	m.ctrl.T.Helper()
@@ -288,6 +306,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListEnvironmentsInResour
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironmentsInResourceGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListEnvironmentsInResourceGroup), arg0)
 }
 
+// ListResourceOperationHistory mocks base method.
+func (m *MockApplicationsManagementClient) ListResourceOperationHistory(arg0 context.Context, arg1, arg2 string) ([]*audit.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceOperationHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*audit.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceOperationHistory indicates an expected call of ListResourceOperationHistory.
+func (mr *MockApplicationsManagementClientMockRecorder) ListResourceOperationHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceOperationHistory", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListResourceOperationHistory), arg0, arg1, arg2)
+}
+
 // ListUCPGroup mocks base method.
 func (m *MockApplicationsManagementClient) ListUCPGroup(arg0 context.Context, arg1, arg2 string) ([]v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +336,51 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListUCPGroup(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListUCPGroup), arg0, arg1, arg2)
 }
 
+// PlanResourceRecipe mocks base method.
+func (m *MockApplicationsManagementClient) PlanResourceRecipe(arg0 context.Context, arg1, arg2 string, arg3 map[string]interface{}) (*recipes.RecipePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanResourceRecipe", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*recipes.RecipePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanResourceRecipe indicates an expected call of PlanResourceRecipe.
+func (mr *MockApplicationsManagementClientMockRecorder) PlanResourceRecipe(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanResourceRecipe", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PlanResourceRecipe), arg0, arg1, arg2, arg3)
+}
+
+// RestoreApplication mocks base method.
+func (m *MockApplicationsManagementClient) RestoreApplication(arg0 context.Context, arg1 string) (v20231001preview.ApplicationResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreApplication", arg0, arg1)
+	ret0, _ := ret[0].(v20231001preview.ApplicationResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreApplication indicates an expected call of RestoreApplication.
+func (mr *MockApplicationsManagementClientMockRecorder) RestoreApplication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreApplication", reflect.TypeOf((*MockApplicationsManagementClient)(nil).RestoreApplication), arg0, arg1)
+}
+
+// RestoreEnv mocks base method.
+func (m *MockApplicationsManagementClient) RestoreEnv(arg0 context.Context, arg1 string) (v20231001preview.EnvironmentResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEnv", arg0, arg1)
+	ret0, _ := ret[0].(v20231001preview.EnvironmentResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreEnv indicates an expected call of RestoreEnv.
+func (mr *MockApplicationsManagementClientMockRecorder) RestoreEnv(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEnv", reflect.TypeOf((*MockApplicationsManagementClient)(nil).RestoreEnv), arg0, arg1)
+}
+
 // ShowApplication mocks base method.
 func (m *MockApplicationsManagementClient) ShowApplication(arg0 context.Context, arg1 string) (v20231001preview.ApplicationResource, error) {
 	m.ctrl.T.Helper()
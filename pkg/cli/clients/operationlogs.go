@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+)
+
+// OperationLogsPollInterval is the interval between the requests made to follow the log of an operation.
+var OperationLogsPollInterval = 2 * time.Second
+
+// LatestOperationWithLog returns the latest asynchronous operation in the operation history of a resource, which is
+// ordered by start time, and the number of synchronous operations that started after it. Only asynchronous operations
+// have an execution log. nil is returned if the history has no asynchronous operation.
+func LatestOperationWithLog(records []*audit.Record) (*audit.Record, int) {
+	for i := len(records) - 1; i >= 0; i-- {
+		if records[i].IsAsync() {
+			return records[i], len(records) - 1 - i
+		}
+	}
+
+	return nil, len(records)
+}
+
+// FollowOperationLogs gets the execution log of an asynchronous operation until it is complete, and calls handler for
+// each entry in the order they were recorded. It returns the last log returned by the server.
+func FollowOperationLogs(ctx context.Context, client ApplicationsManagementClient, resourceType string, operationID string, handler func(oplog.Entry)) (*oplog.Log, error) {
+	var since int64
+	for {
+		log, err := client.GetOperationLogs(ctx, resourceType, operationID, since)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range log.Entries {
+			handler(entry)
+			since = entry.Sequence
+		}
+
+		if log.Complete {
+			return log, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(OperationLogsPollInterval):
+		}
+	}
+}
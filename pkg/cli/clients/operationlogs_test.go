@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LatestOperationWithLog(t *testing.T) {
+	endTime := time.Now()
+	completed := &audit.Record{OperationID: "completed", Result: audit.ResultSucceeded, EndTime: &endTime}
+	running := &audit.Record{OperationID: "running", Result: audit.ResultAccepted}
+	failed := &audit.Record{OperationID: "failed", Result: audit.ResultFailed, EndTime: &endTime}
+	sync := &audit.Record{OperationID: "sync", Result: audit.ResultSucceeded}
+	syncFailed := &audit.Record{OperationID: "sync-failed", Result: audit.ResultFailed}
+
+	tests := []struct {
+		name     string
+		records  []*audit.Record
+		expected *audit.Record
+		later    int
+	}{
+		{name: "empty", records: nil, expected: nil, later: 0},
+		{name: "running", records: []*audit.Record{completed, running}, expected: running, later: 0},
+		{name: "completed", records: []*audit.Record{running, completed}, expected: completed, later: 0},
+		{name: "failed", records: []*audit.Record{completed, failed}, expected: failed, later: 0},
+		{name: "later synchronous operations", records: []*audit.Record{completed, sync, syncFailed}, expected: completed, later: 2},
+		{name: "only synchronous operations", records: []*audit.Record{sync, syncFailed}, expected: nil, later: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latest, later := LatestOperationWithLog(tt.records)
+			require.Equal(t, tt.expected, latest)
+			require.Equal(t, tt.later, later)
+		})
+	}
+}
+
+func Test_FollowOperationLogs(t *testing.T) {
+	OperationLogsPollInterval = 0
+	ctx := testcontext.New(t)
+	ctrl := gomock.NewController(t)
+
+	client := NewMockApplicationsManagementClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().
+			GetOperationLogs(gomock.Any(), "Applications.Datastores/redisCaches", "op-id", int64(0)).
+			Return(&oplog.Log{Entries: []oplog.Entry{{Sequence: 1, Message: "first"}, {Sequence: 2, Message: "second"}}}, nil),
+		client.EXPECT().
+			GetOperationLogs(gomock.Any(), "Applications.Datastores/redisCaches", "op-id", int64(2)).
+			Return(&oplog.Log{}, nil),
+		client.EXPECT().
+			GetOperationLogs(gomock.Any(), "Applications.Datastores/redisCaches", "op-id", int64(2)).
+			Return(&oplog.Log{Entries: []oplog.Entry{{Sequence: 3, Message: "third"}}, Complete: true}, nil),
+	)
+
+	messages := []string{}
+	log, err := FollowOperationLogs(ctx, client, "Applications.Datastores/redisCaches", "op-id", func(entry oplog.Entry) {
+		messages = append(messages, entry.Message)
+	})
+	require.NoError(t, err)
+	require.True(t, log.Complete)
+	require.Equal(t, []string{"first", "second", "third"}, messages)
+}
@@ -48,7 +48,11 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete Radius Application",
-		Long:  "Delete the specified Radius Application deployed in the default environment",
+		Long: `Delete the specified Radius Application deployed in the default environment.
+
+If soft delete is enabled on the server, the application is kept as deleted until the retention period of the server expires
+and can be restored with 'rad app restore' until then. The resources of the application keep running until the retention period expires.
+Otherwise the resources of the application are deleted with it.`,
 		Example: `
 # Delete current application
 rad app delete
@@ -67,6 +67,9 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	
 	You can specify parameters using multiple sources. Parameters can be overridden based on the 
 	order the are provided. Parameters appearing later in the argument list will override those defined earlier.
+
+	Use the '--what-if' flag to display the changes that the recipes of the resources in the template would make
+	without deploying the template. Resources provisioned by Bicep recipes are skipped since their changes cannot be planned.
 	`,
 		Example: `
 # deploy a Bicep template
@@ -98,6 +101,12 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# display the changes the recipes would make without deploying
+rad deploy myapp.bicep --what-if
+
+# display the logs of the recipes while deploying
+rad deploy myapp.bicep --recipe-logs
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -108,6 +117,8 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().Bool("what-if", false, "Display the changes the recipes of the resources in the template would make without deploying the template")
+	cmd.Flags().Bool("recipe-logs", false, "Display the logs of the recipes of the resources in the template while they are deployed")
 
 	return cmd, runner
 }
@@ -126,6 +137,8 @@ type Runner struct {
 	Parameters      map[string]map[string]any
 	Workspace       *workspaces.Workspace
 	Providers       *clients.Providers
+	WhatIf          bool
+	RecipeLogs      bool
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -232,6 +245,21 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// The what-if flag is not defined by commands that reuse this runner, such as `rad run`.
+	if cmd.Flags().Lookup("what-if") != nil {
+		r.WhatIf, err = cmd.Flags().GetBool("what-if")
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.Flags().Lookup("recipe-logs") != nil {
+		r.RecipeLogs, err = cmd.Flags().GetBool("recipe-logs")
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -246,6 +274,10 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.WhatIf {
+		return r.whatIf(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
 	if r.ApplicationName != "" {
@@ -296,6 +328,7 @@ func (r *Runner) Run(ctx context.Context) error {
 		ProgressText:      progressText,
 		CompletionText:    "Deployment Complete",
 		Providers:         r.Providers,
+		RecipeLogs:        r.RecipeLogs,
 	})
 	if err != nil {
 		return err
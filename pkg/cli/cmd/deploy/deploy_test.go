@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/golang/mock/gomock"
 	"github.com/radius-project/radius/pkg/cli/bicep"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -28,9 +29,11 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
@@ -58,6 +61,24 @@ func Test_Validate(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			Name:          "rad deploy - valid with what-if",
+			Input:         []string{"app.bicep", "--what-if"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).WhatIf)
+			},
+		},
 		{
 			Name:          "rad deploy - valid with parameters",
 			Input:         []string{"app.bicep", "-p", "foo=bar", "--parameters", "a=b"},
@@ -491,4 +512,148 @@ func Test_Run(t *testing.T) {
 		// is always empty.
 		require.Empty(t, outputSink.Writes)
 	})
+
+	t.Run("What-if deployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		scope := "/planes/radius/local/resourceGroups/test-group"
+		environmentID := scope + "/providers/applications.core/environments/" + radcli.TestEnvironmentName
+		template := map[string]any{
+			"parameters": map[string]any{
+				"environment": map[string]any{"type": "string"},
+				"size":        map[string]any{"type": "string", "defaultValue": "small"},
+			},
+			"resources": map[string]any{
+				"app": map[string]any{
+					"type": "Applications.Core/applications@2023-10-01-preview",
+					"properties": map[string]any{
+						"name":       "myapp",
+						"properties": map[string]any{"environment": "[parameters('environment')]"},
+					},
+				},
+				"redis": map[string]any{
+					"type": "Applications.Datastores/redisCaches@2023-10-01-preview",
+					"properties": map[string]any{
+						"name": "myredis",
+						"properties": map[string]any{
+							"environment": "[parameters('environment')]",
+							"application": "[reference('app').id]",
+							"recipe": map[string]any{
+								"name":       "default",
+								"parameters": map[string]any{"size": "[parameters('size')]"},
+							},
+						},
+					},
+				},
+				"manual": map[string]any{
+					"type": "Applications.Datastores/redisCaches@2023-10-01-preview",
+					"properties": map[string]any{
+						"name": "manualredis",
+						"properties": map[string]any{
+							"environment":          "[parameters('environment')]",
+							"resourceProvisioning": "manual",
+						},
+					},
+				},
+				"mongo": map[string]any{
+					"type": "Applications.Datastores/mongoDatabases@2023-10-01-preview",
+					"properties": map[string]any{
+						"name":       "mymongo",
+						"properties": map[string]any{"environment": "[parameters('environment')]"},
+					},
+				},
+				"unresolved": map[string]any{
+					"type": "Applications.Datastores/mongoDatabases@2023-10-01-preview",
+					"properties": map[string]any{
+						"name": "[format('{0}-mongo', parameters('size'))]",
+					},
+				},
+			},
+		}
+
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(template, nil).
+			Times(1)
+
+		plan := &recipes.RecipePlan{
+			Changes: []recipes.ResourceChange{
+				{ID: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis", Type: "Deployment", ChangeType: recipes.ChangeTypeCreate},
+			},
+		}
+
+		appManagmentMock := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagmentMock.EXPECT().
+			PlanResourceRecipe(gomock.Any(), "Applications.Datastores/redisCaches", "myredis", map[string]any{
+				"location": "global",
+				"properties": map[string]any{
+					"environment": environmentID,
+					"application": scope + "/providers/Applications.Core/applications/myapp",
+					"recipe": map[string]any{
+						"name":       "default",
+						"parameters": map[string]any{"size": "small"},
+					},
+				},
+			}).
+			Return(plan, nil).
+			Times(1)
+		appManagmentMock.EXPECT().
+			PlanResourceRecipe(gomock.Any(), "Applications.Datastores/mongoDatabases", "mymongo", gomock.Any()).
+			Return(nil, &azcore.ResponseError{ErrorCode: recipes.RecipePlanNotSupported}).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: scope,
+		}
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Bicep:             bicep,
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+			Output:            outputSink,
+			Providers: &clients.Providers{
+				Radius: &clients.RadiusProvider{EnvironmentID: environmentID},
+			},
+			FilePath:        "app.bicep",
+			EnvironmentName: radcli.TestEnvironmentName,
+			Parameters:      map[string]map[string]any{},
+			Workspace:       workspace,
+			WhatIf:          true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 4)
+		require.Equal(t, output.LogOutput{
+			Format: "Skipping resource '%v': its recipe does not support what-if",
+			Params: []any{"mongo"},
+		}, outputSink.Writes[1])
+		require.Equal(t, output.LogOutput{
+			Format: "Skipping resource '%v': %v",
+			Params: []any{"unresolved", fmt.Errorf("expression %q cannot be evaluated before deployment", "format('{0}-mongo', parameters('size'))")},
+		}, outputSink.Writes[2])
+		require.Equal(t, output.FormattedOutput{
+			Format: "table",
+			Obj: []RecipePlanChange{
+				{
+					Resource:   "myredis",
+					Type:       "Deployment",
+					ChangeType: "Create",
+					ID:         "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+				},
+			},
+			Options: objectformats.GetRecipePlanTableFormat(),
+		}, outputSink.Writes[3])
+
+		// The parameters of the runner are not modified by the what-if deployment.
+		require.Empty(t, runner.Parameters)
+	})
 }
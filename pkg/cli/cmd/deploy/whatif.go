@@ -0,0 +1,265 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+var (
+	// parametersExpression matches the template expression that references a parameter, eg: parameters('location').
+	parametersExpression = regexp.MustCompile(`^parameters\('([^']*)'\)$`)
+
+	// referenceIDExpression matches the template expression that references the ID of a resource declared in the
+	// template, eg: reference('env').id.
+	referenceIDExpression = regexp.MustCompile(`^reference\('([^']*)'\)\.id$`)
+
+	// stringLiteralExpression matches a string literal in a template expression, eg: 'value'.
+	stringLiteralExpression = regexp.MustCompile(`^'([^']*)'$`)
+)
+
+// RecipePlanChange is a change that the recipe of a resource in the template would make.
+type RecipePlanChange struct {
+	// Resource is the name of the resource in the template.
+	Resource string
+
+	// Type is the type of the resource changed by the recipe.
+	Type string
+
+	// ChangeType is the type of the change.
+	ChangeType string
+
+	// ID is the ID of the resource changed by the recipe.
+	ID string
+}
+
+// whatIf plans the recipes of the resources in the template that are provisioned by recipes and displays the changes
+// the recipes would make without deploying the template. Resources whose definition cannot be evaluated without
+// deploying the template are skipped.
+func (r *Runner) whatIf(ctx context.Context, template map[string]any) error {
+	parameters := map[string]map[string]any{}
+	for k, v := range r.Parameters {
+		parameters[k] = v
+	}
+	if err := bicep.InjectEnvironmentParam(template, parameters, r.Providers.Radius.EnvironmentID); err != nil {
+		return err
+	}
+	if err := bicep.InjectApplicationParam(template, parameters, r.Providers.Radius.ApplicationID); err != nil {
+		return err
+	}
+
+	evaluator := &templateEvaluator{
+		scope:      r.Workspace.Scope,
+		template:   template,
+		parameters: parameters,
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Planning the recipes of template '%v' for environment '%v' from workspace '%v'...\n", r.FilePath, r.EnvironmentName, r.Workspace.Name)
+
+	changes := []RecipePlanChange{}
+	resources, _ := template["resources"].(map[string]any)
+	symbols := []string{}
+	for symbol := range resources {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	for _, symbol := range symbols {
+		resourceType, name, body, err := evaluator.recipeResource(symbol)
+		if err != nil {
+			r.Output.LogInfo("Skipping resource '%v': %v", symbol, err)
+			continue
+		}
+		if body == nil {
+			continue
+		}
+
+		plan, err := client.PlanResourceRecipe(ctx, resourceType, name, body)
+		if isPlanNotSupportedError(err) {
+			r.Output.LogInfo("Skipping resource '%v': its recipe does not support what-if", symbol)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		for _, change := range plan.Changes {
+			changes = append(changes, RecipePlanChange{
+				Resource:   name,
+				Type:       change.Type,
+				ChangeType: string(change.ChangeType),
+				ID:         change.ID,
+			})
+		}
+	}
+
+	return r.Output.WriteFormatted(output.FormatTable, changes, objectformats.GetRecipePlanTableFormat())
+}
+
+// isPlanNotSupportedError returns true if the error reports that the driver of the recipe cannot plan its changes, for
+// example for bicep recipes.
+func isPlanNotSupportedError(err error) bool {
+	responseError := &azcore.ResponseError{}
+	return errors.As(err, &responseError) && responseError.ErrorCode == recipes.RecipePlanNotSupported
+}
+
+// templateEvaluator evaluates the subset of template expressions that can be resolved without deploying the template.
+type templateEvaluator struct {
+	scope      string
+	template   map[string]any
+	parameters map[string]map[string]any
+}
+
+// recipeResource returns the type, name and definition of the resource with the given symbolic name if it is
+// provisioned by a recipe. A nil definition is returned for resources that are not provisioned by a recipe.
+func (e *templateEvaluator) recipeResource(symbol string) (string, string, map[string]any, error) {
+	resource := e.lookup("resources", symbol)
+	resourceType, _, _ := strings.Cut(fmt.Sprint(resource["type"]), "@")
+	if !isRecipeResourceType(resourceType) {
+		return "", "", nil, nil
+	}
+
+	definition, _ := resource["properties"].(map[string]any)
+	name, err := e.evaluate(definition["name"])
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	properties, err := e.evaluate(definition["properties"])
+	if err != nil {
+		return "", "", nil, err
+	}
+	if props, ok := properties.(map[string]any); ok && strings.EqualFold(fmt.Sprint(props["resourceProvisioning"]), string(portableresources.ResourceProvisioningManual)) {
+		return "", "", nil, nil
+	}
+
+	body := map[string]any{
+		"location":   v1.LocationGlobal,
+		"properties": properties,
+	}
+
+	return resourceType, fmt.Sprint(name), body, nil
+}
+
+// evaluate evaluates the template expressions in the value. An error is returned if the value contains an expression
+// that cannot be evaluated without deploying the template.
+func (e *templateEvaluator) evaluate(value any) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for k, item := range v {
+			evaluated, err := e.evaluate(item)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = evaluated
+		}
+		return result, nil
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			evaluated, err := e.evaluate(item)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, evaluated)
+		}
+		return result, nil
+	case string:
+		if strings.HasPrefix(v, "[[") {
+			return v[1:], nil
+		}
+		if !strings.HasPrefix(v, "[") || !strings.HasSuffix(v, "]") {
+			return v, nil
+		}
+		return e.evaluateExpression(strings.TrimSpace(v[1 : len(v)-1]))
+	default:
+		return v, nil
+	}
+}
+
+// evaluateExpression evaluates a template expression. Parameters, IDs of resources declared in the template and string
+// literals are supported.
+func (e *templateEvaluator) evaluateExpression(expression string) (any, error) {
+	if match := stringLiteralExpression.FindStringSubmatch(expression); match != nil {
+		return match[1], nil
+	}
+
+	if match := parametersExpression.FindStringSubmatch(expression); match != nil {
+		if parameter, ok := e.parameters[match[1]]; ok {
+			return parameter["value"], nil
+		}
+
+		if defaultValue, ok := e.lookup("parameters", match[1])["defaultValue"]; ok {
+			return e.evaluate(defaultValue)
+		}
+
+		return nil, fmt.Errorf("parameter %q has no value", match[1])
+	}
+
+	if match := referenceIDExpression.FindStringSubmatch(expression); match != nil {
+		resource := e.lookup("resources", match[1])
+		if resource == nil {
+			return nil, fmt.Errorf("resource %q is not declared in the template", match[1])
+		}
+
+		resourceType, _, _ := strings.Cut(fmt.Sprint(resource["type"]), "@")
+		definition, _ := resource["properties"].(map[string]any)
+		name, err := e.evaluate(definition["name"])
+		if err != nil {
+			return nil, err
+		}
+
+		return e.scope + "/providers/" + resourceType + "/" + fmt.Sprint(name), nil
+	}
+
+	return nil, fmt.Errorf("expression %q cannot be evaluated before deployment", expression)
+}
+
+// lookup returns the declaration with the given name in the section of the template, or nil if it is not declared.
+func (e *templateEvaluator) lookup(section string, name string) map[string]any {
+	declarations, _ := e.template[section].(map[string]any)
+	declaration, _ := declarations[name].(map[string]any)
+	return declaration
+}
+
+func isRecipeResourceType(resourceType string) bool {
+	for _, t := range clients.RecipeResourceTypesList {
+		if strings.EqualFold(t, resourceType) {
+			return true
+		}
+	}
+	return false
+}
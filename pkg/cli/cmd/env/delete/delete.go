@@ -45,8 +45,12 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete environment",
-		Long:  `Delete environment. Deletes the user's default environment by default.`,
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Delete environment. Deletes the user's default environment by default.
+
+If soft delete is enabled on the server, the environment is kept as deleted until the retention period of the server expires
+and can be restored with 'rad env restore' until then. The applications and resources in the environment keep running until the retention period expires.
+Otherwise the applications in the environment are deleted with it.`,
+		Args: cobra.MaximumNArgs(1),
 		Example: `
 # Delete current environment
 rad env delete
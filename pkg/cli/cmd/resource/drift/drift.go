@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource drift` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "drift [resourceType] [resourceName]",
+		Short: "Show the drift status of a Radius resource",
+		Long: `Show the drift status of a Radius resource.
+
+Radius periodically plans the recipes of Applications.Datastores, Applications.Messaging and Applications.Dapr resources to detect changes made to the deployed resources outside of Radius. This command shows the result of the last check, including the resources which drifted. Re-deploy the resource to bring the drifted resources back in sync with the recipe.`,
+		Example: `
+	sample list of resourceType: daprPubSubBrokers, mongoDatabases, rabbitMQMessageQueues, redisCaches, sqlDatabases, daprStateStores, daprSecretStores
+
+	# show the drift status of a redis cache
+	rad resource drift redisCaches cache
+
+	# show the drift status of a redis cache in JSON format
+	rad resource drift redisCaches cache --output json
+	`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource drift` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	ResourceName      string
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad resource drift` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource drift` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource drift` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource, err := client.ShowResource(ctx, r.ResourceType, r.ResourceName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of type %q was not found.", r.ResourceName, r.ResourceType)
+	} else if err != nil {
+		return err
+	}
+
+	status, err := driftStatus(resource.Properties)
+	if err != nil {
+		return err
+	}
+
+	if status == nil {
+		r.Output.LogInfo("The resource %q of type %q has not been checked for drift.", r.ResourceName, r.ResourceType)
+		return nil
+	}
+
+	status.Name = r.ResourceName
+	status.Type = to.String(resource.Type)
+
+	err = r.Output.WriteFormatted(r.Format, status, objectformats.GetResourceDriftTableFormat())
+	if err != nil {
+		return err
+	}
+
+	if r.Format == output.FormatTable && len(status.Changes) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, status.Changes, objectformats.GetResourceDriftChangesTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// driftStatus reads the drift status from the status of the resource properties. It returns nil if the resource has
+// not been checked for drift.
+func driftStatus(properties map[string]any) (*clients.ResourceDriftStatus, error) {
+	b, err := json.Marshal(properties["status"])
+	if err != nil {
+		return nil, err
+	}
+
+	status := struct {
+		DriftStatus *clients.ResourceDriftStatus `json:"driftStatus"`
+	}{}
+	if err := json.Unmarshal(b, &status); err != nil {
+		return nil, err
+	}
+
+	return status.DriftStatus, nil
+}
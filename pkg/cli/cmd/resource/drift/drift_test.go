@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Drift Command",
+			Input:         []string{"redisCaches", "foo"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Drift Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Drift Command with insufficient args",
+			Input:         []string{"redisCaches"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Drifted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resource := radcli.CreateResource("Applications.Datastores/redisCaches", "foo")
+		resource.Properties = map[string]any{
+			"status": map[string]any{
+				"driftStatus": map[string]any{
+					"state":           "Drifted",
+					"lastCheckedTime": "2023-10-01T12:00:00Z",
+					"changes": []any{
+						map[string]any{"id": "/planes/kubernetes/local/namespaces/default/providers/core/Service/foo", "type": "core/Service", "changeType": "Modify"},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), "Applications.Datastores/redisCaches", "foo").
+			Return(resource, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "Applications.Datastores/redisCaches",
+			ResourceName:      "foo",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		changes := []clients.ResourceDriftChange{
+			{ID: "/planes/kubernetes/local/namespaces/default/providers/core/Service/foo", Type: "core/Service", ChangeType: "Modify"},
+		}
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: &clients.ResourceDriftStatus{
+					Name:            "foo",
+					Type:            "Applications.Datastores/redisCaches",
+					State:           "Drifted",
+					Changes:         changes,
+					LastCheckedTime: "2023-10-01T12:00:00Z",
+				},
+				Options: objectformats.GetResourceDriftTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     changes,
+				Options: objectformats.GetResourceDriftChangesTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Not checked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), "Applications.Datastores/redisCaches", "foo").
+			Return(radcli.CreateResource("Applications.Datastores/redisCaches", "foo"), nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "Applications.Datastores/redisCaches",
+			ResourceName:      "foo",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "The resource %q of type %q has not been checked for drift.",
+				Params: []any{"foo", "Applications.Datastores/redisCaches"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ShowResource(gomock.Any(), "Applications.Datastores/redisCaches", "foo").
+			Return(generated.GenericResource{}, &azcore.ResponseError{ErrorCode: "NotFound"}).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "Applications.Datastores/redisCaches",
+			ResourceName:      "foo",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource \"foo\" of type \"Applications.Datastores/redisCaches\" was not found."), err)
+	})
+}
@@ -53,6 +53,14 @@ func DeployWithProgress(ctx context.Context, options Options) (clients.Deploymen
 	// Watch for progress while we're deploying.
 	progressChan := make(chan clients.ResourceProgress, 1)
 	listener := NewProgressListener(progressChan)
+	if options.RecipeLogs {
+		client, err := options.ConnectionFactory.CreateApplicationsManagementClient(ctx, options.Workspace)
+		if err != nil {
+			return clients.DeploymentResult{}, err
+		}
+
+		listener = NewRecipeLogListener(ctx, progressChan, client)
+	}
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// findOperationTimeout is the maximum time to wait for the operation which deploys a resource to be accepted.
+const findOperationTimeout = 2 * time.Minute
+
+// recipeProviderNamespaces are the provider namespaces of the portable resources, which can be deployed by a recipe.
+var recipeProviderNamespaces = []string{
+	"applications.dapr",
+	"applications.datastores",
+	"applications.messaging",
+}
+
+// RecipeLogListener is a ProgressListener which prints the progress of the deployment line by line, along with the log of
+// the recipes of the resources as they are deployed.
+type RecipeLogListener struct {
+	ctx          context.Context
+	progressChan <-chan clients.ResourceProgress
+	client       clients.ApplicationsManagementClient
+	startTime    time.Time
+	followers    sync.WaitGroup
+}
+
+// NewRecipeLogListener creates a new RecipeLogListener which follows the recipe logs using the management client.
+func NewRecipeLogListener(ctx context.Context, progressChan <-chan clients.ResourceProgress, client clients.ApplicationsManagementClient) ProgressListener {
+	return &RecipeLogListener{
+		ctx:          ctx,
+		progressChan: progressChan,
+		client:       client,
+		startTime:    time.Now(),
+	}
+}
+
+// Run prints each resource update received from the progressChan channel, and the recipe log of each resource which
+// can be deployed by a recipe. It returns once the channel is closed and the recipe logs are complete.
+func (listener *RecipeLogListener) Run() {
+	// Storage for resources we've already 'seen'. Resources can go immediately to the Completed state without first
+	// going to the Started state.
+	seen := map[string]bool{}
+
+	for update := range listener.progressChan {
+		if !output.ShowResource(update.Resource) {
+			continue
+		}
+
+		output.LogInfo("%-10s %s", update.Status, output.FormatResourceForDisplay(update.Resource))
+
+		if seen[update.Resource.String()] || !hasRecipe(update.Resource) {
+			continue
+		}
+		seen[update.Resource.String()] = true
+
+		listener.followers.Add(1)
+		go func(id resources.ID) {
+			defer listener.followers.Done()
+			listener.follow(id)
+		}(update.Resource)
+	}
+
+	listener.followers.Wait()
+}
+
+// follow prints the recipe log of the operation which deploys the resource. Failures are printed as warnings since the
+// log is informational and does not affect the deployment.
+func (listener *RecipeLogListener) follow(id resources.ID) {
+	name := output.FormatResourceNameForDisplay(id)
+
+	operationID, err := listener.findOperation(id)
+	if err != nil {
+		output.LogInfo("Unable to find the operation which deploys %s: %v", name, err)
+		return
+	} else if operationID == "" {
+		return
+	}
+
+	_, err = clients.FollowOperationLogs(listener.ctx, listener.client, id.Type(), operationID, func(entry oplog.Entry) {
+		output.LogInfo("%-15s [%s] %s", name, entry.Source, entry.Message)
+	})
+	if err != nil && !clients.Is404Error(err) {
+		output.LogInfo("Unable to read the recipe log of %s: %v", name, err)
+	}
+}
+
+// findOperation returns the ID of the asynchronous operation accepted since the deployment started on the resource. It
+// returns an empty ID if the operation is not found before findOperationTimeout.
+func (listener *RecipeLogListener) findOperation(id resources.ID) (string, error) {
+	ctx, cancel := context.WithTimeout(listener.ctx, findOperationTimeout)
+	defer cancel()
+
+	for {
+		records, err := listener.client.ListResourceOperationHistory(ctx, id.Type(), id.Name())
+		if err != nil && !clients.Is404Error(err) {
+			return "", err
+		}
+
+		for i := len(records) - 1; i >= 0; i-- {
+			record := records[i]
+			if record.Result == audit.ResultAccepted && !record.StartTime.Before(listener.startTime) {
+				return record.OperationID, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", nil
+		case <-time.After(clients.OperationLogsPollInterval):
+		}
+	}
+}
+
+// hasRecipe returns true if the resource is of a type which can be deployed by a recipe.
+func hasRecipe(id resources.ID) bool {
+	namespace := strings.ToLower(id.ProviderNamespace())
+	for _, ns := range recipeProviderNamespaces {
+		if namespace == ns {
+			return true
+		}
+	}
+
+	return strings.EqualFold(id.Type(), "Applications.Core/extenders")
+}
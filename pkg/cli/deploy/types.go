@@ -53,6 +53,9 @@ type Options struct {
 
 	// CompleteText is a message displayed on the console when deployment completes.
 	CompletionText string
+
+	// RecipeLogs specifies whether the logs of the recipes are displayed while the resources are deployed.
+	RecipeLogs bool
 }
 
 var _ Interface = (*Impl)(nil)
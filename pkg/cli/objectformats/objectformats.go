@@ -72,6 +72,114 @@ func GetResourceTableFormat() output.FormatterOptions {
 	}
 }
 
+// GetResourceHistoryTableFormat returns a FormatterOptions struct containing the columns to display the operation history
+// of a resource: the time, operation, caller, result and duration of each mutating request.
+func GetResourceHistoryTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "TIME",
+				JSONPath: "{ .StartTime }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .OperationType }",
+			},
+			{
+				Heading:  "CALLER",
+				JSONPath: "{ .Caller.PrincipalName }",
+			},
+			{
+				Heading:  "RESULT",
+				JSONPath: "{ .Result }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .StatusCode }",
+			},
+			{
+				Heading:  "DURATION(MS)",
+				JSONPath: "{ .DurationMilliseconds }",
+			},
+		},
+	}
+}
+
+// GetRecipePlanTableFormat returns a FormatterOptions struct containing the columns to display the planned changes of the
+// recipes of the resources in a deployment.
+func GetRecipePlanTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Resource }",
+			},
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .ChangeType }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+		},
+	}
+}
+
+// GetResourceDriftTableFormat returns a FormatterOptions object containing the columns used to display the drift
+// status of a resource.
+func GetResourceDriftTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "DRIFT",
+				JSONPath: "{ .State }",
+			},
+			{
+				Heading:  "LAST CHECKED",
+				JSONPath: "{ .LastCheckedTime }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
+
+// GetResourceDriftChangesTableFormat returns a FormatterOptions object containing the columns used to display the
+// drifted resources deployed by the recipe of a resource.
+func GetResourceDriftChangesTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .ChangeType }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+		},
+	}
+}
+
 // GetResourceGroupTableFormat() returns a FormatterOptions object containing a list of columns with their headings and JSONPaths.
 func GetResourceGroupTableFormat() output.FormatterOptions {
 	return output.FormatterOptions{
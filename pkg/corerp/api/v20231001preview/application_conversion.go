@@ -78,6 +78,7 @@ func (dst *ApplicationResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &ApplicationProperties{
 		ProvisioningState: fromProvisioningStateDataModel(app.InternalMetadata.AsyncProvisioningState),
 		Environment:       to.Ptr(app.Properties.Environment),
+		DeletedTime:       app.InternalMetadata.DeletedTime,
 		Status: &ResourceStatus{
 			Compute: fromEnvironmentComputeDataModel(app.Properties.Status.Compute),
 		},
@@ -58,43 +58,6 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 		}
 	}
 
-	var livenessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.LivenessProbe != nil {
-		livenessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.LivenessProbe)
-	}
-
-	var readinessProbe datamodel.HealthProbeProperties
-	if src.Properties.Container.ReadinessProbe != nil {
-		readinessProbe = toHealthProbePropertiesDataModel(src.Properties.Container.ReadinessProbe)
-	}
-
-	ports := make(map[string]datamodel.ContainerPort)
-	for key, val := range src.Properties.Container.Ports {
-		port := datamodel.ContainerPort{
-			ContainerPort: to.Int32(val.ContainerPort),
-			Protocol:      toPortProtocolDataModel(val.Protocol),
-			Provides:      to.String(val.Provides),
-		}
-
-		if val.Port != nil {
-			port.Port = to.Int32(val.Port)
-		}
-
-		if val.Scheme != nil {
-			port.Scheme = to.String(val.Scheme)
-		}
-
-		ports[key] = port
-	}
-
-	var volumes map[string]datamodel.VolumeProperties
-	if src.Properties.Container.Volumes != nil {
-		volumes = make(map[string]datamodel.VolumeProperties)
-		for key, val := range src.Properties.Container.Volumes {
-			volumes[key] = toVolumePropertiesDataModel(val)
-		}
-	}
-
 	var extensions []datamodel.Extension
 	if src.Properties.Extensions != nil {
 		for _, e := range src.Properties.Extensions {
@@ -120,19 +83,10 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 			BasicResourceProperties: rpv1.BasicResourceProperties{
 				Application: to.String(src.Properties.Application),
 			},
-			Connections: connections,
-			Container: datamodel.Container{
-				Image:           to.String(src.Properties.Container.Image),
-				ImagePullPolicy: toImagePullPolicyDataModel(src.Properties.Container.ImagePullPolicy),
-				Env:             to.StringMap(src.Properties.Container.Env),
-				LivenessProbe:   livenessProbe,
-				Ports:           ports,
-				ReadinessProbe:  readinessProbe,
-				Volumes:         volumes,
-				Command:         stringSlice(src.Properties.Container.Command),
-				Args:            stringSlice(src.Properties.Container.Args),
-				WorkingDir:      to.String(src.Properties.Container.WorkingDir),
-			},
+			Connections:          connections,
+			Container:            toContainerDataModel(src.Properties.Container),
+			InitContainers:       toContainersDataModel(src.Properties.InitContainers),
+			Sidecars:             toContainersDataModel(src.Properties.Sidecars),
 			Extensions:           extensions,
 			Runtimes:             toRuntimePropertiesDataModel(src.Properties.Runtimes),
 			ResourceProvisioning: toContainerResourceProvisioningDataModel(src.Properties.ResourceProvisioning),
@@ -184,41 +138,6 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		}
 	}
 
-	var livenessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.LivenessProbe.IsEmpty() {
-		livenessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.LivenessProbe)
-	}
-
-	var readinessProbe HealthProbePropertiesClassification
-	if !c.Properties.Container.ReadinessProbe.IsEmpty() {
-		readinessProbe = fromHealthProbePropertiesDataModel(c.Properties.Container.ReadinessProbe)
-	}
-
-	ports := make(map[string]*ContainerPortProperties)
-	for key, val := range c.Properties.Container.Ports {
-		ports[key] = &ContainerPortProperties{
-			ContainerPort: to.Ptr(val.ContainerPort),
-			Protocol:      fromPortProtocolDataModel(val.Protocol),
-			Provides:      to.Ptr(val.Provides),
-		}
-
-		if val.Port != 0 {
-			ports[key].Port = to.Ptr(val.Port)
-		}
-
-		if val.Scheme != "" {
-			ports[key].Scheme = to.Ptr(val.Scheme)
-		}
-	}
-
-	var volumes map[string]VolumeClassification
-	if c.Properties.Container.Volumes != nil {
-		volumes = make(map[string]VolumeClassification)
-		for key, val := range c.Properties.Container.Volumes {
-			volumes[key] = fromVolumePropertiesDataModel(val)
-		}
-	}
-
 	var extensions []ExtensionClassification
 	if c.Properties.Extensions != nil {
 		for _, e := range c.Properties.Extensions {
@@ -245,21 +164,12 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResourcesDataModel(c.Properties.Status.OutputResources),
 		},
-		ProvisioningState: fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
-		Application:       to.Ptr(c.Properties.Application),
-		Connections:       connections,
-		Container: &Container{
-			Image:           to.Ptr(c.Properties.Container.Image),
-			ImagePullPolicy: fromImagePullPolicyDataModel(c.Properties.Container.ImagePullPolicy),
-			Env:             *to.StringMapPtr(c.Properties.Container.Env),
-			LivenessProbe:   livenessProbe,
-			Ports:           ports,
-			ReadinessProbe:  readinessProbe,
-			Volumes:         volumes,
-			Command:         to.SliceOfPtrs(c.Properties.Container.Command...),
-			Args:            to.SliceOfPtrs(c.Properties.Container.Args...),
-			WorkingDir:      to.Ptr(c.Properties.Container.WorkingDir),
-		},
+		ProvisioningState:    fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
+		Application:          to.Ptr(c.Properties.Application),
+		Connections:          connections,
+		Container:            fromContainerDataModel(c.Properties.Container),
+		InitContainers:       fromContainersDataModel(c.Properties.InitContainers),
+		Sidecars:             fromContainersDataModel(c.Properties.Sidecars),
 		Extensions:           extensions,
 		Identity:             identity,
 		Runtimes:             fromRuntimePropertiesDataModel(c.Properties.Runtimes),
@@ -270,6 +180,134 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 	return nil
 }
 
+// toContainerDataModel converts the versioned definition of a container to the version-agnostic datamodel.
+func toContainerDataModel(c *Container) datamodel.Container {
+	if c == nil {
+		return datamodel.Container{}
+	}
+
+	var livenessProbe datamodel.HealthProbeProperties
+	if c.LivenessProbe != nil {
+		livenessProbe = toHealthProbePropertiesDataModel(c.LivenessProbe)
+	}
+
+	var readinessProbe datamodel.HealthProbeProperties
+	if c.ReadinessProbe != nil {
+		readinessProbe = toHealthProbePropertiesDataModel(c.ReadinessProbe)
+	}
+
+	ports := make(map[string]datamodel.ContainerPort)
+	for key, val := range c.Ports {
+		port := datamodel.ContainerPort{
+			ContainerPort: to.Int32(val.ContainerPort),
+			Protocol:      toPortProtocolDataModel(val.Protocol),
+			Provides:      to.String(val.Provides),
+		}
+
+		if val.Port != nil {
+			port.Port = to.Int32(val.Port)
+		}
+
+		if val.Scheme != nil {
+			port.Scheme = to.String(val.Scheme)
+		}
+
+		ports[key] = port
+	}
+
+	var volumes map[string]datamodel.VolumeProperties
+	if c.Volumes != nil {
+		volumes = make(map[string]datamodel.VolumeProperties)
+		for key, val := range c.Volumes {
+			volumes[key] = toVolumePropertiesDataModel(val)
+		}
+	}
+
+	return datamodel.Container{
+		Image:           to.String(c.Image),
+		ImagePullPolicy: toImagePullPolicyDataModel(c.ImagePullPolicy),
+		Env:             to.StringMap(c.Env),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         stringSlice(c.Command),
+		Args:            stringSlice(c.Args),
+		WorkingDir:      to.String(c.WorkingDir),
+		Resources:       toContainerResourceRequirementsDataModel(c.Resources),
+	}
+}
+
+// fromContainerDataModel converts the version-agnostic datamodel of a container to the versioned definition.
+func fromContainerDataModel(c datamodel.Container) *Container {
+	var livenessProbe HealthProbePropertiesClassification
+	if !c.LivenessProbe.IsEmpty() {
+		livenessProbe = fromHealthProbePropertiesDataModel(c.LivenessProbe)
+	}
+
+	var readinessProbe HealthProbePropertiesClassification
+	if !c.ReadinessProbe.IsEmpty() {
+		readinessProbe = fromHealthProbePropertiesDataModel(c.ReadinessProbe)
+	}
+
+	ports := make(map[string]*ContainerPortProperties)
+	for key, val := range c.Ports {
+		ports[key] = &ContainerPortProperties{
+			ContainerPort: to.Ptr(val.ContainerPort),
+			Protocol:      fromPortProtocolDataModel(val.Protocol),
+			Provides:      to.Ptr(val.Provides),
+		}
+
+		if val.Port != 0 {
+			ports[key].Port = to.Ptr(val.Port)
+		}
+
+		if val.Scheme != "" {
+			ports[key].Scheme = to.Ptr(val.Scheme)
+		}
+	}
+
+	var volumes map[string]VolumeClassification
+	if c.Volumes != nil {
+		volumes = make(map[string]VolumeClassification)
+		for key, val := range c.Volumes {
+			volumes[key] = fromVolumePropertiesDataModel(val)
+		}
+	}
+
+	return &Container{
+		Image:           to.Ptr(c.Image),
+		ImagePullPolicy: fromImagePullPolicyDataModel(c.ImagePullPolicy),
+		Env:             *to.StringMapPtr(c.Env),
+		LivenessProbe:   livenessProbe,
+		Ports:           ports,
+		ReadinessProbe:  readinessProbe,
+		Volumes:         volumes,
+		Command:         to.SliceOfPtrs(c.Command...),
+		Args:            to.SliceOfPtrs(c.Args...),
+		WorkingDir:      to.Ptr(c.WorkingDir),
+		Resources:       fromContainerResourceRequirementsDataModel(c.Resources),
+	}
+}
+
+// toContainersDataModel converts the versioned init or sidecar containers to the version-agnostic datamodel.
+func toContainersDataModel(containers []*Container) []datamodel.Container {
+	var converted []datamodel.Container
+	for _, c := range containers {
+		converted = append(converted, toContainerDataModel(c))
+	}
+	return converted
+}
+
+// fromContainersDataModel converts the version-agnostic datamodel of init or sidecar containers to the versioned definitions.
+func fromContainersDataModel(containers []datamodel.Container) []*Container {
+	var converted []*Container
+	for _, c := range containers {
+		converted = append(converted, fromContainerDataModel(c))
+	}
+	return converted
+}
+
 func toImagePullPolicyDataModel(pullPolicy *ImagePullPolicy) string {
 	if pullPolicy == nil {
 		return ""
@@ -369,6 +407,48 @@ func fromHealthProbePropertiesDataModel(h datamodel.HealthProbeProperties) Healt
 	return nil
 }
 
+func toContainerResourceRequirementsDataModel(r *ContainerResourceRequirements) datamodel.ContainerResourceRequirements {
+	if r == nil {
+		return datamodel.ContainerResourceRequirements{}
+	}
+	return datamodel.ContainerResourceRequirements{
+		Requests: toContainerResourceQuantitiesDataModel(r.Requests),
+		Limits:   toContainerResourceQuantitiesDataModel(r.Limits),
+	}
+}
+
+func fromContainerResourceRequirementsDataModel(r datamodel.ContainerResourceRequirements) *ContainerResourceRequirements {
+	if r.IsEmpty() {
+		return nil
+	}
+	return &ContainerResourceRequirements{
+		Requests: fromContainerResourceQuantitiesDataModel(r.Requests),
+		Limits:   fromContainerResourceQuantitiesDataModel(r.Limits),
+	}
+}
+
+func toContainerResourceQuantitiesDataModel(q *ContainerResourceQuantities) datamodel.ContainerResourceQuantities {
+	if q == nil {
+		return datamodel.ContainerResourceQuantities{}
+	}
+	return datamodel.ContainerResourceQuantities{
+		CPU:              to.String(q.CPU),
+		Memory:           to.String(q.Memory),
+		EphemeralStorage: to.String(q.EphemeralStorage),
+	}
+}
+
+func fromContainerResourceQuantitiesDataModel(q datamodel.ContainerResourceQuantities) *ContainerResourceQuantities {
+	if q == (datamodel.ContainerResourceQuantities{}) {
+		return nil
+	}
+	return &ContainerResourceQuantities{
+		CPU:              toStringPtr(q.CPU),
+		Memory:           toStringPtr(q.Memory),
+		EphemeralStorage: toStringPtr(q.EphemeralStorage),
+	}
+}
+
 func toKindDataModel(kind *IAMKind) datamodel.IAMKind {
 	switch *kind {
 	case IAMKindAzure:
@@ -634,6 +714,46 @@ func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Replicas: c.Replicas,
 			},
 		}
+	case *AutoScalingExtension:
+		metrics := []datamodel.AutoScalingMetric{}
+		for _, m := range c.Metrics {
+			if m != nil {
+				metrics = append(metrics, datamodel.AutoScalingMetric{
+					Name:               to.String(m.Name),
+					TargetAverageValue: to.String(m.TargetAverageValue),
+				})
+			}
+		}
+		return datamodel.Extension{
+			Kind: datamodel.AutoScaling,
+			AutoScaling: &datamodel.AutoScalingExtension{
+				MinReplicas:             c.MinReplicas,
+				MaxReplicas:             to.Int32(c.MaxReplicas),
+				TargetCPUUtilization:    c.TargetCPUUtilization,
+				TargetMemoryUtilization: c.TargetMemoryUtilization,
+				Metrics:                 metrics,
+			},
+		}
+	case *EventScalingExtension:
+		triggers := map[string]datamodel.EventScalingTrigger{}
+		for name, t := range c.Triggers {
+			if t != nil {
+				triggers[name] = datamodel.EventScalingTrigger{
+					TargetLength: t.TargetLength,
+					Topic:        to.String(t.Topic),
+				}
+			}
+		}
+		return datamodel.Extension{
+			Kind: datamodel.EventScaling,
+			EventScaling: &datamodel.EventScalingExtension{
+				MinReplicas:     c.MinReplicas,
+				MaxReplicas:     to.Int32(c.MaxReplicas),
+				PollingInterval: c.PollingInterval,
+				CooldownPeriod:  c.CooldownPeriod,
+				Triggers:        triggers,
+			},
+		}
 	case *DaprSidecarExtension:
 		return datamodel.Extension{
 			Kind: datamodel.DaprSidecar,
@@ -665,6 +785,42 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 			Kind:     to.Ptr(string(e.Kind)),
 			Replicas: e.ManualScaling.Replicas,
 		}
+	case datamodel.AutoScaling:
+		var metrics []*AutoScalingMetric
+		for _, m := range e.AutoScaling.Metrics {
+			metrics = append(metrics, &AutoScalingMetric{
+				Name:               to.Ptr(m.Name),
+				TargetAverageValue: to.Ptr(m.TargetAverageValue),
+			})
+		}
+		return &AutoScalingExtension{
+			Kind:                    to.Ptr(string(e.Kind)),
+			MinReplicas:             e.AutoScaling.MinReplicas,
+			MaxReplicas:             to.Ptr(e.AutoScaling.MaxReplicas),
+			TargetCPUUtilization:    e.AutoScaling.TargetCPUUtilization,
+			TargetMemoryUtilization: e.AutoScaling.TargetMemoryUtilization,
+			Metrics:                 metrics,
+		}
+	case datamodel.EventScaling:
+		var triggers map[string]*EventScalingTrigger
+		for name, t := range e.EventScaling.Triggers {
+			if triggers == nil {
+				triggers = map[string]*EventScalingTrigger{}
+			}
+			trigger := &EventScalingTrigger{TargetLength: t.TargetLength}
+			if t.Topic != "" {
+				trigger.Topic = to.Ptr(t.Topic)
+			}
+			triggers[name] = trigger
+		}
+		return &EventScalingExtension{
+			Kind:            to.Ptr(string(e.Kind)),
+			MinReplicas:     e.EventScaling.MinReplicas,
+			MaxReplicas:     to.Ptr(e.EventScaling.MaxReplicas),
+			PollingInterval: e.EventScaling.PollingInterval,
+			CooldownPeriod:  e.EventScaling.CooldownPeriod,
+			Triggers:        triggers,
+		}
 	case datamodel.DaprSidecar:
 		return &DaprSidecarExtension{
 			Kind:     to.Ptr(string(e.Kind)),
@@ -242,6 +242,137 @@ func TestContainerConvertVersionedToDataModelEmptyProtocol(t *testing.T) {
 
 }
 
+func TestContainerConvertResources(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-resources.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	expected := datamodel.ContainerResourceRequirements{
+		Requests: datamodel.ContainerResourceQuantities{CPU: "250m", Memory: "256Mi"},
+		Limits:   datamodel.ContainerResourceQuantities{CPU: "1", Memory: "512Mi", EphemeralStorage: "1Gi"},
+	}
+	ct := dm.(*datamodel.ContainerResource)
+	require.Equal(t, expected, ct.Properties.Container.Resources)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+	require.Equal(t, r.Properties.Container.Resources, versioned.Properties.Container.Resources)
+}
+
+func TestContainerConvertInitContainersAndSidecars(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-sidecars.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.ContainerResource)
+	expectedInit := []datamodel.Container{
+		{
+			Image:   "ghcr.io/radius-project/migrations",
+			Command: []string{"/bin/sh"},
+			Args:    []string{"-c", "fetch-config /config"},
+			Env:     map[string]string{},
+			Ports:   map[string]datamodel.ContainerPort{},
+			Volumes: map[string]datamodel.VolumeProperties{
+				"config": {
+					Kind: datamodel.Ephemeral,
+					Ephemeral: &datamodel.EphemeralVolume{
+						VolumeBase:   datamodel.VolumeBase{MountPath: "/config"},
+						ManagedStore: datamodel.ManagedStoreDisk,
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedInit, ct.Properties.InitContainers)
+
+	require.Len(t, ct.Properties.Sidecars, 1)
+	require.Equal(t, "fluent/fluent-bit", ct.Properties.Sidecars[0].Image)
+	require.Equal(t, map[string]string{"LOG_LEVEL": "info"}, ct.Properties.Sidecars[0].Env)
+	require.Equal(t, datamodel.TCPHealthProbe, ct.Properties.Sidecars[0].ReadinessProbe.Kind)
+	require.Equal(t, int32(2020), ct.Properties.Sidecars[0].ReadinessProbe.TCP.ContainerPort)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+	require.Len(t, versioned.Properties.InitContainers, 1)
+	require.Equal(t, r.Properties.InitContainers[0].Image, versioned.Properties.InitContainers[0].Image)
+	require.Equal(t, r.Properties.InitContainers[0].Command, versioned.Properties.InitContainers[0].Command)
+	require.Equal(t, r.Properties.InitContainers[0].Volumes, versioned.Properties.InitContainers[0].Volumes)
+	require.Len(t, versioned.Properties.Sidecars, 1)
+	require.Equal(t, r.Properties.Sidecars[0].ReadinessProbe, versioned.Properties.Sidecars[0].ReadinessProbe)
+}
+
+func TestContainerConvertAutoScalingExtension(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-autoscaling.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	expected := []datamodel.Extension{
+		{
+			Kind: datamodel.AutoScaling,
+			AutoScaling: &datamodel.AutoScalingExtension{
+				MinReplicas:          to.Ptr[int32](2),
+				MaxReplicas:          10,
+				TargetCPUUtilization: to.Ptr[int32](70),
+				Metrics: []datamodel.AutoScalingMetric{
+					{Name: "http_requests_per_second", TargetAverageValue: "100"},
+				},
+			},
+		},
+	}
+	ct := dm.(*datamodel.ContainerResource)
+	require.Equal(t, expected, ct.Properties.Extensions)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+	require.Equal(t, r.Properties.Extensions, versioned.Properties.Extensions)
+}
+
+func TestContainerConvertEventScalingExtension(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-eventscaling.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	expected := []datamodel.Extension{
+		{
+			Kind: datamodel.EventScaling,
+			EventScaling: &datamodel.EventScalingExtension{
+				MaxReplicas:    5,
+				CooldownPeriod: to.Ptr[int32](60),
+				Triggers: map[string]datamodel.EventScalingTrigger{
+					"orders": {TargetLength: to.Ptr[int32](10)},
+					"events": {Topic: "checkout"},
+				},
+			},
+		},
+	}
+	ct := dm.(*datamodel.ContainerResource)
+	require.Equal(t, expected, ct.Properties.Extensions)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+	require.Equal(t, r.Properties.Extensions, versioned.Properties.Extensions)
+}
+
 func TestContainerConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
@@ -18,6 +18,7 @@ package v20231001preview
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -28,6 +29,7 @@ import (
 	rp_util "github.com/radius-project/radius/pkg/rp/portableresources"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 const (
@@ -115,6 +117,32 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.Extensions = extensions
 	}
 
+	if src.Properties.RecipeConfig != nil {
+		recipeConfig, err := toRecipeConfigDatamodel(src.Properties.RecipeConfig)
+		if err != nil {
+			return &datamodel.Environment{}, err
+		}
+		converted.Properties.RecipeConfig = recipeConfig
+	}
+
+	if src.Properties.ContainerResources != nil {
+		converted.Properties.ContainerResources = datamodel.ContainerResourcePolicy{
+			Defaults: toContainerResourceRequirementsDataModel(src.Properties.ContainerResources.Defaults),
+			Maximum:  toContainerResourceQuantitiesDataModel(src.Properties.ContainerResources.Maximum),
+		}
+	}
+
+	if src.Properties.Gateway != nil {
+		implementation, err := toGatewayImplementationDataModel(src.Properties.Gateway.Implementation)
+		if err != nil {
+			return &datamodel.Environment{}, err
+		}
+		converted.Properties.Gateway = datamodel.EnvironmentGateway{
+			Implementation:   implementation,
+			GatewayClassName: to.String(src.Properties.Gateway.GatewayClassName),
+		}
+	}
+
 	return converted, nil
 }
 
@@ -133,6 +161,7 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Tags = *to.StringMapPtr(env.Tags)
 	dst.Properties = &EnvironmentProperties{
 		ProvisioningState: fromProvisioningStateDataModel(env.InternalMetadata.AsyncProvisioningState),
+		DeletedTime:       env.InternalMetadata.DeletedTime,
 	}
 
 	dst.Properties.Compute = fromEnvironmentComputeDataModel(&env.Properties.Compute)
@@ -177,9 +206,47 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.Extensions = extensions
 	}
 
+	dst.Properties.RecipeConfig = fromRecipeConfigDatamodel(env.Properties.RecipeConfig)
+
+	if env.Properties.ContainerResources != (datamodel.ContainerResourcePolicy{}) {
+		dst.Properties.ContainerResources = &ContainerResourcePolicy{
+			Defaults: fromContainerResourceRequirementsDataModel(env.Properties.ContainerResources.Defaults),
+			Maximum:  fromContainerResourceQuantitiesDataModel(env.Properties.ContainerResources.Maximum),
+		}
+	}
+
+	if env.Properties.Gateway != (datamodel.EnvironmentGateway{}) {
+		dst.Properties.Gateway = &EnvironmentGateway{
+			Implementation:   fromGatewayImplementationDataModel(env.Properties.Gateway.Implementation),
+			GatewayClassName: toStringPtr(env.Properties.Gateway.GatewayClassName),
+		}
+	}
+
 	return nil
 }
 
+func toGatewayImplementationDataModel(implementation *GatewayImplementation) (datamodel.GatewayImplementation, error) {
+	if implementation == nil {
+		return datamodel.GatewayImplementationContour, nil
+	}
+	switch *implementation {
+	case GatewayImplementationContour:
+		return datamodel.GatewayImplementationContour, nil
+	case GatewayImplementationGatewayAPI:
+		return datamodel.GatewayImplementationGatewayAPI, nil
+	default:
+		return "", &v1.ErrModelConversion{PropertyName: "$.properties.gateway.implementation", ValidValue: fmt.Sprintf("one of %s", PossibleGatewayImplementationValues())}
+	}
+}
+
+func fromGatewayImplementationDataModel(implementation datamodel.GatewayImplementation) *GatewayImplementation {
+	converted := GatewayImplementationContour
+	if implementation == datamodel.GatewayImplementationGatewayAPI {
+		converted = GatewayImplementationGatewayAPI
+	}
+	return &converted
+}
+
 func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.EnvironmentCompute, error) {
 	switch v := h.(type) {
 	case *KubernetesCompute:
@@ -316,6 +383,20 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			TemplatePath: to.String(c.TemplatePath),
 			Parameters:   c.Parameters,
 		}, nil
+	case *HelmRecipeProperties:
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindHelm,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    to.String(c.TemplatePath),
+			Parameters:      c.Parameters,
+		}, nil
+	case *KubernetesRecipeProperties:
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindKubernetes,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    to.String(c.TemplatePath),
+			Parameters:      c.Parameters,
+		}, nil
 	}
 	return datamodel.EnvironmentRecipeProperties{}, nil
 }
@@ -335,6 +416,340 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 			TemplatePath: to.Ptr(e.TemplatePath),
 			Parameters:   e.Parameters,
 		}
+	case types.TemplateKindHelm:
+		return &HelmRecipeProperties{
+			TemplateKind:    to.Ptr(e.TemplateKind),
+			TemplateVersion: to.Ptr(e.TemplateVersion),
+			TemplatePath:    to.Ptr(e.TemplatePath),
+			Parameters:      e.Parameters,
+		}
+	case types.TemplateKindKubernetes:
+		return &KubernetesRecipeProperties{
+			TemplateKind:    to.Ptr(e.TemplateKind),
+			TemplateVersion: to.Ptr(e.TemplateVersion),
+			TemplatePath:    to.Ptr(e.TemplatePath),
+			Parameters:      e.Parameters,
+		}
 	}
 	return nil
 }
+
+func toRecipeConfigDatamodel(config *RecipeConfigProperties) (datamodel.RecipeConfigProperties, error) {
+	recipeConfig := datamodel.RecipeConfigProperties{}
+	if config.Terraform == nil {
+		return recipeConfig, nil
+	}
+
+	if config.Terraform.Backend != nil {
+		backend, err := toTerraformBackendDatamodel(config.Terraform.Backend)
+		if err != nil {
+			return datamodel.RecipeConfigProperties{}, err
+		}
+		recipeConfig.Terraform.Backend = backend
+	}
+
+	if config.Terraform.Providers != nil {
+		providers, err := toProvidersDatamodel(config.Terraform.Providers)
+		if err != nil {
+			return datamodel.RecipeConfigProperties{}, err
+		}
+		recipeConfig.Terraform.Providers = providers
+	}
+
+	if config.Terraform.Authentication != nil {
+		authentication, err := toAuthConfigDatamodel(config.Terraform.Authentication)
+		if err != nil {
+			return datamodel.RecipeConfigProperties{}, err
+		}
+		recipeConfig.Terraform.Authentication = authentication
+	}
+
+	return recipeConfig, nil
+}
+
+func toAuthConfigDatamodel(config *AuthConfig) (datamodel.AuthConfig, error) {
+	authConfig := datamodel.AuthConfig{}
+	var err error
+	if config.Git != nil {
+		authConfig.Git.PAT, err = toSecretConfigsDatamodel("git.pat", config.Git.Pat)
+		if err != nil {
+			return datamodel.AuthConfig{}, err
+		}
+		authConfig.Git.SSH, err = toSecretConfigsDatamodel("git.ssh", config.Git.SSH)
+		if err != nil {
+			return datamodel.AuthConfig{}, err
+		}
+	}
+
+	authConfig.Registries, err = toSecretConfigsDatamodel("registries", config.Registries)
+	if err != nil {
+		return datamodel.AuthConfig{}, err
+	}
+
+	return authConfig, nil
+}
+
+func toSecretConfigsDatamodel(property string, configs map[string]*SecretConfig) (map[string]datamodel.SecretConfig, error) {
+	if configs == nil {
+		return nil, nil
+	}
+
+	converted := map[string]datamodel.SecretConfig{}
+	for host, config := range configs {
+		if config == nil {
+			continue
+		}
+
+		secret := to.String(config.Secret)
+		if !isValidSecretStoreID(secret) {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid secret store %q for host %q in the Terraform %s authentication. The secret must be the ID of an Applications.Core/secretStores resource", secret, host, property))
+		}
+		converted[host] = datamodel.SecretConfig{Secret: secret}
+	}
+
+	return converted, nil
+}
+
+func fromAuthConfigDatamodel(config datamodel.AuthConfig) *AuthConfig {
+	if config.Git.PAT == nil && config.Git.SSH == nil && config.Registries == nil {
+		return nil
+	}
+
+	authConfig := &AuthConfig{
+		Registries: fromSecretConfigsDatamodel(config.Registries),
+	}
+	if config.Git.PAT != nil || config.Git.SSH != nil {
+		authConfig.Git = &GitAuthConfig{
+			Pat: fromSecretConfigsDatamodel(config.Git.PAT),
+			SSH: fromSecretConfigsDatamodel(config.Git.SSH),
+		}
+	}
+
+	return authConfig
+}
+
+func fromSecretConfigsDatamodel(configs map[string]datamodel.SecretConfig) map[string]*SecretConfig {
+	if configs == nil {
+		return nil
+	}
+
+	converted := map[string]*SecretConfig{}
+	for host, config := range configs {
+		converted[host] = &SecretConfig{Secret: to.Ptr(config.Secret)}
+	}
+
+	return converted
+}
+
+func toProvidersDatamodel(providers map[string][]*ProviderConfigProperties) (map[string][]datamodel.ProviderConfigProperties, error) {
+	converted := map[string][]datamodel.ProviderConfigProperties{}
+	for name, configs := range providers {
+		converted[name] = []datamodel.ProviderConfigProperties{}
+		for _, config := range configs {
+			if config == nil {
+				continue
+			}
+
+			providerConfig := datamodel.ProviderConfigProperties{
+				AdditionalProperties: config.AdditionalProperties,
+			}
+			if config.Secrets != nil {
+				providerConfig.Secrets = map[string]datamodel.SecretReference{}
+				for property, secret := range config.Secrets {
+					if secret == nil {
+						continue
+					}
+
+					source := to.String(secret.Source)
+					if !isValidSecretStoreID(source) {
+						return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid secret store %q for the %q property of the Terraform provider %q. The source must be the ID of an Applications.Core/secretStores resource", source, property, name))
+					}
+					if _, ok := config.AdditionalProperties[property]; ok {
+						return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("the %q property of the Terraform provider %q cannot be set as both a value and a secret", property, name))
+					}
+
+					providerConfig.Secrets[property] = datamodel.SecretReference{
+						Source: source,
+						Key:    to.String(secret.Key),
+					}
+				}
+			}
+			converted[name] = append(converted[name], providerConfig)
+		}
+	}
+
+	return converted, nil
+}
+
+func isValidSecretStoreID(id string) bool {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(parsed.Type(), datamodel.SecretStoreResourceType)
+}
+
+func toTerraformBackendDatamodel(b *TerraformBackendProperties) (*datamodel.TerraformBackend, error) {
+	if b.Kind == nil {
+		return nil, v1.NewClientErrInvalidRequest("the kind of the Terraform backend is required")
+	}
+
+	backend := &datamodel.TerraformBackend{Kind: string(*b.Kind)}
+	switch *b.Kind {
+	case TerraformBackendKindKubernetes:
+	case TerraformBackendKindS3:
+		if b.S3 == nil {
+			return nil, newMissingTerraformBackendConfigError(backend.Kind)
+		}
+		backend.S3 = &datamodel.TerraformS3Backend{
+			Bucket:       to.String(b.S3.Bucket),
+			Region:       to.String(b.S3.Region),
+			Endpoint:     to.String(b.S3.Endpoint),
+			UsePathStyle: to.Bool(b.S3.UsePathStyle),
+		}
+	case TerraformBackendKindPg:
+		if b.Pg == nil {
+			return nil, newMissingTerraformBackendConfigError(backend.Kind)
+		}
+		if b.Pg.ConnectionString == nil {
+			return nil, v1.NewClientErrInvalidRequest("the connectionString property is required for the \"pg\" Terraform backend")
+		}
+		connectionString, err := toTerraformBackendSecretDatamodel(backend.Kind, "connectionString", b.Pg.ConnectionString)
+		if err != nil {
+			return nil, err
+		}
+		backend.Postgres = &datamodel.TerraformPostgresBackend{
+			ConnectionString: connectionString,
+		}
+	case TerraformBackendKindAzurerm:
+		if b.Azurerm == nil {
+			return nil, newMissingTerraformBackendConfigError(backend.Kind)
+		}
+		backend.AzureRM = &datamodel.TerraformAzureRMBackend{
+			SubscriptionID:     to.String(b.Azurerm.SubscriptionID),
+			ResourceGroupName:  to.String(b.Azurerm.ResourceGroupName),
+			StorageAccountName: to.String(b.Azurerm.StorageAccountName),
+			ContainerName:      to.String(b.Azurerm.ContainerName),
+		}
+	case TerraformBackendKindLocal:
+		if b.Local == nil {
+			return nil, newMissingTerraformBackendConfigError(backend.Kind)
+		}
+		if path := to.String(b.Local.Path); !filepath.IsAbs(path) {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("the path %q of the \"local\" Terraform backend must be an absolute path", path))
+		}
+		backend.Local = &datamodel.TerraformLocalBackend{
+			Path: to.String(b.Local.Path),
+		}
+	default:
+		kinds := []string{}
+		for _, kind := range PossibleTerraformBackendKindValues() {
+			kinds = append(kinds, fmt.Sprintf("%q", kind))
+		}
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid Terraform backend kind %q. Allowed kinds: %s", backend.Kind, strings.Join(kinds, ", ")))
+	}
+
+	return backend, nil
+}
+
+func toTerraformBackendSecretDatamodel(kind string, property string, secret *SecretReference) (datamodel.SecretReference, error) {
+	source := to.String(secret.Source)
+	if !isValidSecretStoreID(source) {
+		return datamodel.SecretReference{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid secret store %q for the %q property of the %q Terraform backend. The source must be the ID of an Applications.Core/secretStores resource", source, property, kind))
+	}
+
+	return datamodel.SecretReference{
+		Source: source,
+		Key:    to.String(secret.Key),
+	}, nil
+}
+
+func newMissingTerraformBackendConfigError(kind string) error {
+	return v1.NewClientErrInvalidRequest(fmt.Sprintf("the %q property is required for the %q Terraform backend", kind, kind))
+}
+
+func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeConfigProperties {
+	authentication := fromAuthConfigDatamodel(config.Terraform.Authentication)
+	if config.Terraform.Backend == nil && config.Terraform.Providers == nil && authentication == nil {
+		return nil
+	}
+
+	return &RecipeConfigProperties{
+		Terraform: &TerraformConfigProperties{
+			Authentication: authentication,
+			Backend:        fromTerraformBackendDatamodel(config.Terraform.Backend),
+			Providers:      fromProvidersDatamodel(config.Terraform.Providers),
+		},
+	}
+}
+
+func fromProvidersDatamodel(providers map[string][]datamodel.ProviderConfigProperties) map[string][]*ProviderConfigProperties {
+	if providers == nil {
+		return nil
+	}
+
+	converted := map[string][]*ProviderConfigProperties{}
+	for name, configs := range providers {
+		converted[name] = []*ProviderConfigProperties{}
+		for _, config := range configs {
+			providerConfig := &ProviderConfigProperties{
+				AdditionalProperties: config.AdditionalProperties,
+			}
+			if config.Secrets != nil {
+				providerConfig.Secrets = map[string]*SecretReference{}
+				for property, secret := range config.Secrets {
+					providerConfig.Secrets[property] = fromSecretReferenceDatamodel(secret)
+				}
+			}
+			converted[name] = append(converted[name], providerConfig)
+		}
+	}
+
+	return converted
+}
+
+func fromSecretReferenceDatamodel(secret datamodel.SecretReference) *SecretReference {
+	return &SecretReference{
+		Source: to.Ptr(secret.Source),
+		Key:    to.Ptr(secret.Key),
+	}
+}
+
+func fromTerraformBackendDatamodel(b *datamodel.TerraformBackend) *TerraformBackendProperties {
+	if b == nil {
+		return nil
+	}
+
+	backend := &TerraformBackendProperties{
+		Kind: to.Ptr(TerraformBackendKind(b.Kind)),
+	}
+	if b.S3 != nil {
+		backend.S3 = &TerraformS3BackendProperties{
+			Bucket:       to.Ptr(b.S3.Bucket),
+			Region:       to.Ptr(b.S3.Region),
+			Endpoint:     to.Ptr(b.S3.Endpoint),
+			UsePathStyle: to.Ptr(b.S3.UsePathStyle),
+		}
+	}
+	if b.Postgres != nil {
+		backend.Pg = &TerraformPostgresBackendProperties{
+			ConnectionString: fromSecretReferenceDatamodel(b.Postgres.ConnectionString),
+		}
+	}
+	if b.AzureRM != nil {
+		backend.Azurerm = &TerraformAzureRMBackendProperties{
+			SubscriptionID:     to.Ptr(b.AzureRM.SubscriptionID),
+			ResourceGroupName:  to.Ptr(b.AzureRM.ResourceGroupName),
+			StorageAccountName: to.Ptr(b.AzureRM.StorageAccountName),
+			ContainerName:      to.Ptr(b.AzureRM.ContainerName),
+		}
+	}
+	if b.Local != nil {
+		backend.Local = &TerraformLocalBackendProperties{
+			Path: to.Ptr(b.Local.Path),
+		}
+	}
+
+	return backend
+}
@@ -141,6 +141,16 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 								TemplateKind: recipes.TemplateKindBicep,
 								TemplatePath: "br:ghcr.io/sampleregistry/radius/recipes/rediscaches",
 							},
+							"helm-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind:    recipes.TemplateKindHelm,
+								TemplatePath:    "oci://ghcr.io/sampleregistry/charts/redis",
+								TemplateVersion: "18.1.0",
+							},
+							"kubernetes-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind:    recipes.TemplateKindKubernetes,
+								TemplatePath:    "git::https://github.com/sampleorg/recipes.git//redis",
+								TemplateVersion: "v1.0.0",
+							},
 						},
 						dapr_ctrl.DaprStateStoresResourceType: {
 							"statestore-recipe": datamodel.EnvironmentRecipeProperties{
@@ -268,6 +278,207 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			filename: "environmentresource-with-container-resources.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					ContainerResources: datamodel.ContainerResourcePolicy{
+						Defaults: datamodel.ContainerResourceRequirements{
+							Requests: datamodel.ContainerResourceQuantities{CPU: "100m", Memory: "128Mi"},
+							Limits:   datamodel.ContainerResourceQuantities{Memory: "256Mi"},
+						},
+						Maximum: datamodel.ContainerResourceQuantities{CPU: "2", Memory: "4Gi", EphemeralStorage: "10Gi"},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-gateway-api.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					Gateway: datamodel.EnvironmentGateway{
+						Implementation:   datamodel.GatewayImplementationGatewayAPI,
+						GatewayClassName: "eg",
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-backend.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Backend: &datamodel.TerraformBackend{
+								Kind: datamodel.TerraformBackendKindS3,
+								S3: &datamodel.TerraformS3Backend{
+									Bucket:       "tfstate",
+									Region:       "us-east-1",
+									Endpoint:     "http://minio.minio-system:9000",
+									UsePathStyle: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-providers.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Providers: map[string][]datamodel.ProviderConfigProperties{
+								"postgresql": {
+									{
+										AdditionalProperties: map[string]any{
+											"host": "postgres.default.svc.cluster.local",
+											"port": float64(5432),
+										},
+										Secrets: map[string]datamodel.SecretReference{
+											"password": {
+												Source: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/pg-secrets",
+												Key:    "password",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-authentication.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Authentication: datamodel.AuthConfig{
+								Git: datamodel.GitAuthConfig{
+									PAT: map[string]datamodel.SecretConfig{
+										"github.com": {Secret: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/github-secrets"},
+									},
+									SSH: map[string]datamodel.SecretConfig{
+										"dev.azure.com": {Secret: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/ssh-secrets"},
+									},
+								},
+								Registries: map[string]datamodel.SecretConfig{
+									"app.terraform.io": {Secret: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/registry-secrets"},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
 		{
 			filename: "environmentresource-invalid-missing-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
@@ -276,17 +487,41 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			filename: "environmentresource-invalid-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
 		},
+		{
+			filename: "environmentresource-invalid-gateway-implementation.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.gateway.implementation", ValidValue: "one of [contour gatewayAPI]"},
+		},
 		{
 			filename: "environmentresource-invalid-resourcetype.json",
 			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid resource type: \"Applications.Dapr/pubsub\""},
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\", \"kubernetes\""},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\", \"kubernetes\""},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-backend.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "the \"pg\" property is required for the \"pg\" Terraform backend"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-backend-secret.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid secret store \"pg-secrets\" for the \"connectionString\" property of the \"pg\" Terraform backend. The source must be the ID of an Applications.Core/secretStores resource"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-backend-local-path.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "the path \"tfstate\" of the \"local\" Terraform backend must be an absolute path"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-provider-secret.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid secret store \"pg-secrets\" for the \"password\" property of the Terraform provider \"postgresql\". The source must be the ID of an Applications.Core/secretStores resource"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-authentication.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid secret store \"github-secrets\" for host \"github.com\" in the Terraform git.pat authentication. The secret must be the ID of an Applications.Core/secretStores resource"},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
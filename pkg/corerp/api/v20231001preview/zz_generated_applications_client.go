@@ -308,6 +308,62 @@ func (client *ApplicationsClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// Restore - Restores the deleted application.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - applicationName - The application name
+//   - body - The content of the action request
+//   - options - ApplicationsClientRestoreOptions contains the optional parameters for the ApplicationsClient.Restore method.
+func (client *ApplicationsClient) Restore(ctx context.Context, applicationName string, body map[string]any, options *ApplicationsClientRestoreOptions) (ApplicationsClientRestoreResponse, error) {
+	var err error
+	req, err := client.restoreCreateRequest(ctx, applicationName, body, options)
+	if err != nil {
+		return ApplicationsClientRestoreResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ApplicationsClientRestoreResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ApplicationsClientRestoreResponse{}, err
+	}
+	resp, err := client.restoreHandleResponse(httpResp)
+	return resp, err
+}
+
+// restoreCreateRequest creates the Restore request.
+func (client *ApplicationsClient) restoreCreateRequest(ctx context.Context, applicationName string, body map[string]any, options *ApplicationsClientRestoreOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/applications/{applicationName}/restore"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if applicationName == "" {
+		return nil, errors.New("parameter applicationName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{applicationName}", url.PathEscape(applicationName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// restoreHandleResponse handles the Restore response.
+func (client *ApplicationsClient) restoreHandleResponse(resp *http.Response) (ApplicationsClientRestoreResponse, error) {
+	result := ApplicationsClientRestoreResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.ApplicationResource); err != nil {
+		return ApplicationsClientRestoreResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a ApplicationResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
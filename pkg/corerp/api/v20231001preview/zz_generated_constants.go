@@ -142,6 +142,45 @@ func PossibleDirectionValues() []Direction {
 	}
 }
 
+// DriftState - The drift state of the resources deployed by a recipe.
+type DriftState string
+
+const (
+	// DriftStateDrifted - The deployed resources were changed outside of Radius and no longer match the recipe.
+	DriftStateDrifted DriftState = "Drifted"
+	// DriftStateInSync - The deployed resources match the recipe.
+	DriftStateInSync DriftState = "InSync"
+	// DriftStateUnknown - The drift check failed and the state of the deployed resources is unknown.
+	DriftStateUnknown DriftState = "Unknown"
+)
+
+// PossibleDriftStateValues returns the possible values for the DriftState const type.
+func PossibleDriftStateValues() []DriftState {
+	return []DriftState{	
+		DriftStateDrifted,
+		DriftStateInSync,
+		DriftStateUnknown,
+	}
+}
+
+// GatewayImplementation - The implementation used to render the gateways of the environment.
+type GatewayImplementation string
+
+const (
+	// GatewayImplementationContour - Gateways are rendered as Contour HTTPProxy resources.
+	GatewayImplementationContour GatewayImplementation = "contour"
+	// GatewayImplementationGatewayAPI - Gateways are rendered as Kubernetes Gateway API resources.
+	GatewayImplementationGatewayAPI GatewayImplementation = "gatewayAPI"
+)
+
+// PossibleGatewayImplementationValues returns the possible values for the GatewayImplementation const type.
+func PossibleGatewayImplementationValues() []GatewayImplementation {
+	return []GatewayImplementation{	
+		GatewayImplementationContour,
+		GatewayImplementationGatewayAPI,
+	}
+}
+
 // IAMKind - The kind of IAM provider to configure
 type IAMKind string
 
@@ -358,6 +397,33 @@ func PossibleTLSMinVersionValues() []TLSMinVersion {
 	}
 }
 
+// TerraformBackendKind - The kind of the Terraform backend.
+type TerraformBackendKind string
+
+const (
+	// TerraformBackendKindAzurerm - The Terraform state is stored in an Azure Storage blob container.
+	TerraformBackendKindAzurerm TerraformBackendKind = "azurerm"
+	// TerraformBackendKindKubernetes - The Terraform state is stored in a Kubernetes secret.
+	TerraformBackendKindKubernetes TerraformBackendKind = "kubernetes"
+	// TerraformBackendKindLocal - The Terraform state is stored on the local file system, for example a persistent volume.
+	TerraformBackendKindLocal TerraformBackendKind = "local"
+	// TerraformBackendKindPg - The Terraform state is stored in a Postgres database.
+	TerraformBackendKindPg TerraformBackendKind = "pg"
+	// TerraformBackendKindS3 - The Terraform state is stored in an S3-compatible bucket.
+	TerraformBackendKindS3 TerraformBackendKind = "s3"
+)
+
+// PossibleTerraformBackendKindValues returns the possible values for the TerraformBackendKind const type.
+func PossibleTerraformBackendKindValues() []TerraformBackendKind {
+	return []TerraformBackendKind{	
+		TerraformBackendKindAzurerm,
+		TerraformBackendKindKubernetes,
+		TerraformBackendKindLocal,
+		TerraformBackendKindPg,
+		TerraformBackendKindS3,
+	}
+}
+
 // Versions - Supported API versions for the Applications.Core resource provider.
 type Versions string
 
@@ -28,7 +28,8 @@ type EnvironmentComputeUpdateClassification interface {
 // ExtensionClassification provides polymorphic access to related types.
 // Call the interface's GetExtension() method to access the common type.
 // Use a type switch to determine the concrete type.  The possible types are:
-// - *DaprSidecarExtension, *Extension, *KubernetesMetadataExtension, *KubernetesNamespaceExtension, *ManualScalingExtension
+// - *AutoScalingExtension, *DaprSidecarExtension, *EventScalingExtension, *Extension, *KubernetesMetadataExtension,
+// - *KubernetesNamespaceExtension, *ManualScalingExtension
 type ExtensionClassification interface {
 	// GetExtension returns the Extension content of the underlying type.
 	GetExtension() *Extension
@@ -63,6 +63,9 @@ type ApplicationProperties struct {
 	// The application extension.
 	Extensions []ExtensionClassification
 
+	// READ-ONLY; The time when the resource was deleted. It is set only for deleted resources which can be restored.
+	DeletedTime *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 
@@ -130,6 +133,54 @@ type ApplicationResourceUpdateProperties struct {
 	Simulated *bool
 }
 
+// AuthConfig - Authentication information used to download Terraform modules from private sources.
+type AuthConfig struct {
+	// Authentication information used to access private git repositories.
+	Git *GitAuthConfig
+
+	// Tokens used to access private Terraform module registries, keyed by the hostname of the registry. The secret store
+	// must contain the 'token' secret.
+	Registries map[string]*SecretConfig
+}
+
+// AutoScalingExtension - Horizontal autoscaling extension. The replicas of the container are scaled between the minimum
+// and maximum replica counts to meet the metric targets.
+type AutoScalingExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// REQUIRED; The maximum replica count.
+	MaxReplicas *int32
+
+	// Custom metrics to scale on. Each metric is read for each replica from the custom metrics API of the cluster.
+	Metrics []*AutoScalingMetric
+
+	// The minimum replica count. Defaults to 1.
+	MinReplicas *int32
+
+	// The target average CPU utilization of the replicas, in percent of the CPU requested by the container.
+	TargetCPUUtilization *int32
+
+	// The target average memory utilization of the replicas, in percent of the memory requested by the container.
+	TargetMemoryUtilization *int32
+}
+
+// GetExtension implements the ExtensionClassification interface for type AutoScalingExtension.
+func (a *AutoScalingExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: a.Kind,
+	}
+}
+
+// AutoScalingMetric - A custom metric to scale on.
+type AutoScalingMetric struct {
+	// REQUIRED; The name of the metric.
+	Name *string
+
+	// REQUIRED; The target average value of the metric for each replica, as a Kubernetes quantity such as '100' or '500m'.
+	TargetAverageValue *string
+}
+
 // AzureKeyVaultVolumeProperties - Represents Azure Key Vault Volume properties
 type AzureKeyVaultVolumeProperties struct {
 	// REQUIRED; Fully qualified resource ID for the application that the portable resource is consumed by
@@ -284,6 +335,9 @@ type Container struct {
 	// readiness probe properties
 	ReadinessProbe HealthProbePropertiesClassification
 
+	// The compute resources requested by the container and the limits on the compute resources it can use
+	Resources *ContainerResourceRequirements
+
 	// container volumes
 	Volumes map[string]VolumeClassification
 
@@ -349,6 +403,9 @@ type ContainerProperties struct {
 	// Configuration for supported external identity providers
 	Identity *IdentitySettings
 
+	// Init containers that run to completion, in order, before the container is started. The init containers are named 'init-<index>' and share the environment variables and secrets of the connections.
+	InitContainers []*Container
+
 	// Specifies how the underlying container resource is provisioned and managed.
 	ResourceProvisioning *ContainerResourceProvisioning
 
@@ -358,6 +415,9 @@ type ContainerProperties struct {
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
 
+	// Sidecar containers that run alongside the container. The sidecar containers are named 'sidecar-<index>' and share the environment variables and secrets of the connections.
+	Sidecars []*Container
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 
@@ -398,6 +458,38 @@ type ContainerResourceListResult struct {
 	NextLink *string
 }
 
+// ContainerResourcePolicy - The default and maximum compute resources of the containers of an environment.
+type ContainerResourcePolicy struct {
+	// The requests and limits of the containers that do not specify them. The defaults for CPU, memory and ephemeral storage
+	// are only applied to a container that specifies neither a request nor a limit for the resource.
+	Defaults *ContainerResourceRequirements
+
+	// The maximum requests and limits of the containers. Containers that request or are limited to more are rejected.
+	Maximum *ContainerResourceQuantities
+}
+
+// ContainerResourceQuantities - Quantities of compute resources, in the Kubernetes quantity format such as '500m' or '256Mi'
+type ContainerResourceQuantities struct {
+	// The CPU quantity, in cores or millicores such as '0.5' or '500m'
+	CPU *string
+
+	// The ephemeral storage quantity, in bytes such as '1Gi'
+	EphemeralStorage *string
+
+	// The memory quantity, in bytes such as '256Mi' or '1Gi'
+	Memory *string
+}
+
+// ContainerResourceRequirements - The compute resources requested by a container and the limits on the compute resources
+// it can use
+type ContainerResourceRequirements struct {
+	// The maximum compute resources the container can use
+	Limits *ContainerResourceQuantities
+
+	// The compute resources reserved for the container
+	Requests *ContainerResourceQuantities
+}
+
 // ContainerResourceUpdate - The type used for update operations of the ContainerResource.
 type ContainerResourceUpdate struct {
 	// The updatable properties of the ContainerResource.
@@ -427,6 +519,9 @@ type ContainerResourceUpdateProperties struct {
 	// Configuration for supported external identity providers
 	Identity *IdentitySettingsUpdate
 
+	// Init containers that run to completion, in order, before the container is started. The init containers are named 'init-<index>' and share the environment variables and secrets of the connections.
+	InitContainers []*ContainerUpdate
+
 	// Specifies how the underlying container resource is provisioned and managed.
 	ResourceProvisioning *ContainerResourceProvisioning
 
@@ -435,6 +530,9 @@ type ContainerResourceUpdateProperties struct {
 
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
+
+	// Sidecar containers that run alongside the container. The sidecar containers are named 'sidecar-<index>' and share the environment variables and secrets of the connections.
+	Sidecars []*ContainerUpdate
 }
 
 // ContainerUpdate - Definition of a container
@@ -463,6 +561,9 @@ type ContainerUpdate struct {
 	// readiness probe properties
 	ReadinessProbe HealthProbePropertiesClassification
 
+	// The compute resources requested by the container and the limits on the compute resources it can use
+	Resources *ContainerResourceRequirements
+
 	// container volumes
 	Volumes map[string]VolumeClassification
 
@@ -495,6 +596,33 @@ func (d *DaprSidecarExtension) GetExtension() *Extension {
 	}
 }
 
+// DriftChange - A drifted resource deployed by a recipe.
+type DriftChange struct {
+	// REQUIRED; The change that re-deploying the recipe would make to the resource, for example Modify.
+	ChangeType *string
+
+	// REQUIRED; The ID of the drifted resource.
+	ID *string
+
+	// The type of the drifted resource.
+	Type *string
+}
+
+// DriftStatus - The result of a drift check of the resources deployed by a recipe.
+type DriftStatus struct {
+	// REQUIRED; The drift state of the resources deployed by the recipe.
+	State *DriftState
+
+	// The changes that re-deploying the recipe would make to bring the resources back in sync.
+	Changes []*DriftChange
+
+	// The time of the drift check.
+	LastCheckedTime *time.Time
+
+	// Describes why the drift check failed when the state is Unknown.
+	Message *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -525,23 +653,44 @@ type EnvironmentComputeUpdate struct {
 // GetEnvironmentComputeUpdate implements the EnvironmentComputeUpdateClassification interface for type EnvironmentComputeUpdate.
 func (e *EnvironmentComputeUpdate) GetEnvironmentComputeUpdate() *EnvironmentComputeUpdate { return e }
 
+// EnvironmentGateway - The gateway configuration of the environment.
+type EnvironmentGateway struct {
+	// The name of the GatewayClass of the Kubernetes Gateway API resources. Required when the implementation is 'gatewayAPI'.
+	GatewayClassName *string
+
+	// The implementation used to render the gateways of the environment. Defaults to 'contour'.
+	Implementation *GatewayImplementation
+}
+
 // EnvironmentProperties - Environment properties
 type EnvironmentProperties struct {
 	// REQUIRED; The compute resource used by application environment.
 	Compute EnvironmentComputeClassification
 
+	// The default and maximum compute resources of the containers of the environment.
+	ContainerResources *ContainerResourcePolicy
+
 	// The environment extension.
 	Extensions []ExtensionClassification
 
+	// The gateway configuration of the environment.
+	Gateway *EnvironmentGateway
+
 	// Cloud providers configuration for the environment.
 	Providers *Providers
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigProperties
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesClassification
 
 	// Simulated environment.
 	Simulated *bool
 
+	// READ-ONLY; The time when the resource was deleted. It is set only for deleted resources which can be restored.
+	DeletedTime *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -593,12 +742,21 @@ type EnvironmentResourceUpdateProperties struct {
 	// The compute resource used by application environment.
 	Compute EnvironmentComputeUpdateClassification
 
+	// The default and maximum compute resources of the containers of the environment.
+	ContainerResources *ContainerResourcePolicy
+
 	// The environment extension.
 	Extensions []ExtensionClassification
 
+	// The gateway configuration of the environment.
+	Gateway *EnvironmentGateway
+
 	// Cloud providers configuration for the environment.
 	Providers *ProvidersUpdate
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigProperties
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesUpdateClassification
 
@@ -660,6 +818,45 @@ type ErrorResponse struct {
 	Error *ErrorDetail
 }
 
+// EventScalingExtension - Event-driven scaling extension. The replicas of the container are scaled by KEDA between the minimum
+// and maximum replica counts, using triggers derived from the connections of the container to queues and pub/sub brokers.
+type EventScalingExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// REQUIRED; The maximum replica count.
+	MaxReplicas *int32
+
+	// The period in seconds to wait after the last active trigger before scaling to the minimum replica count. Defaults to 300.
+	CooldownPeriod *int32
+
+	// The minimum replica count. Defaults to 0, so the container is scaled to zero when there are no pending events.
+	MinReplicas *int32
+
+	// The interval in seconds at which each trigger is checked. Defaults to 30.
+	PollingInterval *int32
+
+	// The triggers to scale on, keyed by connection name. When omitted, a trigger is derived from each connection of the container
+	// to a supported resource.
+	Triggers map[string]*EventScalingTrigger
+}
+
+// GetExtension implements the ExtensionClassification interface for type EventScalingExtension.
+func (e *EventScalingExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: e.Kind,
+	}
+}
+
+// EventScalingTrigger - A trigger derived from a connection of the container.
+type EventScalingTrigger struct {
+	// The target number of pending messages for each replica. Defaults to 5.
+	TargetLength *int32
+
+	// The topic to scale on. Required for connections to Dapr pub/sub brokers.
+	Topic *string
+}
+
 // ExecHealthProbeProperties - Specifies the properties for readiness/liveness probe using an executable
 type ExecHealthProbeProperties struct {
 	// REQUIRED; Command to execute to probe readiness/liveness
@@ -917,6 +1114,17 @@ type GatewayTLS struct {
 	SSLPassthrough *bool
 }
 
+// GitAuthConfig - Authentication information used to access private git repositories.
+type GitAuthConfig struct {
+	// Personal access tokens, keyed by the hostname of the git server. The secret store must contain the 'pat' secret and
+	// can contain the 'username' secret.
+	Pat map[string]*SecretConfig
+
+	// SSH private keys, keyed by the hostname of the git server. The secret store must contain the 'privateKey' secret and
+	// can contain the 'knownHosts' secret.
+	SSH map[string]*SecretConfig
+}
+
 // HTTPGetHealthProbeProperties - Specifies the properties for readiness/liveness probe using HTTP Get
 type HTTPGetHealthProbeProperties struct {
 	// REQUIRED; The listening port number
@@ -1060,6 +1268,54 @@ type HealthProbeProperties struct {
 // GetHealthProbeProperties implements the HealthProbePropertiesClassification interface for type HealthProbeProperties.
 func (h *HealthProbeProperties) GetHealthProbeProperties() *HealthProbeProperties { return h }
 
+// HelmRecipeProperties - Represents Helm recipe properties.
+type HelmRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Version of the Helm chart to deploy. The latest version is used when omitted.
+	TemplateVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
+// HelmRecipePropertiesUpdate - Represents Helm recipe properties.
+type HelmRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Version of the Helm chart to deploy. The latest version is used when omitted.
+	TemplateVersion *string
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type HelmRecipePropertiesUpdate.
+func (h *HelmRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
 // IamProperties - IAM properties
 type IamProperties struct {
 	// REQUIRED; The kind of IAM provider to configure
@@ -1197,6 +1453,54 @@ func (k *KubernetesNamespaceExtension) GetExtension() *Extension {
 	}
 }
 
+// KubernetesRecipeProperties - Represents Kubernetes recipe properties.
+type KubernetesRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Version of the manifests to deploy. This is the tag of an OCI artifact or the git reference of a git repository.
+	TemplateVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type KubernetesRecipeProperties.
+func (k *KubernetesRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters: k.Parameters,
+		TemplateKind: k.TemplateKind,
+		TemplatePath: k.TemplatePath,
+	}
+}
+
+// KubernetesRecipePropertiesUpdate - Represents Kubernetes recipe properties.
+type KubernetesRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Version of the manifests to deploy. This is the tag of an OCI artifact or the git reference of a git repository.
+	TemplateVersion *string
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type KubernetesRecipePropertiesUpdate.
+func (k *KubernetesRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		Parameters: k.Parameters,
+		TemplateKind: k.TemplateKind,
+		TemplatePath: k.TemplatePath,
+	}
+}
+
 // KubernetesRuntimeProperties - The runtime configuration properties for Kubernetes
 type KubernetesRuntimeProperties struct {
 	// The serialized YAML manifest which represents the base Kubernetes resources to deploy, such as Deployment, Service, ServiceAccount,
@@ -1309,6 +1613,16 @@ func (p *PersistentVolume) GetVolume() *Volume {
 	}
 }
 
+// ProviderConfigProperties - The configuration of a Terraform provider. It can contain any property supported by the provider.
+type ProviderConfigProperties struct {
+	// OPTIONAL; Contains additional key/value pairs not defined in the schema.
+	AdditionalProperties map[string]any
+
+	// Secret properties of the provider configuration, keyed by the name of the property. The values are resolved from Applications.Core/secretStores
+	// resources.
+	Secrets map[string]*SecretReference
+}
+
 // Providers - The Cloud providers configuration
 type Providers struct {
 	// The AWS cloud provider configuration
@@ -1360,6 +1674,12 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipeConfigProperties - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+type RecipeConfigProperties struct {
+	// Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as part of Recipe deployment.
+	Terraform *TerraformConfigProperties
+}
+
 // RecipeGetMetadata - Represents the request body of the getmetadata action.
 type RecipeGetMetadata struct {
 	// REQUIRED; The name of the recipe registered to the environment
@@ -1374,7 +1694,7 @@ type RecipeGetMetadataResponse struct {
 	// REQUIRED; The key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
-	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform.
+	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, helm, kubernetes, terraform.
 	TemplateKind *string
 
 	// REQUIRED; The path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
@@ -1385,7 +1705,7 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
-// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, helm, kubernetes, terraform.
 type RecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1400,7 +1720,7 @@ type RecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type RecipeProperties.
 func (r *RecipeProperties) GetRecipeProperties() *RecipeProperties { return r }
 
-// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, helm, kubernetes, terraform.
 type RecipePropertiesUpdate struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1452,6 +1772,9 @@ type ResourceStatus struct {
 
 	// Properties of an output resource
 	OutputResources []*OutputResource
+
+	// READ-ONLY; The result of the last drift check of the resources deployed by the recipe.
+	DriftStatus *DriftStatus
 }
 
 // RuntimesProperties - The properties for runtime configuration
@@ -1460,6 +1783,12 @@ type RuntimesProperties struct {
 	Kubernetes *KubernetesRuntimeProperties
 }
 
+// SecretConfig - A reference to an Applications.Core/secretStores resource containing the credentials.
+type SecretConfig struct {
+	// REQUIRED; The ID of the Applications.Core/secretStores resource containing the credentials.
+	Secret *string
+}
+
 // SecretObjectProperties - Represents secret object properties
 type SecretObjectProperties struct {
 	// REQUIRED; The name of the secret
@@ -1475,6 +1804,15 @@ type SecretObjectProperties struct {
 	Version *string
 }
 
+// SecretReference - A reference to a secret value in an Applications.Core/secretStores resource.
+type SecretReference struct {
+	// REQUIRED; The key of the secret in the secret store.
+	Key *string
+
+	// REQUIRED; The ID of the Applications.Core/secretStores resource containing the secret.
+	Source *string
+}
+
 // SecretStoreListSecretsResult - The list of secrets
 type SecretStoreListSecretsResult struct {
 	// REQUIRED; An object to represent key-value type secrets
@@ -1633,6 +1971,64 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformAzureRMBackendProperties - The Azure Storage Terraform backend configuration. The Azure credential registered with Radius is used to access the storage account.
+type TerraformAzureRMBackendProperties struct {
+	// REQUIRED; The name of the blob container.
+	ContainerName *string
+
+	// REQUIRED; The name of the resource group of the storage account.
+	ResourceGroupName *string
+
+	// REQUIRED; The name of the storage account.
+	StorageAccountName *string
+
+	// The ID of the subscription of the storage account. The subscription of the Azure provider scope of the environment is used if it is not specified.
+	SubscriptionID *string
+}
+
+// TerraformBackendProperties - The Terraform backend used to store the Terraform state of the Recipes.
+type TerraformBackendProperties struct {
+	// REQUIRED; The kind of the Terraform backend.
+	Kind *TerraformBackendKind
+
+	// The Azure Storage backend configuration. Required when kind is 'azurerm'.
+	Azurerm *TerraformAzureRMBackendProperties
+
+	// The local backend configuration. Required when kind is 'local'.
+	Local *TerraformLocalBackendProperties
+
+	// The Postgres backend configuration. Required when kind is 'pg'.
+	Pg *TerraformPostgresBackendProperties
+
+	// The S3 backend configuration. Required when kind is 's3'.
+	S3 *TerraformS3BackendProperties
+}
+
+// TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as part of Recipe deployment.
+type TerraformConfigProperties struct {
+	// Authentication information used to download Terraform modules from private sources.
+	Authentication *AuthConfig
+
+	// The backend used to store the Terraform state of the Recipes. The Kubernetes backend is used if it is not specified.
+	Backend *TerraformBackendProperties
+
+	// Configuration for Terraform providers, keyed by the name of the provider. A provider can have multiple configurations,
+	// for example with different aliases. The configurations are added alongside the configurations generated by Radius.
+	Providers map[string][]*ProviderConfigProperties
+}
+
+// TerraformLocalBackendProperties - The local Terraform backend configuration.
+type TerraformLocalBackendProperties struct {
+	// REQUIRED; The absolute path to the directory in which the Terraform state is stored. The directory should be on a persistent volume mounted to Radius.
+	Path *string
+}
+
+// TerraformPostgresBackendProperties - The Postgres Terraform backend configuration.
+type TerraformPostgresBackendProperties struct {
+	// REQUIRED; The secret containing the Postgres connection string.
+	ConnectionString *SecretReference
+}
+
 // TerraformRecipeProperties - Represents Terraform recipe properties.
 type TerraformRecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
@@ -1683,6 +2079,21 @@ func (t *TerraformRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePro
 	}
 }
 
+// TerraformS3BackendProperties - The S3 Terraform backend configuration. The AWS credential registered with Radius is used to access the bucket, or the default AWS credential chain if none is registered.
+type TerraformS3BackendProperties struct {
+	// REQUIRED; The name of the bucket.
+	Bucket *string
+
+	// The endpoint of an S3-compatible storage service, for example MinIO. The AWS S3 endpoint is used if it is not specified.
+	Endpoint *string
+
+	// The region of the bucket.
+	Region *string
+
+	// Use path-style addressing for the bucket. This is usually required by S3-compatible storage services.
+	UsePathStyle *bool
+}
+
 // TrackedResource - The resource model definition for an Azure Resource Manager tracked top level resource which has 'tags'
 // and a 'location'
 type TrackedResource struct {
@@ -153,6 +153,7 @@ func (a *ApplicationGraphResponse) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type ApplicationProperties.
 func (a ApplicationProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "deletedTime", a.DeletedTime)
 	populate(objectMap, "environment", a.Environment)
 	populate(objectMap, "extensions", a.Extensions)
 	populate(objectMap, "provisioningState", a.ProvisioningState)
@@ -169,6 +170,9 @@ func (a *ApplicationProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "deletedTime":
+				err = unpopulateTimeRFC3339(val, "DeletedTime", &a.DeletedTime)
+			delete(rawMsg, key)
 		case "environment":
 				err = unpopulate(val, "Environment", &a.Environment)
 			delete(rawMsg, key)
@@ -356,6 +360,115 @@ func (a *ApplicationResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type AuthConfig.
+func (a AuthConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "git", a.Git)
+	populate(objectMap, "registries", a.Registries)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AuthConfig.
+func (a *AuthConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "git":
+				err = unpopulate(val, "Git", &a.Git)
+			delete(rawMsg, key)
+		case "registries":
+				err = unpopulate(val, "Registries", &a.Registries)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AutoScalingExtension.
+func (a AutoScalingExtension) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	objectMap["kind"] = "autoScaling"
+	populate(objectMap, "maxReplicas", a.MaxReplicas)
+	populate(objectMap, "metrics", a.Metrics)
+	populate(objectMap, "minReplicas", a.MinReplicas)
+	populate(objectMap, "targetCpuUtilization", a.TargetCPUUtilization)
+	populate(objectMap, "targetMemoryUtilization", a.TargetMemoryUtilization)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AutoScalingExtension.
+func (a *AutoScalingExtension) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "maxReplicas":
+				err = unpopulate(val, "MaxReplicas", &a.MaxReplicas)
+			delete(rawMsg, key)
+		case "metrics":
+				err = unpopulate(val, "Metrics", &a.Metrics)
+			delete(rawMsg, key)
+		case "minReplicas":
+				err = unpopulate(val, "MinReplicas", &a.MinReplicas)
+			delete(rawMsg, key)
+		case "targetCpuUtilization":
+				err = unpopulate(val, "TargetCPUUtilization", &a.TargetCPUUtilization)
+			delete(rawMsg, key)
+		case "targetMemoryUtilization":
+				err = unpopulate(val, "TargetMemoryUtilization", &a.TargetMemoryUtilization)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AutoScalingMetric.
+func (a AutoScalingMetric) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "name", a.Name)
+	populate(objectMap, "targetAverageValue", a.TargetAverageValue)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AutoScalingMetric.
+func (a *AutoScalingMetric) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "name":
+				err = unpopulate(val, "Name", &a.Name)
+			delete(rawMsg, key)
+		case "targetAverageValue":
+				err = unpopulate(val, "TargetAverageValue", &a.TargetAverageValue)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AzureKeyVaultVolumeProperties.
 func (a AzureKeyVaultVolumeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -613,6 +726,7 @@ func (c Container) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "livenessProbe", c.LivenessProbe)
 	populate(objectMap, "ports", c.Ports)
 	populate(objectMap, "readinessProbe", c.ReadinessProbe)
+	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "volumes", c.Volumes)
 	populate(objectMap, "workingDir", c.WorkingDir)
 	return json.Marshal(objectMap)
@@ -651,6 +765,9 @@ func (c *Container) UnmarshalJSON(data []byte) error {
 		case "readinessProbe":
 			c.ReadinessProbe, err = unmarshalHealthProbePropertiesClassification(val)
 			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &c.Resources)
+			delete(rawMsg, key)
 		case "volumes":
 			c.Volumes, err = unmarshalVolumeClassificationMap(val)
 			delete(rawMsg, key)
@@ -760,10 +877,12 @@ func (c ContainerProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "environment", c.Environment)
 	populate(objectMap, "extensions", c.Extensions)
 	populate(objectMap, "identity", c.Identity)
+	populate(objectMap, "initContainers", c.InitContainers)
 	populate(objectMap, "provisioningState", c.ProvisioningState)
 	populate(objectMap, "resourceProvisioning", c.ResourceProvisioning)
 	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "runtimes", c.Runtimes)
+	populate(objectMap, "sidecars", c.Sidecars)
 	populate(objectMap, "status", c.Status)
 	return json.Marshal(objectMap)
 }
@@ -795,6 +914,9 @@ func (c *ContainerProperties) UnmarshalJSON(data []byte) error {
 		case "identity":
 				err = unpopulate(val, "Identity", &c.Identity)
 			delete(rawMsg, key)
+		case "initContainers":
+				err = unpopulate(val, "InitContainers", &c.InitContainers)
+			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &c.ProvisioningState)
 			delete(rawMsg, key)
@@ -807,6 +929,9 @@ func (c *ContainerProperties) UnmarshalJSON(data []byte) error {
 		case "runtimes":
 				err = unpopulate(val, "Runtimes", &c.Runtimes)
 			delete(rawMsg, key)
+		case "sidecars":
+				err = unpopulate(val, "Sidecars", &c.Sidecars)
+			delete(rawMsg, key)
 		case "status":
 				err = unpopulate(val, "Status", &c.Status)
 			delete(rawMsg, key)
@@ -900,6 +1025,103 @@ func (c *ContainerResourceListResult) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ContainerResourcePolicy.
+func (c ContainerResourcePolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "defaults", c.Defaults)
+	populate(objectMap, "maximum", c.Maximum)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ContainerResourcePolicy.
+func (c *ContainerResourcePolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "defaults":
+				err = unpopulate(val, "Defaults", &c.Defaults)
+			delete(rawMsg, key)
+		case "maximum":
+				err = unpopulate(val, "Maximum", &c.Maximum)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ContainerResourceQuantities.
+func (c ContainerResourceQuantities) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "cpu", c.CPU)
+	populate(objectMap, "ephemeralStorage", c.EphemeralStorage)
+	populate(objectMap, "memory", c.Memory)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ContainerResourceQuantities.
+func (c *ContainerResourceQuantities) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "cpu":
+				err = unpopulate(val, "CPU", &c.CPU)
+			delete(rawMsg, key)
+		case "ephemeralStorage":
+				err = unpopulate(val, "EphemeralStorage", &c.EphemeralStorage)
+			delete(rawMsg, key)
+		case "memory":
+				err = unpopulate(val, "Memory", &c.Memory)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ContainerResourceRequirements.
+func (c ContainerResourceRequirements) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "limits", c.Limits)
+	populate(objectMap, "requests", c.Requests)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ContainerResourceRequirements.
+func (c *ContainerResourceRequirements) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "limits":
+				err = unpopulate(val, "Limits", &c.Limits)
+			delete(rawMsg, key)
+		case "requests":
+				err = unpopulate(val, "Requests", &c.Requests)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ContainerResourceUpdate.
 func (c ContainerResourceUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -940,9 +1162,11 @@ func (c ContainerResourceUpdateProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "environment", c.Environment)
 	populate(objectMap, "extensions", c.Extensions)
 	populate(objectMap, "identity", c.Identity)
+	populate(objectMap, "initContainers", c.InitContainers)
 	populate(objectMap, "resourceProvisioning", c.ResourceProvisioning)
 	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "runtimes", c.Runtimes)
+	populate(objectMap, "sidecars", c.Sidecars)
 	return json.Marshal(objectMap)
 }
 
@@ -973,6 +1197,9 @@ func (c *ContainerResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "identity":
 				err = unpopulate(val, "Identity", &c.Identity)
 			delete(rawMsg, key)
+		case "initContainers":
+				err = unpopulate(val, "InitContainers", &c.InitContainers)
+			delete(rawMsg, key)
 		case "resourceProvisioning":
 				err = unpopulate(val, "ResourceProvisioning", &c.ResourceProvisioning)
 			delete(rawMsg, key)
@@ -982,6 +1209,9 @@ func (c *ContainerResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "runtimes":
 				err = unpopulate(val, "Runtimes", &c.Runtimes)
 			delete(rawMsg, key)
+		case "sidecars":
+				err = unpopulate(val, "Sidecars", &c.Sidecars)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", c, err)
@@ -1001,6 +1231,7 @@ func (c ContainerUpdate) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "livenessProbe", c.LivenessProbe)
 	populate(objectMap, "ports", c.Ports)
 	populate(objectMap, "readinessProbe", c.ReadinessProbe)
+	populate(objectMap, "resources", c.Resources)
 	populate(objectMap, "volumes", c.Volumes)
 	populate(objectMap, "workingDir", c.WorkingDir)
 	return json.Marshal(objectMap)
@@ -1039,6 +1270,9 @@ func (c *ContainerUpdate) UnmarshalJSON(data []byte) error {
 		case "readinessProbe":
 			c.ReadinessProbe, err = unmarshalHealthProbePropertiesClassification(val)
 			delete(rawMsg, key)
+		case "resources":
+				err = unpopulate(val, "Resources", &c.Resources)
+			delete(rawMsg, key)
 		case "volumes":
 			c.Volumes, err = unmarshalVolumeClassificationMap(val)
 			delete(rawMsg, key)
@@ -1096,6 +1330,80 @@ func (d *DaprSidecarExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type DriftChange.
+func (d DriftChange) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "changeType", d.ChangeType)
+	populate(objectMap, "id", d.ID)
+	populate(objectMap, "type", d.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type DriftChange.
+func (d *DriftChange) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", d, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "changeType":
+				err = unpopulate(val, "ChangeType", &d.ChangeType)
+			delete(rawMsg, key)
+		case "id":
+				err = unpopulate(val, "ID", &d.ID)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &d.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", d, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type DriftStatus.
+func (d DriftStatus) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "changes", d.Changes)
+	populateTimeRFC3339(objectMap, "lastCheckedTime", d.LastCheckedTime)
+	populate(objectMap, "message", d.Message)
+	populate(objectMap, "state", d.State)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type DriftStatus.
+func (d *DriftStatus) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", d, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "changes":
+				err = unpopulate(val, "Changes", &d.Changes)
+			delete(rawMsg, key)
+		case "lastCheckedTime":
+				err = unpopulateTimeRFC3339(val, "LastCheckedTime", &d.LastCheckedTime)
+			delete(rawMsg, key)
+		case "message":
+				err = unpopulate(val, "Message", &d.Message)
+			delete(rawMsg, key)
+		case "state":
+				err = unpopulate(val, "State", &d.State)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", d, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentCompute.
 func (e EnvironmentCompute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1166,13 +1474,48 @@ func (e *EnvironmentComputeUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentGateway.
+func (e EnvironmentGateway) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "gatewayClassName", e.GatewayClassName)
+	populate(objectMap, "implementation", e.Implementation)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentGateway.
+func (e *EnvironmentGateway) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "gatewayClassName":
+				err = unpopulate(val, "GatewayClassName", &e.GatewayClassName)
+			delete(rawMsg, key)
+		case "implementation":
+				err = unpopulate(val, "Implementation", &e.Implementation)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentProperties.
 func (e EnvironmentProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
+	populate(objectMap, "containerResources", e.ContainerResources)
+	populateTimeRFC3339(objectMap, "deletedTime", e.DeletedTime)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "gateway", e.Gateway)
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "provisioningState", e.ProvisioningState)
+	populate(objectMap, "recipeConfig", e.RecipeConfig)
 	populate(objectMap, "recipes", e.Recipes)
 	populate(objectMap, "simulated", e.Simulated)
 	return json.Marshal(objectMap)
@@ -1190,15 +1533,27 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 		case "compute":
 			e.Compute, err = unmarshalEnvironmentComputeClassification(val)
 			delete(rawMsg, key)
+		case "containerResources":
+				err = unpopulate(val, "ContainerResources", &e.ContainerResources)
+			delete(rawMsg, key)
+		case "deletedTime":
+				err = unpopulateTimeRFC3339(val, "DeletedTime", &e.DeletedTime)
+			delete(rawMsg, key)
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "gateway":
+				err = unpopulate(val, "Gateway", &e.Gateway)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &e.ProvisioningState)
 			delete(rawMsg, key)
+		case "recipeConfig":
+				err = unpopulate(val, "RecipeConfig", &e.RecipeConfig)
+			delete(rawMsg, key)
 		case "recipes":
 			var recipesRaw map[string]json.RawMessage
 			if err = json.Unmarshal(val, &recipesRaw); err != nil {
@@ -1341,8 +1696,11 @@ func (e *EnvironmentResourceUpdate) UnmarshalJSON(data []byte) error {
 func (e EnvironmentResourceUpdateProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
+	populate(objectMap, "containerResources", e.ContainerResources)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "gateway", e.Gateway)
 	populate(objectMap, "providers", e.Providers)
+	populate(objectMap, "recipeConfig", e.RecipeConfig)
 	populate(objectMap, "recipes", e.Recipes)
 	populate(objectMap, "simulated", e.Simulated)
 	return json.Marshal(objectMap)
@@ -1360,12 +1718,21 @@ func (e *EnvironmentResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 		case "compute":
 			e.Compute, err = unmarshalEnvironmentComputeUpdateClassification(val)
 			delete(rawMsg, key)
+		case "containerResources":
+				err = unpopulate(val, "ContainerResources", &e.ContainerResources)
+			delete(rawMsg, key)
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "gateway":
+				err = unpopulate(val, "Gateway", &e.Gateway)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
+		case "recipeConfig":
+				err = unpopulate(val, "RecipeConfig", &e.RecipeConfig)
+			delete(rawMsg, key)
 		case "recipes":
 			var recipesRaw map[string]json.RawMessage
 			if err = json.Unmarshal(val, &recipesRaw); err != nil {
@@ -1527,20 +1894,20 @@ func (e *ErrorResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type ExecHealthProbeProperties.
-func (e ExecHealthProbeProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type EventScalingExtension.
+func (e EventScalingExtension) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "command", e.Command)
-	populate(objectMap, "failureThreshold", e.FailureThreshold)
-	populate(objectMap, "initialDelaySeconds", e.InitialDelaySeconds)
-	objectMap["kind"] = "exec"
-	populate(objectMap, "periodSeconds", e.PeriodSeconds)
-	populate(objectMap, "timeoutSeconds", e.TimeoutSeconds)
+	populate(objectMap, "cooldownPeriod", e.CooldownPeriod)
+	objectMap["kind"] = "eventScaling"
+	populate(objectMap, "maxReplicas", e.MaxReplicas)
+	populate(objectMap, "minReplicas", e.MinReplicas)
+	populate(objectMap, "pollingInterval", e.PollingInterval)
+	populate(objectMap, "triggers", e.Triggers)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type ExecHealthProbeProperties.
-func (e *ExecHealthProbeProperties) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type EventScalingExtension.
+func (e *EventScalingExtension) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
 		return fmt.Errorf("unmarshalling type %T: %v", e, err)
@@ -1548,23 +1915,23 @@ func (e *ExecHealthProbeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "command":
-				err = unpopulate(val, "Command", &e.Command)
-			delete(rawMsg, key)
-		case "failureThreshold":
-				err = unpopulate(val, "FailureThreshold", &e.FailureThreshold)
-			delete(rawMsg, key)
-		case "initialDelaySeconds":
-				err = unpopulate(val, "InitialDelaySeconds", &e.InitialDelaySeconds)
+		case "cooldownPeriod":
+				err = unpopulate(val, "CooldownPeriod", &e.CooldownPeriod)
 			delete(rawMsg, key)
 		case "kind":
 				err = unpopulate(val, "Kind", &e.Kind)
 			delete(rawMsg, key)
-		case "periodSeconds":
-				err = unpopulate(val, "PeriodSeconds", &e.PeriodSeconds)
+		case "maxReplicas":
+				err = unpopulate(val, "MaxReplicas", &e.MaxReplicas)
 			delete(rawMsg, key)
-		case "timeoutSeconds":
-				err = unpopulate(val, "TimeoutSeconds", &e.TimeoutSeconds)
+		case "minReplicas":
+				err = unpopulate(val, "MinReplicas", &e.MinReplicas)
+			delete(rawMsg, key)
+		case "pollingInterval":
+				err = unpopulate(val, "PollingInterval", &e.PollingInterval)
+			delete(rawMsg, key)
+		case "triggers":
+				err = unpopulate(val, "Triggers", &e.Triggers)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -1574,14 +1941,92 @@ func (e *ExecHealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type ExtenderProperties.
-func (e ExtenderProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type EventScalingTrigger.
+func (e EventScalingTrigger) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "application", e.Application)
-	populate(objectMap, "environment", e.Environment)
-	populate(objectMap, "provisioningState", e.ProvisioningState)
-	populate(objectMap, "recipe", e.Recipe)
-	populate(objectMap, "resourceProvisioning", e.ResourceProvisioning)
+	populate(objectMap, "targetLength", e.TargetLength)
+	populate(objectMap, "topic", e.Topic)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EventScalingTrigger.
+func (e *EventScalingTrigger) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "targetLength":
+				err = unpopulate(val, "TargetLength", &e.TargetLength)
+			delete(rawMsg, key)
+		case "topic":
+				err = unpopulate(val, "Topic", &e.Topic)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ExecHealthProbeProperties.
+func (e ExecHealthProbeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "command", e.Command)
+	populate(objectMap, "failureThreshold", e.FailureThreshold)
+	populate(objectMap, "initialDelaySeconds", e.InitialDelaySeconds)
+	objectMap["kind"] = "exec"
+	populate(objectMap, "periodSeconds", e.PeriodSeconds)
+	populate(objectMap, "timeoutSeconds", e.TimeoutSeconds)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ExecHealthProbeProperties.
+func (e *ExecHealthProbeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "command":
+				err = unpopulate(val, "Command", &e.Command)
+			delete(rawMsg, key)
+		case "failureThreshold":
+				err = unpopulate(val, "FailureThreshold", &e.FailureThreshold)
+			delete(rawMsg, key)
+		case "initialDelaySeconds":
+				err = unpopulate(val, "InitialDelaySeconds", &e.InitialDelaySeconds)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &e.Kind)
+			delete(rawMsg, key)
+		case "periodSeconds":
+				err = unpopulate(val, "PeriodSeconds", &e.PeriodSeconds)
+			delete(rawMsg, key)
+		case "timeoutSeconds":
+				err = unpopulate(val, "TimeoutSeconds", &e.TimeoutSeconds)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ExtenderProperties.
+func (e ExtenderProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "application", e.Application)
+	populate(objectMap, "environment", e.Environment)
+	populate(objectMap, "provisioningState", e.ProvisioningState)
+	populate(objectMap, "recipe", e.Recipe)
+	populate(objectMap, "resourceProvisioning", e.ResourceProvisioning)
 	populate(objectMap, "secrets", e.Secrets)
 	populate(objectMap, "status", e.Status)
 	if e.AdditionalProperties != nil {
@@ -2143,6 +2588,37 @@ func (g *GatewayTLS) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type GitAuthConfig.
+func (g GitAuthConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "pat", g.Pat)
+	populate(objectMap, "ssh", g.SSH)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GitAuthConfig.
+func (g *GitAuthConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "pat":
+				err = unpopulate(val, "Pat", &g.Pat)
+			delete(rawMsg, key)
+		case "ssh":
+				err = unpopulate(val, "SSH", &g.SSH)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type HTTPGetHealthProbeProperties.
 func (h HTTPGetHealthProbeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2448,6 +2924,84 @@ func (h *HealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type HelmRecipeProperties.
+func (h HelmRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", h.Parameters)
+	objectMap["templateKind"] = "helm"
+	populate(objectMap, "templatePath", h.TemplatePath)
+	populate(objectMap, "templateVersion", h.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+				err = unpopulate(val, "Parameters", &h.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &h.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &h.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &h.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type HelmRecipePropertiesUpdate.
+func (h HelmRecipePropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", h.Parameters)
+	objectMap["templateKind"] = "helm"
+	populate(objectMap, "templatePath", h.TemplatePath)
+	populate(objectMap, "templateVersion", h.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type HelmRecipePropertiesUpdate.
+func (h *HelmRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", h, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+				err = unpopulate(val, "Parameters", &h.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &h.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &h.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &h.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", h, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type IamProperties.
 func (i IamProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2759,6 +3313,84 @@ func (k *KubernetesNamespaceExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type KubernetesRecipeProperties.
+func (k KubernetesRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", k.Parameters)
+	objectMap["templateKind"] = "kubernetes"
+	populate(objectMap, "templatePath", k.TemplatePath)
+	populate(objectMap, "templateVersion", k.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesRecipeProperties.
+func (k *KubernetesRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+				err = unpopulate(val, "Parameters", &k.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &k.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &k.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &k.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type KubernetesRecipePropertiesUpdate.
+func (k KubernetesRecipePropertiesUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "parameters", k.Parameters)
+	objectMap["templateKind"] = "kubernetes"
+	populate(objectMap, "templatePath", k.TemplatePath)
+	populate(objectMap, "templateVersion", k.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesRecipePropertiesUpdate.
+func (k *KubernetesRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "parameters":
+				err = unpopulate(val, "Parameters", &k.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &k.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &k.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &k.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type KubernetesRuntimeProperties.
 func (k KubernetesRuntimeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3008,6 +3640,48 @@ func (p *PersistentVolume) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ProviderConfigProperties.
+func (p ProviderConfigProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secrets", p.Secrets)
+	if p.AdditionalProperties != nil {
+		for key, val := range p.AdditionalProperties {
+			objectMap[key] = val
+		}
+	}
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ProviderConfigProperties.
+func (p *ProviderConfigProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", p, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secrets":
+				err = unpopulate(val, "Secrets", &p.Secrets)
+			delete(rawMsg, key)
+		default:
+			if p.AdditionalProperties == nil {
+				p.AdditionalProperties = map[string]any{}
+			}
+			if val != nil {
+				var aux any
+				err = json.Unmarshal(val, &aux)
+				p.AdditionalProperties[key] = aux
+			}
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", p, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type Providers.
 func (p Providers) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3209,6 +3883,33 @@ func (r *Recipe) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RecipeConfigProperties.
+func (r RecipeConfigProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "terraform", r.Terraform)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeConfigProperties.
+func (r *RecipeConfigProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "terraform":
+				err = unpopulate(val, "Terraform", &r.Terraform)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type RecipeGetMetadata.
 func (r RecipeGetMetadata) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3450,6 +4151,7 @@ func (r *ResourceReference) UnmarshalJSON(data []byte) error {
 func (r ResourceStatus) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", r.Compute)
+	populate(objectMap, "driftStatus", r.DriftStatus)
 	populate(objectMap, "outputResources", r.OutputResources)
 	return json.Marshal(objectMap)
 }
@@ -3466,6 +4168,9 @@ func (r *ResourceStatus) UnmarshalJSON(data []byte) error {
 		case "compute":
 			r.Compute, err = unmarshalEnvironmentComputeClassification(val)
 			delete(rawMsg, key)
+		case "driftStatus":
+				err = unpopulate(val, "DriftStatus", &r.DriftStatus)
+			delete(rawMsg, key)
 		case "outputResources":
 				err = unpopulate(val, "OutputResources", &r.OutputResources)
 			delete(rawMsg, key)
@@ -3504,6 +4209,33 @@ func (r *RuntimesProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type SecretConfig.
+func (s SecretConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secret", s.Secret)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretConfig.
+func (s *SecretConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secret":
+				err = unpopulate(val, "Secret", &s.Secret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type SecretObjectProperties.
 func (s SecretObjectProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3543,6 +4275,37 @@ func (s *SecretObjectProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type SecretReference.
+func (s SecretReference) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "key", s.Key)
+	populate(objectMap, "source", s.Source)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretReference.
+func (s *SecretReference) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "key":
+				err = unpopulate(val, "Key", &s.Key)
+			delete(rawMsg, key)
+		case "source":
+				err = unpopulate(val, "Source", &s.Source)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type SecretStoreListSecretsResult.
 func (s SecretStoreListSecretsResult) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3910,6 +4673,177 @@ func (t *TCPHealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type TerraformAzureRMBackendProperties.
+func (t TerraformAzureRMBackendProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "containerName", t.ContainerName)
+	populate(objectMap, "resourceGroupName", t.ResourceGroupName)
+	populate(objectMap, "storageAccountName", t.StorageAccountName)
+	populate(objectMap, "subscriptionId", t.SubscriptionID)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformAzureRMBackendProperties.
+func (t *TerraformAzureRMBackendProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "containerName":
+				err = unpopulate(val, "ContainerName", &t.ContainerName)
+			delete(rawMsg, key)
+		case "resourceGroupName":
+				err = unpopulate(val, "ResourceGroupName", &t.ResourceGroupName)
+			delete(rawMsg, key)
+		case "storageAccountName":
+				err = unpopulate(val, "StorageAccountName", &t.StorageAccountName)
+			delete(rawMsg, key)
+		case "subscriptionId":
+				err = unpopulate(val, "SubscriptionID", &t.SubscriptionID)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformBackendProperties.
+func (t TerraformBackendProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "azurerm", t.Azurerm)
+	populate(objectMap, "kind", t.Kind)
+	populate(objectMap, "local", t.Local)
+	populate(objectMap, "pg", t.Pg)
+	populate(objectMap, "s3", t.S3)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformBackendProperties.
+func (t *TerraformBackendProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "azurerm":
+				err = unpopulate(val, "Azurerm", &t.Azurerm)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &t.Kind)
+			delete(rawMsg, key)
+		case "local":
+				err = unpopulate(val, "Local", &t.Local)
+			delete(rawMsg, key)
+		case "pg":
+				err = unpopulate(val, "Pg", &t.Pg)
+			delete(rawMsg, key)
+		case "s3":
+				err = unpopulate(val, "S3", &t.S3)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformConfigProperties.
+func (t TerraformConfigProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "authentication", t.Authentication)
+	populate(objectMap, "backend", t.Backend)
+	populate(objectMap, "providers", t.Providers)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformConfigProperties.
+func (t *TerraformConfigProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "authentication":
+				err = unpopulate(val, "Authentication", &t.Authentication)
+			delete(rawMsg, key)
+		case "backend":
+				err = unpopulate(val, "Backend", &t.Backend)
+			delete(rawMsg, key)
+		case "providers":
+				err = unpopulate(val, "Providers", &t.Providers)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformLocalBackendProperties.
+func (t TerraformLocalBackendProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "path", t.Path)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformLocalBackendProperties.
+func (t *TerraformLocalBackendProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "path":
+				err = unpopulate(val, "Path", &t.Path)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type TerraformPostgresBackendProperties.
+func (t TerraformPostgresBackendProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "connectionString", t.ConnectionString)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformPostgresBackendProperties.
+func (t *TerraformPostgresBackendProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "connectionString":
+				err = unpopulate(val, "ConnectionString", &t.ConnectionString)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type TerraformRecipeProperties.
 func (t TerraformRecipeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3988,6 +4922,45 @@ func (t *TerraformRecipePropertiesUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type TerraformS3BackendProperties.
+func (t TerraformS3BackendProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "bucket", t.Bucket)
+	populate(objectMap, "endpoint", t.Endpoint)
+	populate(objectMap, "region", t.Region)
+	populate(objectMap, "usePathStyle", t.UsePathStyle)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformS3BackendProperties.
+func (t *TerraformS3BackendProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "bucket":
+				err = unpopulate(val, "Bucket", &t.Bucket)
+			delete(rawMsg, key)
+		case "endpoint":
+				err = unpopulate(val, "Endpoint", &t.Endpoint)
+			delete(rawMsg, key)
+		case "region":
+				err = unpopulate(val, "Region", &t.Region)
+			delete(rawMsg, key)
+		case "usePathStyle":
+				err = unpopulate(val, "UsePathStyle", &t.UsePathStyle)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type TrackedResource.
 func (t TrackedResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
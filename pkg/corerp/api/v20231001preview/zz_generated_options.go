@@ -32,6 +32,11 @@ type ApplicationsClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ApplicationsClientRestoreOptions contains the optional parameters for the ApplicationsClient.Restore method.
+type ApplicationsClientRestoreOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ApplicationsClientUpdateOptions contains the optional parameters for the ApplicationsClient.Update method.
 type ApplicationsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -91,6 +96,11 @@ type EnvironmentsClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientRestoreOptions contains the optional parameters for the EnvironmentsClient.Restore method.
+type EnvironmentsClientRestoreOptions struct {
+	// placeholder for future optional parameters
+}
+
 // EnvironmentsClientUpdateOptions contains the optional parameters for the EnvironmentsClient.Update method.
 type EnvironmentsClientUpdateOptions struct {
 	// placeholder for future optional parameters
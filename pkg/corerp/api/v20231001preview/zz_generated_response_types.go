@@ -36,6 +36,12 @@ type ApplicationsClientListByScopeResponse struct {
 	ApplicationResourceListResult
 }
 
+// ApplicationsClientRestoreResponse contains the response from method ApplicationsClient.Restore.
+type ApplicationsClientRestoreResponse struct {
+	// Radius Application resource
+	ApplicationResource
+}
+
 // ApplicationsClientUpdateResponse contains the response from method ApplicationsClient.Update.
 type ApplicationsClientUpdateResponse struct {
 	// Radius Application resource
@@ -100,6 +106,12 @@ type EnvironmentsClientListByScopeResponse struct {
 	EnvironmentResourceListResult
 }
 
+// EnvironmentsClientRestoreResponse contains the response from method EnvironmentsClient.Restore.
+type EnvironmentsClientRestoreResponse struct {
+	// The environment resource
+	EnvironmentResource
+}
+
 // EnvironmentsClientUpdateResponse contains the response from method EnvironmentsClient.Update.
 type EnvironmentsClientUpdateResponse struct {
 	// The environment resource
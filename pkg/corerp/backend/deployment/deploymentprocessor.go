@@ -417,6 +417,11 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		envOpts.KubernetesMetadata = envExt.KubernetesMetadata
 	}
 
+	envOpts.ContainerResourceDefaults = env.Properties.ContainerResources.Defaults
+
+	envOpts.Gateway.Implementation = env.Properties.Gateway.Implementation
+	envOpts.Gateway.ClassName = env.Properties.Gateway.GatewayClassName
+
 	if publicEndpointOverride != "" {
 		// Check if publicEndpointOverride contains a scheme,
 		// and if so, throw an error to the user
@@ -432,12 +437,16 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 			port = ""
 		}
 
-		envOpts.Gateway = renderers.GatewayOptions{
-			PublicEndpointOverride: true,
-			Hostname:               hostname,
-			Port:                   port,
-		}
+		envOpts.Gateway.PublicEndpointOverride = true
+		envOpts.Gateway.Hostname = hostname
+		envOpts.Gateway.Port = port
+
+		return envOpts, nil
+	}
 
+	// The public endpoint of a Gateway API gateway is the address assigned to the Gateway, which is only known once
+	// its routes are ready. It is returned by the Kubernetes handler when the routes are deployed.
+	if envOpts.Gateway.Implementation == corerp_dm.GatewayImplementationGatewayAPI {
 		return envOpts, nil
 	}
 
@@ -452,11 +461,8 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		for _, service := range services.Items {
 			if service.Name == "contour-envoy" {
 				for _, in := range service.Status.LoadBalancer.Ingress {
-					envOpts.Gateway = renderers.GatewayOptions{
-						PublicEndpointOverride: false,
-						Hostname:               in.Hostname,
-						ExternalIP:             in.IP,
-					}
+					envOpts.Gateway.Hostname = in.Hostname
+					envOpts.Gateway.ExternalIP = in.IP
 					return envOpts, nil
 				}
 			}
@@ -486,7 +492,7 @@ func (dp *deploymentProcessor) getResourceDataByID(ctx context.Context, resource
 		return ResourceData{}, fmt.Errorf(errMsg, resourceID.String(), err)
 	}
 
-	resource, err := sc.Get(ctx, resourceID.String())
+	resource, err := store.GetLiveObject(ctx, sc, resourceID.String())
 	if err != nil {
 		if errors.Is(&store.ErrNotFound{ID: resourceID.String()}, err) {
 			return ResourceData{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("resource %q does not exist", resourceID.String()))
@@ -958,6 +958,40 @@ func Test_Deploy(t *testing.T) {
 		require.Equal(t, map[string]any{"url": testRendererOutput.ComputedValues["url"].Value}, deploymentOutput.ComputedValues)
 	})
 
+	t.Run("Verify deploy computes values from the properties returned by the handler", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		mocks := setup(t)
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+
+		testResource := getTestResource()
+		testRendererOutput := getTestRendererOutput()
+		resourceID := getTestResourceID(testResource.ID)
+
+		// The URL of a Gateway API gateway is built from the address of the Gateway returned by the handler.
+		testRendererOutput.ComputedValues["url"] = rpv1.ComputedValueReference{
+			LocalID:           rpv1.LocalIDService,
+			PropertyReference: handlers.GatewayAddressKey,
+			Transformer: func(_ v1.DataModelInterface, cv map[string]any) error {
+				cv["url"] = "http://" + cv["url"].(string)
+				return nil
+			},
+		}
+
+		setupDeployMocks(mocks, false)
+
+		mocks.resourceHandler.
+			EXPECT().
+			Put(gomock.Any(), gomock.Any()).Times(1).
+			DoAndReturn(func(ctx context.Context, options *handlers.PutOptions) (map[string]string, error) {
+				options.Resource.ID = resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Service", "test-namespace", "test-service")
+				return map[string]string{handlers.GatewayAddressKey: "10.0.0.1"}, nil
+			})
+
+		deploymentOutput, err := dp.Deploy(ctx, resourceID, testRendererOutput)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"url": "http://10.0.0.1"}, deploymentOutput.ComputedValues)
+	})
+
 	t.Run("Verify deploy success with simulated env", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
@@ -1201,3 +1235,47 @@ func Test_fetchSecrets(t *testing.T) {
 		require.Equal(t, secret, secretValues[pr_renderers.ConnectionStringValue])
 	})
 }
+
+func Test_getEnvOptions_GatewayAPI(t *testing.T) {
+	ctx := testcontext.New(t)
+	mocks := setup(t)
+	dp := deploymentProcessor{mocks.model, nil, nil, nil}
+
+	env := &datamodel.Environment{
+		Properties: datamodel.EnvironmentProperties{
+			Compute: rpv1.EnvironmentCompute{
+				Kind: rpv1.KubernetesComputeKind,
+				KubernetesCompute: rpv1.KubernetesComputeProperties{
+					Namespace: "radius-system",
+				},
+			},
+			Gateway: datamodel.EnvironmentGateway{
+				Implementation:   datamodel.GatewayImplementationGatewayAPI,
+				GatewayClassName: "istio",
+			},
+		},
+	}
+
+	t.Run("Verify getEnvOptions sets gateway implementation", func(t *testing.T) {
+		options, err := dp.getEnvOptions(ctx, env)
+		require.NoError(t, err)
+
+		require.Equal(t, datamodel.GatewayImplementationGatewayAPI, options.Gateway.Implementation)
+		require.Equal(t, "istio", options.Gateway.ClassName)
+		require.False(t, options.Gateway.PublicEndpointOverride)
+	})
+
+	t.Run("Verify getEnvOptions keeps gateway implementation with public endpoint override", func(t *testing.T) {
+		os.Setenv("RADIUS_PUBLIC_ENDPOINT_OVERRIDE", "localhost:8000")
+		defer os.Unsetenv("RADIUS_PUBLIC_ENDPOINT_OVERRIDE")
+
+		options, err := dp.getEnvOptions(ctx, env)
+		require.NoError(t, err)
+
+		require.Equal(t, datamodel.GatewayImplementationGatewayAPI, options.Gateway.Implementation)
+		require.Equal(t, "istio", options.Gateway.ClassName)
+		require.True(t, options.Gateway.PublicEndpointOverride)
+		require.Equal(t, "localhost", options.Gateway.Hostname)
+		require.Equal(t, "8000", options.Gateway.Port)
+	})
+}
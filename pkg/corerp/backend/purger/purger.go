@@ -0,0 +1,298 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package purger implements the garbage collection of soft-deleted applications and environments. A deleted
+// application or environment is kept as a tombstone until the retention period expires. Then the resources in it are
+// deleted, which deletes their output resources, and the tombstone is removed from the data store.
+package purger
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	cntr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/containers"
+	ext_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/extenders"
+	gtwy_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
+	hrt_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/httproutes"
+	sstr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
+	vol_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/volumes"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultRetentionPeriod is the default duration for which soft-deleted resources are kept.
+	DefaultRetentionPeriod = 7 * 24 * time.Hour
+
+	// DefaultPurgeInterval is the default interval at which expired soft-deleted resources are purged.
+	DefaultPurgeInterval = time.Hour
+
+	applicationsResourceType = "Applications.Core/applications"
+	environmentsResourceType = "Applications.Core/environments"
+)
+
+// Options represents the options of Purger.
+type Options struct {
+	// StorageProvider is the provider of storage client.
+	StorageProvider dataprovider.DataStorageProvider
+
+	// ResourceClient is the client used to delete the resources in a purged application or environment.
+	ResourceClient processors.ResourceClient
+
+	// RetentionPeriod is the duration for which soft-deleted resources are kept. DefaultRetentionPeriod is used if it is zero.
+	RetentionPeriod time.Duration
+
+	// PurgeInterval is the interval at which expired soft-deleted resources are purged. DefaultPurgeInterval is used if it is zero.
+	PurgeInterval time.Duration
+
+	// ResourceTypes are the types of the resources which can be in an application or environment. DefaultResourceTypes
+	// is used if it is empty.
+	ResourceTypes []string
+}
+
+// DefaultResourceTypes are the types of the resources which can be in an application or environment.
+var DefaultResourceTypes = []string{
+	cntr_ctrl.ResourceTypeName,
+	ext_ctrl.ResourceTypeName,
+	gtwy_ctrl.ResourceTypeName,
+	hrt_ctrl.ResourceTypeName,
+	sstr_ctrl.ResourceTypeName,
+	vol_ctrl.ResourceTypeName,
+	dapr_ctrl.DaprPubSubBrokersResourceType,
+	dapr_ctrl.DaprSecretStoresResourceType,
+	dapr_ctrl.DaprStateStoresResourceType,
+	ds_ctrl.MongoDatabasesResourceType,
+	ds_ctrl.RedisCachesResourceType,
+	ds_ctrl.SqlDatabasesResourceType,
+	msg_ctrl.RabbitMQQueuesResourceType,
+}
+
+// Purger periodically purges the soft-deleted applications and environments whose retention period has expired.
+type Purger struct {
+	options Options
+}
+
+// New creates a new Purger.
+func New(options Options) *Purger {
+	if options.RetentionPeriod == 0 {
+		options.RetentionPeriod = DefaultRetentionPeriod
+	}
+	if options.PurgeInterval == 0 {
+		options.PurgeInterval = DefaultPurgeInterval
+	}
+	if len(options.ResourceTypes) == 0 {
+		options.ResourceTypes = DefaultResourceTypes
+	}
+	return &Purger{options: options}
+}
+
+// Run purges the expired soft-deleted resources every purge interval until the context is canceled.
+func (p *Purger) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ticker := time.NewTicker(p.options.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.purge(ctx, time.Now()); err != nil {
+			logger.Error(err, "failed to purge deleted resources")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// tombstoneTypes are the resource types which are soft-deleted, in the order they are purged.
+var tombstoneTypes = []string{applicationsResourceType, environmentsResourceType}
+
+// resource is the subset of a stored resource used to find tombstones and the resources in them.
+type resource struct {
+	v1.BaseResource
+
+	Properties struct {
+		Application string `json:"application,omitempty"`
+		Environment string `json:"environment,omitempty"`
+	} `json:"properties"`
+}
+
+// purge purges the tombstones which were deleted before now minus the retention period. A tombstone whose resources
+// cannot be deleted is kept and retried in the next purge.
+func (p *Purger) purge(ctx context.Context, now time.Time) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	var errs []error
+	for _, resourceType := range tombstoneTypes {
+		client, err := p.options.StorageProvider.GetStorageClient(ctx, resourceType)
+		if err != nil {
+			return err
+		}
+
+		objs, err := queryAll(ctx, client, store.Query{RootScope: resources.SegmentSeparator + resources.PlanesSegment, ScopeRecursive: true, ResourceType: resourceType})
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range objs {
+			tombstone := &resource{}
+			if err := obj.As(tombstone); err != nil {
+				return err
+			}
+
+			if !tombstone.IsDeleted() || now.Sub(*tombstone.DeletedTime) < p.options.RetentionPeriod {
+				continue
+			}
+
+			logger.Info("Purging deleted resource", "resourceId", obj.ID)
+			if err := p.purgeTombstone(ctx, client, obj); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// purgeTombstone deletes the resources in the application or environment of the tombstone and then removes the tombstone.
+// The applications in an environment are purged along with it, whether or not they were deleted themselves.
+func (p *Purger) purgeTombstone(ctx context.Context, client store.StorageClient, obj store.Object) error {
+	id, err := resources.ParseResource(obj.ID)
+	if err != nil {
+		return err
+	}
+
+	query := store.Query{RootScope: id.PlaneScope(), ScopeRecursive: true}
+	if strings.EqualFold(id.Type(), environmentsResourceType) {
+		if err := p.purgeApplications(ctx, query, id); err != nil {
+			return err
+		}
+	}
+
+	// Each resource type can be kept in a different storage client, so the resources are queried type by type.
+	for _, resourceType := range p.options.ResourceTypes {
+		childClient, err := p.options.StorageProvider.GetStorageClient(ctx, resourceType)
+		if err != nil {
+			return err
+		}
+
+		query.ResourceType = resourceType
+		children, err := queryAll(ctx, childClient, query)
+		if err != nil {
+			return err
+		}
+
+		for _, child := range children {
+			if !isInTombstone(id, child) {
+				continue
+			}
+
+			if err := p.options.ResourceClient.Delete(ctx, child.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	err = client.Delete(ctx, obj.ID, store.WithETag(obj.ETag))
+	if errors.Is(&store.ErrNotFound{ID: obj.ID}, err) {
+		return nil
+	}
+	return err
+}
+
+// purgeApplications purges the applications in the environment.
+func (p *Purger) purgeApplications(ctx context.Context, query store.Query, environmentID resources.ID) error {
+	client, err := p.options.StorageProvider.GetStorageClient(ctx, applicationsResourceType)
+	if err != nil {
+		return err
+	}
+
+	query.ResourceType = applicationsResourceType
+	apps, err := queryAll(ctx, client, query)
+	if err != nil {
+		return err
+	}
+
+	for _, app := range apps {
+		r := &resource{}
+		if err := app.As(r); err != nil {
+			return err
+		}
+
+		if !strings.EqualFold(r.Properties.Environment, environmentID.String()) {
+			continue
+		}
+
+		if err := p.purgeTombstone(ctx, client, app); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isInTombstone returns true if the object is a resource in the application or environment of the tombstone. Applications
+// and environments are excluded because they are removed from the data store instead of being deleted by the resource
+// client.
+func isInTombstone(tombstoneID resources.ID, obj store.Object) bool {
+	objID, err := resources.ParseResource(obj.ID)
+	if err != nil {
+		return false
+	}
+
+	objType := objID.Type()
+	if strings.EqualFold(objType, applicationsResourceType) || strings.EqualFold(objType, environmentsResourceType) {
+		return false
+	}
+
+	r := &resource{}
+	if err := obj.As(r); err != nil {
+		return false
+	}
+
+	if strings.EqualFold(tombstoneID.Type(), applicationsResourceType) {
+		return strings.EqualFold(r.Properties.Application, tombstoneID.String())
+	}
+	return strings.EqualFold(r.Properties.Environment, tombstoneID.String())
+}
+
+func queryAll(ctx context.Context, client store.StorageClient, query store.Query) ([]store.Object, error) {
+	objs := []store.Object{}
+	token := ""
+	for {
+		result, err := client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		objs = append(objs, result.Items...)
+		if result.PaginationToken == "" {
+			return objs, nil
+		}
+		token = result.PaginationToken
+	}
+}
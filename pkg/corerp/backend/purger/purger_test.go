@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purger
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testEnvID       = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+	testAppID       = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+	testContainerID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container"
+	testOtherID     = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/other-container"
+
+	containersResourceType  = "Applications.Core/containers"
+	redisCachesResourceType = "Applications.Datastores/redisCaches"
+)
+
+func newTombstone(id string, deletedTime time.Time) store.Object {
+	r := &resource{}
+	r.ID = id
+	r.DeletedTime = &deletedTime
+	return store.Object{Metadata: store.Metadata{ID: id, ETag: "tombstone-etag"}, Data: r}
+}
+
+func newChild(id, application string) store.Object {
+	r := &resource{}
+	r.ID = id
+	r.Properties.Application = application
+	return store.Object{Metadata: store.Metadata{ID: id}, Data: r}
+}
+
+func newApp(id, environment string) store.Object {
+	r := &resource{}
+	r.ID = id
+	r.Properties.Environment = environment
+	return store.Object{Metadata: store.Metadata{ID: id}, Data: r}
+}
+
+func setup(t *testing.T, apps []store.Object, envs ...store.Object) (*store.MockStorageClient, *processors.MockResourceClient, *Purger) {
+	mctrl := gomock.NewController(t)
+	sc := store.NewMockStorageClient(mctrl)
+	sp := dataprovider.NewMockDataStorageProvider(mctrl)
+	rc := processors.NewMockResourceClient(mctrl)
+
+	sp.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(sc, nil).AnyTimes()
+	sc.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			switch query.ResourceType {
+			case applicationsResourceType:
+				return &store.ObjectQueryResult{Items: apps}, nil
+			case environmentsResourceType:
+				return &store.ObjectQueryResult{Items: envs}, nil
+			case containersResourceType:
+				require.Equal(t, "/planes/radius/local", query.RootScope)
+				require.True(t, query.ScopeRecursive)
+				return &store.ObjectQueryResult{Items: []store.Object{
+					newChild(testContainerID, testAppID),
+					newChild(testOtherID, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/other-app"),
+				}}, nil
+			default:
+				require.Equal(t, redisCachesResourceType, query.ResourceType)
+				return &store.ObjectQueryResult{}, nil
+			}
+		}).
+		AnyTimes()
+
+	p := New(Options{StorageProvider: sp, ResourceClient: rc, RetentionPeriod: time.Hour, ResourceTypes: []string{containersResourceType, redisCachesResourceType}})
+	return sc, rc, p
+}
+
+func Test_Purge(t *testing.T) {
+	now := time.Now()
+
+	t.Run("expired tombstone is purged", func(t *testing.T) {
+		sc, rc, p := setup(t, []store.Object{newTombstone(testAppID, now.Add(-2*time.Hour))})
+
+		rc.EXPECT().Delete(gomock.Any(), testContainerID).Return(nil).Times(1)
+		sc.EXPECT().
+			Delete(gomock.Any(), testAppID, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, options ...store.DeleteOptions) error {
+				require.Equal(t, "tombstone-etag", store.NewDeleteConfig(options...).ETag)
+				return nil
+			}).
+			Times(1)
+
+		require.NoError(t, p.purge(context.Background(), now))
+	})
+
+	t.Run("applications in expired environment are purged", func(t *testing.T) {
+		otherAppID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/other-app"
+		apps := []store.Object{newApp(testAppID, testEnvID), newApp(otherAppID, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/other-env")}
+		sc, rc, p := setup(t, apps, newTombstone(testEnvID, now.Add(-2*time.Hour)))
+
+		rc.EXPECT().Delete(gomock.Any(), testContainerID).Return(nil).Times(1)
+		sc.EXPECT().Delete(gomock.Any(), testAppID, gomock.Any()).Return(nil).Times(1)
+		sc.EXPECT().Delete(gomock.Any(), testEnvID, gomock.Any()).Return(nil).Times(1)
+
+		require.NoError(t, p.purge(context.Background(), now))
+	})
+
+	t.Run("tombstone in retention period is kept", func(t *testing.T) {
+		_, _, p := setup(t, []store.Object{newTombstone(testAppID, now.Add(-30*time.Minute))})
+		require.NoError(t, p.purge(context.Background(), now))
+	})
+
+	t.Run("resource which is not deleted is kept", func(t *testing.T) {
+		app := newChild(testAppID, "")
+		_, _, p := setup(t, []store.Object{app})
+		require.NoError(t, p.purge(context.Background(), now))
+	})
+
+	t.Run("tombstone is kept if resources fail to delete", func(t *testing.T) {
+		_, rc, p := setup(t, []store.Object{newTombstone(testAppID, now.Add(-2*time.Hour))})
+
+		rc.EXPECT().Delete(gomock.Any(), testContainerID).Return(errors.New("failed")).Times(1)
+
+		require.EqualError(t, p.purge(context.Background(), now), "failed")
+	})
+}
+
+func Test_New_Defaults(t *testing.T) {
+	p := New(Options{})
+	require.Equal(t, DefaultRetentionPeriod, p.options.RetentionPeriod)
+	require.Equal(t, DefaultPurgeInterval, p.options.PurgeInterval)
+	require.Equal(t, DefaultResourceTypes, p.options.ResourceTypes)
+}
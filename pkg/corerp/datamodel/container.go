@@ -73,6 +73,8 @@ type ContainerProperties struct {
 	rpv1.BasicResourceProperties
 	Connections          map[string]ConnectionProperties `json:"connections,omitempty"`
 	Container            Container                       `json:"container,omitempty"`
+	InitContainers       []Container                     `json:"initContainers,omitempty"`
+	Sidecars             []Container                     `json:"sidecars,omitempty"`
 	Extensions           []Extension                     `json:"extensions,omitempty"`
 	Identity             *rpv1.IdentitySettings          `json:"identity,omitempty"`
 	Runtimes             *RuntimeProperties              `json:"runtimes,omitempty"`
@@ -115,16 +117,36 @@ type ConnectionProperties struct {
 
 // Container - Definition of a container.
 type Container struct {
-	Image           string                      `json:"image,omitempty"`
-	ImagePullPolicy string                      `json:"imagePullPolicy,omitempty"`
-	Env             map[string]string           `json:"env,omitempty"`
-	LivenessProbe   HealthProbeProperties       `json:"livenessProbe,omitempty"`
-	Ports           map[string]ContainerPort    `json:"ports,omitempty"`
-	ReadinessProbe  HealthProbeProperties       `json:"readinessProbe,omitempty"`
-	Volumes         map[string]VolumeProperties `json:"volumes,omitempty"`
-	Command         []string                    `json:"command,omitempty"`
-	Args            []string                    `json:"args,omitempty"`
-	WorkingDir      string                      `json:"workingDir,omitempty"`
+	Image           string                        `json:"image,omitempty"`
+	ImagePullPolicy string                        `json:"imagePullPolicy,omitempty"`
+	Env             map[string]string             `json:"env,omitempty"`
+	LivenessProbe   HealthProbeProperties         `json:"livenessProbe,omitempty"`
+	Ports           map[string]ContainerPort      `json:"ports,omitempty"`
+	ReadinessProbe  HealthProbeProperties         `json:"readinessProbe,omitempty"`
+	Volumes         map[string]VolumeProperties   `json:"volumes,omitempty"`
+	Command         []string                      `json:"command,omitempty"`
+	Args            []string                      `json:"args,omitempty"`
+	WorkingDir      string                        `json:"workingDir,omitempty"`
+	Resources       ContainerResourceRequirements `json:"resources,omitempty"`
+}
+
+// ContainerResourceRequirements - The compute resources requested by a container and the limits on the compute resources
+// it can use.
+type ContainerResourceRequirements struct {
+	Requests ContainerResourceQuantities `json:"requests,omitempty"`
+	Limits   ContainerResourceQuantities `json:"limits,omitempty"`
+}
+
+// IsEmpty checks if the ContainerResourceRequirements is empty or not.
+func (r ContainerResourceRequirements) IsEmpty() bool {
+	return r == ContainerResourceRequirements{}
+}
+
+// ContainerResourceQuantities - Quantities of compute resources, in the Kubernetes quantity format.
+type ContainerResourceQuantities struct {
+	CPU              string `json:"cpu,omitempty"`
+	Memory           string `json:"memory,omitempty"`
+	EphemeralStorage string `json:"ephemeralStorage,omitempty"`
 }
 
 // ContainerPort - Specifies a listening port for the container
@@ -252,6 +274,38 @@ type ManualScalingExtension struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 }
 
+// AutoScalingExtension - Horizontal autoscaling extension. The replicas are scaled between MinReplicas and MaxReplicas to
+// meet the metric targets.
+type AutoScalingExtension struct {
+	MinReplicas             *int32              `json:"minReplicas,omitempty"`
+	MaxReplicas             int32               `json:"maxReplicas,omitempty"`
+	TargetCPUUtilization    *int32              `json:"targetCpuUtilization,omitempty"`
+	TargetMemoryUtilization *int32              `json:"targetMemoryUtilization,omitempty"`
+	Metrics                 []AutoScalingMetric `json:"metrics,omitempty"`
+}
+
+// AutoScalingMetric - A custom metric to scale on, with its target average value for each replica.
+type AutoScalingMetric struct {
+	Name               string `json:"name,omitempty"`
+	TargetAverageValue string `json:"targetAverageValue,omitempty"`
+}
+
+// EventScalingExtension - Event-driven scaling extension. The replicas are scaled by KEDA between MinReplicas and MaxReplicas,
+// using triggers derived from the connections of the container.
+type EventScalingExtension struct {
+	MinReplicas     *int32                         `json:"minReplicas,omitempty"`
+	MaxReplicas     int32                          `json:"maxReplicas,omitempty"`
+	PollingInterval *int32                         `json:"pollingInterval,omitempty"`
+	CooldownPeriod  *int32                         `json:"cooldownPeriod,omitempty"`
+	Triggers        map[string]EventScalingTrigger `json:"triggers,omitempty"`
+}
+
+// EventScalingTrigger - A trigger derived from the connection of the same name.
+type EventScalingTrigger struct {
+	TargetLength *int32 `json:"targetLength,omitempty"`
+	Topic        string `json:"topic,omitempty"`
+}
+
 // DaprSidecarExtension - Specifies the resource should have a Dapr sidecar injected
 type DaprSidecarExtension struct {
 	AppID    string   `json:"appId,omitempty"`
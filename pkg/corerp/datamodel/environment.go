@@ -38,11 +38,157 @@ func (e *Environment) ResourceTypeName() string {
 
 // EnvironmentProperties represents the properties of Environment.
 type EnvironmentProperties struct {
-	Compute    rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
-	Recipes    map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
-	Providers  Providers                                         `json:"providers,omitempty"`
-	Extensions []Extension                                       `json:"extensions,omitempty"`
-	Simulated  bool                                              `json:"simulated,omitempty"`
+	Compute            rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
+	Recipes            map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
+	Providers          Providers                                         `json:"providers,omitempty"`
+	Extensions         []Extension                                       `json:"extensions,omitempty"`
+	Simulated          bool                                              `json:"simulated,omitempty"`
+	RecipeConfig       RecipeConfigProperties                            `json:"recipeConfig,omitempty"`
+	ContainerResources ContainerResourcePolicy                           `json:"containerResources,omitempty"`
+	Gateway            EnvironmentGateway                                `json:"gateway,omitempty"`
+}
+
+// GatewayImplementation is the implementation used to render the gateways of an environment.
+type GatewayImplementation string
+
+const (
+	// GatewayImplementationContour renders gateways as Contour HTTPProxy resources. This is the default.
+	GatewayImplementationContour GatewayImplementation = "contour"
+	// GatewayImplementationGatewayAPI renders gateways as Kubernetes Gateway API resources.
+	GatewayImplementationGatewayAPI GatewayImplementation = "gatewayAPI"
+)
+
+// EnvironmentGateway represents the gateway configuration of an environment.
+type EnvironmentGateway struct {
+	// Implementation is the implementation used to render the gateways. Contour is used if it is empty.
+	Implementation GatewayImplementation `json:"implementation,omitempty"`
+	// GatewayClassName is the name of the GatewayClass of the Gateway API resources.
+	GatewayClassName string `json:"gatewayClassName,omitempty"`
+}
+
+// ContainerResourcePolicy represents the default and maximum compute resources of the containers of an environment.
+type ContainerResourcePolicy struct {
+	// Defaults are the requests and limits of the containers that specify neither a request nor a limit for a resource.
+	Defaults ContainerResourceRequirements `json:"defaults,omitempty"`
+	// Maximum is the maximum request and limit of each resource of the containers.
+	Maximum ContainerResourceQuantities `json:"maximum,omitempty"`
+}
+
+// RecipeConfigProperties represents the configuration for the recipes of the environment.
+type RecipeConfigProperties struct {
+	// Terraform is the configuration for Terraform recipes.
+	Terraform TerraformConfigProperties `json:"terraform,omitempty"`
+}
+
+// TerraformConfigProperties represents the configuration for Terraform recipes.
+type TerraformConfigProperties struct {
+	// Backend is the backend used to store the Terraform state. The Kubernetes backend is used if it is nil.
+	Backend *TerraformBackend `json:"backend,omitempty"`
+	// Providers is the configuration of the Terraform providers, keyed by the name of the provider.
+	Providers map[string][]ProviderConfigProperties `json:"providers,omitempty"`
+	// Authentication is the authentication information used to download Terraform modules from private sources.
+	Authentication AuthConfig `json:"authentication,omitempty"`
+}
+
+// AuthConfig represents the authentication information used to download Terraform modules from private sources.
+type AuthConfig struct {
+	// Git is the authentication information used to access private git repositories.
+	Git GitAuthConfig `json:"git,omitempty"`
+	// Registries contains the tokens used to access private Terraform module registries, keyed by the hostname of the registry.
+	Registries map[string]SecretConfig `json:"registries,omitempty"`
+}
+
+// GitAuthConfig represents the authentication information used to access private git repositories.
+type GitAuthConfig struct {
+	// PAT contains the personal access tokens, keyed by the hostname of the git server.
+	PAT map[string]SecretConfig `json:"pat,omitempty"`
+	// SSH contains the SSH private keys, keyed by the hostname of the git server.
+	SSH map[string]SecretConfig `json:"ssh,omitempty"`
+}
+
+// SecretConfig represents a reference to an Applications.Core/secretStores resource containing credentials.
+type SecretConfig struct {
+	// Secret is the ID of the secret store.
+	Secret string `json:"secret,omitempty"`
+}
+
+// ProviderConfigProperties represents the configuration of a Terraform provider.
+type ProviderConfigProperties struct {
+	// AdditionalProperties contains the properties of the provider configuration.
+	AdditionalProperties map[string]any `json:"additionalProperties,omitempty"`
+	// Secrets contains the secret properties of the provider configuration, keyed by the name of the property.
+	Secrets map[string]SecretReference `json:"secrets,omitempty"`
+}
+
+// SecretReference represents a reference to a secret value in an Applications.Core/secretStores resource.
+type SecretReference struct {
+	// Source is the ID of the secret store.
+	Source string `json:"source"`
+	// Key is the key of the secret in the secret store.
+	Key string `json:"key"`
+}
+
+const (
+	// TerraformBackendKindKubernetes stores the Terraform state in Kubernetes secrets.
+	TerraformBackendKindKubernetes = "kubernetes"
+	// TerraformBackendKindS3 stores the Terraform state in an S3-compatible bucket.
+	TerraformBackendKindS3 = "s3"
+	// TerraformBackendKindPostgres stores the Terraform state in a Postgres database.
+	TerraformBackendKindPostgres = "pg"
+	// TerraformBackendKindAzureRM stores the Terraform state in an Azure Storage blob container.
+	TerraformBackendKindAzureRM = "azurerm"
+	// TerraformBackendKindLocal stores the Terraform state in a local directory.
+	TerraformBackendKindLocal = "local"
+)
+
+// TerraformBackend represents the Terraform backend used to store the Terraform state of the recipes.
+type TerraformBackend struct {
+	// Kind is the kind of the backend, for example "s3".
+	Kind string `json:"kind"`
+	// S3 is the configuration of the S3 backend.
+	S3 *TerraformS3Backend `json:"s3,omitempty"`
+	// Postgres is the configuration of the Postgres backend.
+	Postgres *TerraformPostgresBackend `json:"pg,omitempty"`
+	// AzureRM is the configuration of the Azure Storage backend.
+	AzureRM *TerraformAzureRMBackend `json:"azurerm,omitempty"`
+	// Local is the configuration of the local backend.
+	Local *TerraformLocalBackend `json:"local,omitempty"`
+}
+
+// TerraformS3Backend represents the configuration of the S3 Terraform backend.
+type TerraformS3Backend struct {
+	// Bucket is the name of the bucket.
+	Bucket string `json:"bucket"`
+	// Region is the region of the bucket.
+	Region string `json:"region,omitempty"`
+	// Endpoint is the endpoint of an S3-compatible storage service.
+	Endpoint string `json:"endpoint,omitempty"`
+	// UsePathStyle enables path-style addressing for the bucket.
+	UsePathStyle bool `json:"usePathStyle,omitempty"`
+}
+
+// TerraformPostgresBackend represents the configuration of the Postgres Terraform backend.
+type TerraformPostgresBackend struct {
+	// ConnectionString is the secret containing the Postgres connection string.
+	ConnectionString SecretReference `json:"connectionString"`
+}
+
+// TerraformAzureRMBackend represents the configuration of the Azure Storage Terraform backend.
+type TerraformAzureRMBackend struct {
+	// SubscriptionID is the ID of the subscription of the storage account.
+	SubscriptionID string `json:"subscriptionId,omitempty"`
+	// ResourceGroupName is the name of the resource group of the storage account.
+	ResourceGroupName string `json:"resourceGroupName"`
+	// StorageAccountName is the name of the storage account.
+	StorageAccountName string `json:"storageAccountName"`
+	// ContainerName is the name of the blob container.
+	ContainerName string `json:"containerName"`
+}
+
+// TerraformLocalBackend represents the configuration of the local Terraform backend.
+type TerraformLocalBackend struct {
+	// Path is the path to the directory in which the Terraform state is stored.
+	Path string `json:"path"`
 }
 
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
@@ -21,6 +21,8 @@ type ExtensionKind string
 
 const (
 	ManualScaling                ExtensionKind = "manualScaling"
+	AutoScaling                  ExtensionKind = "autoScaling"
+	EventScaling                 ExtensionKind = "eventScaling"
 	DaprSidecar                  ExtensionKind = "daprSidecar"
 	KubernetesMetadata           ExtensionKind = "kubernetesMetadata"
 	KubernetesNamespaceExtension ExtensionKind = "kubernetesNamespace"
@@ -30,6 +32,8 @@ const (
 type Extension struct {
 	Kind                ExtensionKind           `json:"kind,omitempty"`
 	ManualScaling       *ManualScalingExtension `json:"manualScaling,omitempty"`
+	AutoScaling         *AutoScalingExtension   `json:"autoScaling,omitempty"`
+	EventScaling        *EventScalingExtension  `json:"eventScaling,omitempty"`
 	DaprSidecar         *DaprSidecarExtension   `json:"daprSidecar,omitempty"`
 	KubernetesMetadata  *KubeMetadataExtension  `json:"kubernetesMetadata,omitempty"`
 	KubernetesNamespace *KubeNamespaceExtension `json:"kubernetesNamespace,omitempty"`
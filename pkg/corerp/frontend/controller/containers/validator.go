@@ -20,9 +20,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
+	"golang.org/x/exp/maps"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -30,12 +35,18 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/frontend/controller/util"
 	"github.com/radius-project/radius/pkg/kubeutil"
+	rp_util "github.com/radius-project/radius/pkg/rp/util"
 )
 
 const (
 	manifestTargetProperty = "$.properties.runtimes.kubernetes.base"
+	extensionsProperty     = "$.properties.extensions"
 	podTargetProperty      = "$.properties.runtimes.kubernetes.pod"
+	resourcesProperty      = "$.properties.container.resources"
+	initContainersProperty = "$.properties.initContainers"
+	sidecarsProperty       = "$.properties.sidecars"
 )
 
 // ValidateAndMutateRequest checks if the newResource has a user-defined identity and if so, returns a bad request
@@ -52,6 +63,45 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		newResource.Properties.Identity = oldResource.Properties.Identity
 	}
 
+	if err := validateScalingExtensions(newResource.Properties.Extensions, newResource.Properties.Connections); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	if err := validateAdditionalContainers(newResource.Properties); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	// The compute resources of the init containers and sidecars are validated the same way as the container.
+	resourceTargets := map[string]datamodel.ContainerResourceRequirements{}
+	if resources := newResource.Properties.Container.Resources; !resources.IsEmpty() {
+		resourceTargets[resourcesProperty] = resources
+	}
+	for i, c := range newResource.Properties.InitContainers {
+		if !c.Resources.IsEmpty() {
+			resourceTargets[fmt.Sprintf("%s[%d].resources", initContainersProperty, i)] = c.Resources
+		}
+	}
+	for i, c := range newResource.Properties.Sidecars {
+		if !c.Resources.IsEmpty() {
+			resourceTargets[fmt.Sprintf("%s[%d].resources", sidecarsProperty, i)] = c.Resources
+		}
+	}
+
+	if len(resourceTargets) > 0 {
+		maximum, err := fetchContainerResourceMaximum(ctx, newResource, options)
+		if err != nil {
+			return nil, err
+		}
+
+		targets := maps.Keys(resourceTargets)
+		sort.Strings(targets)
+		for _, target := range targets {
+			if err := util.ValidateContainerResources(target, resourceTargets[target], maximum); err != nil {
+				return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+			}
+		}
+	}
+
 	runtimes := newResource.Properties.Runtimes
 	if runtimes != nil && runtimes.Kubernetes != nil {
 		if runtimes.Kubernetes.Base != "" {
@@ -72,6 +122,181 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 	return nil, nil
 }
 
+// validateAdditionalContainers validates the init containers and sidecars of the container. Init containers run to
+// completion before the pod is ready, so they can't have probes or ports, and only the ports of the container can
+// provide routes. A volume declared by more than one container is shared by them, so every declaration of the volume
+// must use the same source.
+func validateAdditionalContainers(properties datamodel.ContainerProperties) error {
+	volumes := map[string]datamodel.VolumeProperties{}
+	for name, volume := range properties.Container.Volumes {
+		volumes[name] = volume
+	}
+
+	validate := func(target string, c datamodel.Container) error {
+		for name, port := range c.Ports {
+			if port.Provides != "" {
+				return errInvalidContainer(target, "The port %q can't provide a route. Only the ports of the container can provide routes.", name)
+			}
+		}
+
+		for name, volume := range c.Volumes {
+			shared, ok := volumes[name]
+			if !ok {
+				volumes[name] = volume
+				continue
+			}
+			if !sameVolumeSource(shared, volume) {
+				return errInvalidContainer(target, "The volume %q must have the same kind and source as the other declarations of the volume.", name)
+			}
+		}
+		return nil
+	}
+
+	for i, c := range properties.InitContainers {
+		target := fmt.Sprintf("%s[%d]", initContainersProperty, i)
+		if !c.ReadinessProbe.IsEmpty() || !c.LivenessProbe.IsEmpty() {
+			return errInvalidContainer(target, "Init containers can't have readiness or liveness probes.")
+		}
+		if len(c.Ports) > 0 {
+			return errInvalidContainer(target, "Init containers can't have ports.")
+		}
+		if err := validate(target, c); err != nil {
+			return err
+		}
+	}
+
+	for i, c := range properties.Sidecars {
+		if err := validate(fmt.Sprintf("%s[%d]", sidecarsProperty, i), c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sameVolumeSource returns true if the volumes have the same kind and source, ignoring the mount paths which can be
+// different for each container.
+func sameVolumeSource(a, b datamodel.VolumeProperties) bool {
+	if a.Kind != b.Kind {
+		return false
+	}
+
+	switch a.Kind {
+	case datamodel.Ephemeral:
+		return a.Ephemeral != nil && b.Ephemeral != nil && a.Ephemeral.ManagedStore == b.Ephemeral.ManagedStore
+	case datamodel.Persistent:
+		return a.Persistent != nil && b.Persistent != nil && a.Persistent.Source == b.Persistent.Source
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func errInvalidContainer(target string, format string, a ...any) v1.ErrorDetails {
+	return v1.ErrorDetails{
+		Code:    v1.CodeInvalidRequestContent,
+		Target:  target,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// fetchContainerResourceMaximum fetches the environment of the container through its application and returns the
+// maximum compute resources that the environment allows a container to request.
+func fetchContainerResourceMaximum(ctx context.Context, newResource *datamodel.ContainerResource, options *controller.Options) (datamodel.ContainerResourceQuantities, error) {
+	app := &datamodel.Application{}
+	if err := rp_util.FetchScopeResource(ctx, options.DataProvider, newResource.Properties.Application, app); err != nil {
+		return datamodel.ContainerResourceQuantities{}, err
+	}
+
+	env := &datamodel.Environment{}
+	if err := rp_util.FetchScopeResource(ctx, options.DataProvider, app.Properties.Environment, env); err != nil {
+		return datamodel.ContainerResourceQuantities{}, err
+	}
+
+	return env.Properties.ContainerResources.Maximum, nil
+}
+
+// validateScalingExtensions validates the autoScaling and eventScaling extensions. At most one of the manualScaling,
+// autoScaling and eventScaling extensions can be used since they all set the replica count of the container.
+func validateScalingExtensions(extensions []datamodel.Extension, connections map[string]datamodel.ConnectionProperties) error {
+	scaling := []string{}
+	for _, kind := range []datamodel.ExtensionKind{datamodel.AutoScaling, datamodel.EventScaling, datamodel.ManualScaling} {
+		if datamodel.FindExtension(extensions, kind) != nil {
+			scaling = append(scaling, string(kind))
+		}
+	}
+	if len(scaling) > 1 {
+		return errInvalidExtension("The %s extensions cannot be used together.", strings.Join(scaling, " and "))
+	}
+
+	if ext := datamodel.FindExtension(extensions, datamodel.AutoScaling); ext != nil && ext.AutoScaling != nil {
+		return validateAutoScalingExtension(ext.AutoScaling)
+	}
+	if ext := datamodel.FindExtension(extensions, datamodel.EventScaling); ext != nil && ext.EventScaling != nil {
+		return validateEventScalingExtension(ext.EventScaling, connections)
+	}
+
+	return nil
+}
+
+func validateAutoScalingExtension(ext *datamodel.AutoScalingExtension) error {
+	if ext.MaxReplicas < 1 {
+		return errInvalidExtension("The maxReplicas of the autoScaling extension must be at least 1.")
+	}
+	if ext.MinReplicas != nil && (*ext.MinReplicas < 1 || *ext.MinReplicas > ext.MaxReplicas) {
+		return errInvalidExtension("The minReplicas of the autoScaling extension must be between 1 and maxReplicas (%d).", ext.MaxReplicas)
+	}
+	if ext.TargetCPUUtilization != nil && *ext.TargetCPUUtilization < 1 {
+		return errInvalidExtension("The targetCpuUtilization of the autoScaling extension must be at least 1.")
+	}
+	if ext.TargetMemoryUtilization != nil && *ext.TargetMemoryUtilization < 1 {
+		return errInvalidExtension("The targetMemoryUtilization of the autoScaling extension must be at least 1.")
+	}
+
+	for _, metric := range ext.Metrics {
+		if metric.Name == "" {
+			return errInvalidExtension("The metrics of the autoScaling extension must have a name.")
+		}
+		if _, err := resource.ParseQuantity(metric.TargetAverageValue); err != nil {
+			return errInvalidExtension("The targetAverageValue %q of the metric %q of the autoScaling extension is not a valid quantity.", metric.TargetAverageValue, metric.Name)
+		}
+	}
+
+	return nil
+}
+
+func validateEventScalingExtension(ext *datamodel.EventScalingExtension, connections map[string]datamodel.ConnectionProperties) error {
+	if ext.MaxReplicas < 1 {
+		return errInvalidExtension("The maxReplicas of the eventScaling extension must be at least 1.")
+	}
+	if ext.MinReplicas != nil && (*ext.MinReplicas < 0 || *ext.MinReplicas > ext.MaxReplicas) {
+		return errInvalidExtension("The minReplicas of the eventScaling extension must be between 0 and maxReplicas (%d).", ext.MaxReplicas)
+	}
+	if ext.PollingInterval != nil && *ext.PollingInterval < 1 {
+		return errInvalidExtension("The pollingInterval of the eventScaling extension must be at least 1.")
+	}
+	if ext.CooldownPeriod != nil && *ext.CooldownPeriod < 0 {
+		return errInvalidExtension("The cooldownPeriod of the eventScaling extension must not be negative.")
+	}
+
+	for name, trigger := range ext.Triggers {
+		if _, ok := connections[name]; !ok {
+			return errInvalidExtension("The trigger %q of the eventScaling extension does not match a connection of the container.", name)
+		}
+		if trigger.TargetLength != nil && *trigger.TargetLength < 1 {
+			return errInvalidExtension("The targetLength of the trigger %q of the eventScaling extension must be at least 1.", name)
+		}
+	}
+
+	return nil
+}
+
+func errInvalidExtension(format string, a ...any) v1.ErrorDetails {
+	return v1.ErrorDetails{
+		Code:    v1.CodeInvalidRequestContent,
+		Target:  extensionsProperty,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
 // validatePodSpec is doing only syntactic validation for PodSpec by deserialzing the given JSON patch
 // to PodSpec object at this time. The semantic validation will be done when Radius applies the
 // patched object to Kubernetes API server.
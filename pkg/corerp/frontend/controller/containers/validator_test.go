@@ -22,10 +22,15 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
 )
@@ -444,3 +449,293 @@ func TestValidatePodSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateScalingExtensions(t *testing.T) {
+	autoScaling := func(ext datamodel.AutoScalingExtension) datamodel.Extension {
+		return datamodel.Extension{Kind: datamodel.AutoScaling, AutoScaling: &ext}
+	}
+	eventScaling := func(ext datamodel.EventScalingExtension) datamodel.Extension {
+		return datamodel.Extension{Kind: datamodel.EventScaling, EventScaling: &ext}
+	}
+	connections := map[string]datamodel.ConnectionProperties{
+		"orders": {Source: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Messaging/rabbitMQQueues/orders"},
+	}
+
+	tests := []struct {
+		name       string
+		extensions []datamodel.Extension
+		message    string
+	}{
+		{
+			name:       "no autoScaling extension",
+			extensions: []datamodel.Extension{{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{Replicas: to.Ptr[int32](2)}}},
+		},
+		{
+			name: "valid autoScaling extension",
+			extensions: []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{
+				MinReplicas:          to.Ptr[int32](2),
+				MaxReplicas:          5,
+				TargetCPUUtilization: to.Ptr[int32](80),
+				Metrics:              []datamodel.AutoScalingMetric{{Name: "requests", TargetAverageValue: "500m"}},
+			})},
+		},
+		{
+			name: "combined with manualScaling",
+			extensions: []datamodel.Extension{
+				autoScaling(datamodel.AutoScalingExtension{MaxReplicas: 5}),
+				{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{Replicas: to.Ptr[int32](2)}},
+			},
+			message: "The autoScaling and manualScaling extensions cannot be used together.",
+		},
+		{
+			name:       "invalid maxReplicas",
+			extensions: []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{})},
+			message:    "The maxReplicas of the autoScaling extension must be at least 1.",
+		},
+		{
+			name:       "minReplicas greater than maxReplicas",
+			extensions: []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{MinReplicas: to.Ptr[int32](6), MaxReplicas: 5})},
+			message:    "The minReplicas of the autoScaling extension must be between 1 and maxReplicas (5).",
+		},
+		{
+			name:       "invalid target utilization",
+			extensions: []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{MaxReplicas: 5, TargetMemoryUtilization: to.Ptr[int32](0)})},
+			message:    "The targetMemoryUtilization of the autoScaling extension must be at least 1.",
+		},
+		{
+			name: "invalid metric target",
+			extensions: []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{
+				MaxReplicas: 5,
+				Metrics:     []datamodel.AutoScalingMetric{{Name: "requests", TargetAverageValue: "lots"}},
+			})},
+			message: "The targetAverageValue \"lots\" of the metric \"requests\" of the autoScaling extension is not a valid quantity.",
+		},
+		{
+			name: "valid eventScaling extension",
+			extensions: []datamodel.Extension{eventScaling(datamodel.EventScalingExtension{
+				MinReplicas: to.Ptr[int32](0),
+				MaxReplicas: 5,
+				Triggers:    map[string]datamodel.EventScalingTrigger{"orders": {TargetLength: to.Ptr[int32](10)}},
+			})},
+		},
+		{
+			name: "eventScaling combined with autoScaling",
+			extensions: []datamodel.Extension{
+				autoScaling(datamodel.AutoScalingExtension{MaxReplicas: 5}),
+				eventScaling(datamodel.EventScalingExtension{MaxReplicas: 5}),
+			},
+			message: "The autoScaling and eventScaling extensions cannot be used together.",
+		},
+		{
+			name:       "eventScaling minReplicas greater than maxReplicas",
+			extensions: []datamodel.Extension{eventScaling(datamodel.EventScalingExtension{MinReplicas: to.Ptr[int32](6), MaxReplicas: 5})},
+			message:    "The minReplicas of the eventScaling extension must be between 0 and maxReplicas (5).",
+		},
+		{
+			name: "eventScaling trigger without connection",
+			extensions: []datamodel.Extension{eventScaling(datamodel.EventScalingExtension{
+				MaxReplicas: 5,
+				Triggers:    map[string]datamodel.EventScalingTrigger{"payments": {}},
+			})},
+			message: "The trigger \"payments\" of the eventScaling extension does not match a connection of the container.",
+		},
+		{
+			name: "eventScaling invalid targetLength",
+			extensions: []datamodel.Extension{eventScaling(datamodel.EventScalingExtension{
+				MaxReplicas: 5,
+				Triggers:    map[string]datamodel.EventScalingTrigger{"orders": {TargetLength: to.Ptr[int32](0)}},
+			})},
+			message: "The targetLength of the trigger \"orders\" of the eventScaling extension must be at least 1.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateScalingExtensions(tc.extensions, connections)
+			if tc.message == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  extensionsProperty,
+				Message: tc.message,
+			}, err)
+		})
+	}
+}
+
+func TestValidateAndMutateRequest_Resources(t *testing.T) {
+	const (
+		appID = "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app"
+		envID = "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/environments/test-env"
+	)
+
+	setupOptions := func(t *testing.T) *controller.Options {
+		ctrl := gomock.NewController(t)
+		sc := store.NewMockStorageClient(ctrl)
+		sp := dataprovider.NewMockDataStorageProvider(ctrl)
+		sp.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(sc, nil).AnyTimes()
+
+		app := &datamodel.Application{
+			Properties: datamodel.ApplicationProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{Environment: envID},
+			},
+		}
+		env := &datamodel.Environment{
+			Properties: datamodel.EnvironmentProperties{
+				ContainerResources: datamodel.ContainerResourcePolicy{
+					Maximum: datamodel.ContainerResourceQuantities{CPU: "2", Memory: "1Gi"},
+				},
+			},
+		}
+		sc.EXPECT().Get(gomock.Any(), appID).Return(&store.Object{Metadata: store.Metadata{ID: appID}, Data: app}, nil).AnyTimes()
+		sc.EXPECT().Get(gomock.Any(), envID).Return(&store.Object{Metadata: store.Metadata{ID: envID}, Data: env}, nil).AnyTimes()
+
+		return &controller.Options{DataProvider: sp}
+	}
+
+	tests := []struct {
+		name      string
+		resources datamodel.ContainerResourceRequirements
+		message   string
+	}{
+		{
+			name: "within maximum",
+			resources: datamodel.ContainerResourceRequirements{
+				Requests: datamodel.ContainerResourceQuantities{CPU: "500m", Memory: "256Mi"},
+				Limits:   datamodel.ContainerResourceQuantities{CPU: "2", Memory: "1Gi"},
+			},
+		},
+		{
+			name: "limit exceeds maximum",
+			resources: datamodel.ContainerResourceRequirements{
+				Limits: datamodel.ContainerResourceQuantities{Memory: "2Gi"},
+			},
+			message: "The memory limit (2Gi) exceeds the maximum of the environment (1Gi).",
+		},
+		{
+			name: "request exceeds limit",
+			resources: datamodel.ContainerResourceRequirements{
+				Requests: datamodel.ContainerResourceQuantities{CPU: "1"},
+				Limits:   datamodel.ContainerResourceQuantities{CPU: "500m"},
+			},
+			message: "The cpu request (1) must not exceed the cpu limit (500m).",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			newResource := &datamodel.ContainerResource{
+				Properties: datamodel.ContainerProperties{
+					BasicResourceProperties: rpv1.BasicResourceProperties{Application: appID},
+					Container: datamodel.Container{
+						Image:     "test-image",
+						Resources: tc.resources,
+					},
+				},
+			}
+
+			r, err := ValidateAndMutateRequest(context.Background(), newResource, nil, setupOptions(t))
+			require.NoError(t, err)
+			if tc.message == "" {
+				require.Nil(t, r)
+				return
+			}
+
+			require.Equal(t, rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  resourcesProperty,
+					Message: tc.message,
+				},
+			}), r)
+		})
+	}
+}
+
+func TestValidateAdditionalContainers(t *testing.T) {
+	ephemeral := func(mountPath string, store datamodel.ManagedStore) map[string]datamodel.VolumeProperties {
+		return map[string]datamodel.VolumeProperties{
+			"config": {
+				Kind: datamodel.Ephemeral,
+				Ephemeral: &datamodel.EphemeralVolume{
+					VolumeBase:   datamodel.VolumeBase{MountPath: mountPath},
+					ManagedStore: store,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		properties datamodel.ContainerProperties
+		target     string
+		message    string
+	}{
+		{
+			name: "shared volume",
+			properties: datamodel.ContainerProperties{
+				Container:      datamodel.Container{Image: "app", Volumes: ephemeral("/etc/app", datamodel.ManagedStoreDisk)},
+				InitContainers: []datamodel.Container{{Image: "init", Volumes: ephemeral("/config", datamodel.ManagedStoreDisk)}},
+				Sidecars:       []datamodel.Container{{Image: "sidecar", Ports: map[string]datamodel.ContainerPort{"metrics": {ContainerPort: 2020}}}},
+			},
+		},
+		{
+			name: "init container with probe",
+			properties: datamodel.ContainerProperties{
+				InitContainers: []datamodel.Container{{
+					Image:          "init",
+					ReadinessProbe: datamodel.HealthProbeProperties{Kind: datamodel.TCPHealthProbe, TCP: &datamodel.TCPHealthProbeProperties{ContainerPort: 80}},
+				}},
+			},
+			target:  "$.properties.initContainers[0]",
+			message: "Init containers can't have readiness or liveness probes.",
+		},
+		{
+			name: "init container with port",
+			properties: datamodel.ContainerProperties{
+				InitContainers: []datamodel.Container{{Image: "init", Ports: map[string]datamodel.ContainerPort{"web": {ContainerPort: 80}}}},
+			},
+			target:  "$.properties.initContainers[0]",
+			message: "Init containers can't have ports.",
+		},
+		{
+			name: "sidecar port provides a route",
+			properties: datamodel.ContainerProperties{
+				Sidecars: []datamodel.Container{
+					{Image: "sidecar"},
+					{Image: "sidecar", Ports: map[string]datamodel.ContainerPort{"web": {ContainerPort: 80, Provides: "route"}}},
+				},
+			},
+			target:  "$.properties.sidecars[1]",
+			message: "The port \"web\" can't provide a route. Only the ports of the container can provide routes.",
+		},
+		{
+			name: "shared volume with different store",
+			properties: datamodel.ContainerProperties{
+				Container: datamodel.Container{Image: "app", Volumes: ephemeral("/etc/app", datamodel.ManagedStoreDisk)},
+				Sidecars:  []datamodel.Container{{Image: "sidecar", Volumes: ephemeral("/config", datamodel.ManagedStoreMemory)}},
+			},
+			target:  "$.properties.sidecars[0]",
+			message: "The volume \"config\" must have the same kind and source as the other declarations of the volume.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateAdditionalContainers(tc.properties)
+			if tc.message == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  tc.target,
+				Message: tc.message,
+			}, err)
+		})
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
+	"sort"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -27,6 +29,10 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/corerp/frontend/controller/util"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/exp/maps"
 )
 
 var _ ctrl.Controller = (*CreateOrUpdateEnvironment)(nil)
@@ -34,10 +40,12 @@ var _ ctrl.Controller = (*CreateOrUpdateEnvironment)(nil)
 // CreateOrUpdateEnvironments is the controller implementation to create or update environment resource.
 type CreateOrUpdateEnvironment struct {
 	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine engine.Engine
 }
 
-// NewCreateOrUpdateEnvironment creates a new controller for creating or updating an environment resource.
-func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
+// NewCreateOrUpdateEnvironment creates a new controller for creating or updating an environment resource. The recipe
+// engine is used to validate the recipe parameters against the parameters declared by the recipe templates.
+func NewCreateOrUpdateEnvironment(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
 	return &CreateOrUpdateEnvironment{
 		ctrl.NewOperation(opts,
 			ctrl.ResourceOptions[datamodel.Environment]{
@@ -45,10 +53,11 @@ func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
 				ResponseConverter: converter.EnvironmentDataModelToVersioned,
 			},
 		),
+		engine,
 	}, nil
 }
 
-// Run checks if a resource with the same namespace already exists, and if not, updates the resource with the new values.
+// Run validates the container resource policy and the recipe parameters and checks if a resource with the same namespace already exists, and if not, updates the resource with the new values.
 // If a resource with the same namespace already exists, a conflict response is returned.
 func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -69,6 +78,21 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		return rest.NewBadRequestResponse(err.Error()), nil
 	}
 
+	// The default compute resources of the containers must not exceed the maximum of the environment.
+	policy := newResource.Properties.ContainerResources
+	if err := util.ValidateContainerResources("$.properties.containerResources", policy.Defaults, policy.Maximum); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	gateway := newResource.Properties.Gateway
+	if gateway.Implementation == datamodel.GatewayImplementationGatewayAPI && gateway.GatewayClassName == "" {
+		return rest.NewBadRequestResponse("gatewayClassName is required when the gateway implementation is gatewayAPI."), nil
+	}
+
+	if r := e.validateRecipeParameters(ctx, newResource, old); r != nil {
+		return r, nil
+	}
+
 	// Create Query filter to query kubernetes namespace used by the other environment resources.
 	namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
 	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, e.StorageClient())
@@ -97,3 +121,58 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 
 	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
+
+// validateRecipeParameters validates the parameters of the new or updated recipes of the environment against the parameters
+// declared by the recipe templates, and returns a bad request response listing the problems found. The parameters of a recipe
+// are not validated if its template can't be loaded, since the template may be published after the environment is updated.
+func (e *CreateOrUpdateEnvironment) validateRecipeParameters(ctx context.Context, newResource, old *datamodel.Environment) rest.Response {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	errs := []v1.ErrorDetails{}
+	resourceTypes := maps.Keys(newResource.Properties.Recipes)
+	sort.Strings(resourceTypes)
+	for _, resourceType := range resourceTypes {
+		names := maps.Keys(newResource.Properties.Recipes[resourceType])
+		sort.Strings(names)
+		for _, name := range names {
+			recipe := newResource.Properties.Recipes[resourceType][name]
+			if len(recipe.Parameters) == 0 {
+				continue
+			}
+
+			if old != nil {
+				if oldRecipe, ok := old.Properties.Recipes[resourceType][name]; ok && reflect.DeepEqual(oldRecipe, recipe) {
+					continue
+				}
+			}
+
+			recipeErrs, err := e.engine.ValidateParameters(ctx, engine.ValidateOptions{
+				Definition: &recipes.EnvironmentDefinition{
+					Name:            name,
+					Driver:          recipe.TemplateKind,
+					ResourceType:    resourceType,
+					Parameters:      recipe.Parameters,
+					TemplatePath:    recipe.TemplatePath,
+					TemplateVersion: recipe.TemplateVersion,
+				},
+			})
+			if err != nil {
+				logger.Info(fmt.Sprintf("Skipping the validation of the parameters of recipe %q for resource type %q: %s", name, resourceType, err.Error()))
+				continue
+			}
+			errs = append(errs, recipeErrs...)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Message: "The recipe parameters are invalid.",
+			Details: errs,
+		},
+	})
+}
@@ -24,9 +24,13 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/store"
 
 	"github.com/golang/mock/gomock"
@@ -39,6 +43,8 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 	defer mctrl.Finish()
 
 	mStorageClient := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
+	mEngine.EXPECT().ValidateParameters(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	ctx := context.Background()
 
 	createNewResourceCases := []struct {
@@ -101,7 +107,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -178,7 +184,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -241,7 +247,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -310,7 +316,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -399,7 +405,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 				StorageClient: mStorageClient,
 			}
 
-			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
 			require.NoError(t, err)
 			resp, err := ctl.Run(ctx, w, req)
 			require.NoError(t, err)
@@ -409,3 +415,146 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateEnvironmentRun_InvalidRecipeParameters(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
+	ctx := context.Background()
+
+	envInput, _, _ := getTestModels20231001preview()
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+	require.NoError(t, err)
+	ctx = rpctest.NewARMRequestContext(req)
+
+	mStorageClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, &store.ErrNotFound{})
+
+	target := "$.properties.recipes['Applications.Datastores/mongoDatabases']['mongo-azure'].parameters.throughput"
+	mEngine.EXPECT().
+		ValidateParameters(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts engine.ValidateOptions) ([]v1.ErrorDetails, error) {
+			require.Equal(t, &recipes.EnvironmentDefinition{
+				Name:         "mongo-azure",
+				Driver:       "bicep",
+				ResourceType: "Applications.Datastores/mongoDatabases",
+				TemplatePath: "ghcr.io/radius-project/dev/recipes/mongodatabases/azure:1.0",
+				Parameters:   map[string]any{"throughput": float64(400)},
+			}, opts.Definition)
+			return []v1.ErrorDetails{
+				{
+					Code:    v1.CodeInvalidRequestContent,
+					Message: "parameter \"throughput\" must be of type \"string\", but the value is of type \"number\"",
+					Target:  target,
+				},
+			}, nil
+		})
+
+	opts := ctrl.Options{
+		StorageClient: mStorageClient,
+	}
+
+	ctl, err := NewCreateOrUpdateEnvironment(opts, mEngine)
+	require.NoError(t, err)
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	actual := v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), &actual)
+	require.NoError(t, err)
+	require.Equal(t, v1.CodeInvalidRequestContent, actual.Error.Code)
+	require.Len(t, actual.Error.Details, 1)
+	require.Equal(t, target, actual.Error.Details[0].Target)
+}
+
+func TestCreateOrUpdateEnvironmentRun_InvalidContainerResources(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	ctx := context.Background()
+
+	envInput, _, _ := getTestModels20231001preview()
+	envInput.Properties.ContainerResources = &v20231001preview.ContainerResourcePolicy{
+		Defaults: &v20231001preview.ContainerResourceRequirements{
+			Limits: &v20231001preview.ContainerResourceQuantities{
+				Memory: to.Ptr("2Gi"),
+			},
+		},
+		Maximum: &v20231001preview.ContainerResourceQuantities{
+			Memory: to.Ptr("1Gi"),
+		},
+	}
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+	require.NoError(t, err)
+	ctx = rpctest.NewARMRequestContext(req)
+
+	mStorageClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, &store.ErrNotFound{})
+
+	opts := ctrl.Options{
+		StorageClient: mStorageClient,
+	}
+
+	ctl, err := NewCreateOrUpdateEnvironment(opts, engine.NewMockEngine(mctrl))
+	require.NoError(t, err)
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	actual := v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), &actual)
+	require.NoError(t, err)
+	require.Equal(t, v1.CodeInvalidRequestContent, actual.Error.Code)
+	require.Equal(t, "$.properties.containerResources", actual.Error.Target)
+	require.Equal(t, "The memory limit (2Gi) exceeds the maximum of the environment (1Gi).", actual.Error.Message)
+}
+
+func TestCreateOrUpdateEnvironmentRun_GatewayAPIWithoutClassName(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	mStorageClient := store.NewMockStorageClient(mctrl)
+	ctx := context.Background()
+
+	envInput, _, _ := getTestModels20231001preview()
+	envInput.Properties.Gateway = &v20231001preview.EnvironmentGateway{
+		Implementation: to.Ptr(v20231001preview.GatewayImplementationGatewayAPI),
+	}
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+	require.NoError(t, err)
+	ctx = rpctest.NewARMRequestContext(req)
+
+	mStorageClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, &store.ErrNotFound{})
+
+	opts := ctrl.Options{
+		StorageClient: mStorageClient,
+	}
+
+	ctl, err := NewCreateOrUpdateEnvironment(opts, engine.NewMockEngine(mctrl))
+	require.NoError(t, err)
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	actual := v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), &actual)
+	require.NoError(t, err)
+	require.Equal(t, "gatewayClassName is required when the gateway implementation is gatewayAPI.", actual.Error.Message)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ValidateContainerResources validates the quantities of the compute resources of a container, and checks that the
+// requests do not exceed the limits and that neither exceeds the maximum. The target is the JSON path of the
+// requirements, which is reported in the returned v1.ErrorDetails.
+func ValidateContainerResources(target string, requirements datamodel.ContainerResourceRequirements, maximum datamodel.ContainerResourceQuantities) error {
+	quantities := []struct {
+		name    string
+		request string
+		limit   string
+		maximum string
+	}{
+		{"cpu", requirements.Requests.CPU, requirements.Limits.CPU, maximum.CPU},
+		{"memory", requirements.Requests.Memory, requirements.Limits.Memory, maximum.Memory},
+		{"ephemeralStorage", requirements.Requests.EphemeralStorage, requirements.Limits.EphemeralStorage, maximum.EphemeralStorage},
+	}
+
+	for _, q := range quantities {
+		request, err := parseQuantity(target, "requests", q.name, q.request)
+		if err != nil {
+			return err
+		}
+		limit, err := parseQuantity(target, "limits", q.name, q.limit)
+		if err != nil {
+			return err
+		}
+		max, err := parseQuantity(target, "maximum", q.name, q.maximum)
+		if err != nil {
+			return err
+		}
+
+		if request != nil && limit != nil && request.Cmp(*limit) > 0 {
+			return errInvalidResources(target, "The %s request (%s) must not exceed the %s limit (%s).", q.name, q.request, q.name, q.limit)
+		}
+		if max == nil {
+			continue
+		}
+		if request != nil && request.Cmp(*max) > 0 {
+			return errInvalidResources(target, "The %s request (%s) exceeds the maximum of the environment (%s).", q.name, q.request, q.maximum)
+		}
+		if limit != nil && limit.Cmp(*max) > 0 {
+			return errInvalidResources(target, "The %s limit (%s) exceeds the maximum of the environment (%s).", q.name, q.limit, q.maximum)
+		}
+	}
+
+	return nil
+}
+
+func parseQuantity(target, kind, name, value string) (*resource.Quantity, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, errInvalidResources(target, "The %s %s %q is not a valid quantity.", name, kind, value)
+	}
+	return &quantity, nil
+}
+
+func errInvalidResources(target string, format string, a ...any) v1.ErrorDetails {
+	return v1.ErrorDetails{
+		Code:    v1.CodeInvalidRequestContent,
+		Target:  target,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
@@ -31,6 +31,7 @@ import (
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -62,6 +63,7 @@ func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discove
 		client:             client,
 		k8sDiscoveryClient: discoveryClient,
 		httpProxyWaiter:    NewHTTPProxyWaiter(dynamicClientSet),
+		gatewayRouteWaiter: NewGatewayRouteWaiter(dynamicClientSet),
 		deploymentWaiter:   NewDeploymentWaiter(clientSet),
 	}
 }
@@ -71,6 +73,7 @@ type kubernetesHandler struct {
 	// k8sDiscoveryClient is the Kubernetes client to used for API version lookups on Kubernetes resources. Override this for testing.
 	k8sDiscoveryClient discovery.ServerResourcesInterface
 	httpProxyWaiter    ResourceWaiter
+	gatewayRouteWaiter ResourceWaiter
 	deploymentWaiter   ResourceWaiter
 }
 
@@ -102,6 +105,13 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		return nil, err
 	}
 
+	if strings.EqualFold(item.GetKind(), resources_kubernetes.KindDeployment) && item.GetAnnotations()[kubernetes.AnnotationAutoscaled] == "true" {
+		err = handler.preserveReplicas(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = handler.client.Patch(ctx, &item, client.Apply, &client.PatchOptions{FieldManager: kubernetes.FieldManager})
 	if err != nil {
 		return nil, err
@@ -137,6 +147,22 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		}
 		logger.Info(fmt.Sprintf("HTTP Proxy %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
 		return properties, nil
+	case "httproute", "grpcroute":
+		err = handler.gatewayRouteWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("%s %s in namespace %s is ready", item.GetKind(), item.GetName(), item.GetNamespace()))
+
+		// The public endpoint of the gateway is the address assigned to the parent Gateway.
+		address, err := getGatewayAddress(ctx, handler.client, &item)
+		if err != nil {
+			return nil, err
+		}
+		if address != "" {
+			properties[GatewayAddressKey] = address
+		}
+		return properties, nil
 	default:
 		// We do not monitor the other resource types.
 		return properties, nil
@@ -203,6 +229,28 @@ func (handler *kubernetesHandler) lookupKubernetesAPIVersion(ctx context.Context
 	return "", fmt.Errorf("could not find API version for type %q, type was not found", id.Type())
 }
 
+// preserveReplicas sets the replica count of an autoscaled Deployment to its live value, so that applying the Deployment
+// does not fight the HorizontalPodAutoscaler which manages it. The rendered Deployment does not specify a replica count,
+// but server-side apply resets a field which is no longer specified by its owner, for example when the Deployment was
+// previously scaled by the manualScaling extension.
+func (handler *kubernetesHandler) preserveReplicas(ctx context.Context, item *unstructured.Unstructured) error {
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(item.GroupVersionKind())
+	err := handler.client.Get(ctx, client.ObjectKey{Namespace: item.GetNamespace(), Name: item.GetName()}, live)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	replicas, found, err := unstructured.NestedInt64(live.Object, "spec", "replicas")
+	if err != nil || !found {
+		return err
+	}
+
+	return unstructured.SetNestedField(item.Object, replicas, "spec", "replicas")
+}
+
 func convertToUnstructured(resource rpv1.OutputResource) (unstructured.Unstructured, error) {
 	obj, ok := resource.CreateResource.Data.(runtime.Object)
 	if !ok {
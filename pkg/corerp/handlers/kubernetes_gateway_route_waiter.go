@@ -0,0 +1,259 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	MaxGatewayRouteDeploymentTimeout = time.Minute * time.Duration(10)
+	GatewayRouteConditionAccepted    = "Accepted"
+	GatewayRouteConditionResolved    = "ResolvedRefs"
+
+	// GatewayAddressKey is the key of the address of the parent Gateway of a route, once the route is ready.
+	GatewayAddressKey = "gatewayaddress"
+)
+
+// gatewayRouteStatus is the status of a Kubernetes Gateway API HTTPRoute or GRPCRoute.
+type gatewayRouteStatus struct {
+	Parents []gatewayRouteParentStatus `json:"parents,omitempty"`
+}
+
+// gatewayStatus is the status of a Kubernetes Gateway API Gateway.
+type gatewayStatus struct {
+	Addresses []gatewayStatusAddress `json:"addresses,omitempty"`
+}
+
+// gatewayStatusAddress is an address assigned to a Gateway, such as the IP address of its load balancer.
+type gatewayStatusAddress struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value"`
+}
+
+// gatewayRouteParentStatus is the status of a route with respect to one of its parent Gateways.
+type gatewayRouteParentStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type gatewayRouteWaiter struct {
+	dynamicClientSet       dynamic.Interface
+	routeDeploymentTimeout time.Duration
+	cacheResyncInterval    time.Duration
+}
+
+// NewGatewayRouteWaiter returns a new instance of GatewayRouteWaiter
+func NewGatewayRouteWaiter(dynamicClientSet dynamic.Interface) *gatewayRouteWaiter {
+	return &gatewayRouteWaiter{
+		dynamicClientSet:       dynamicClientSet,
+		routeDeploymentTimeout: MaxGatewayRouteDeploymentTimeout,
+		cacheResyncInterval:    DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *gatewayRouteWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkRouteStatus(ctx, informerFactory, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkRouteStatus(ctx, informerFactory, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addEventHandler is not implemented for gatewayRouteWaiter
+func (handler *gatewayRouteWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *gatewayRouteWaiter) waitUntilReady(ctx context.Context, obj client.Object) error {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("routeName", obj.GetName(), "kind", kind, "namespace", obj.GetNamespace())
+
+	doneCh := make(chan error, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, handler.routeDeploymentTimeout)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	// Create dynamic informer for the route
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(handler.dynamicClientSet, 0, obj.GetNamespace(), nil)
+	routeInformer := dynamicInformerFactory.ForResource(gatewayRouteGVR(obj))
+	// Add event handlers to the route informer
+	handler.addDynamicEventHandler(ctx, dynamicInformerFactory, routeInformer.Informer(), obj, doneCh)
+
+	// Start the informers
+	dynamicInformerFactory.Start(ctx.Done())
+
+	// Wait for the cache to be synced.
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	select {
+	case <-ctx.Done():
+		// Get the final status
+		route, err := routeInformer.Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+		if err != nil {
+			return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, error occured while fetching latest status: %w", kind, obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		status, err := getGatewayRouteStatus(route.(*unstructured.Unstructured))
+		if err != nil {
+			return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, error occured while fetching latest status: %w", kind, obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		condition := metav1.Condition{}
+		for _, parent := range status.Parents {
+			if len(parent.Conditions) > 0 {
+				condition = parent.Conditions[len(parent.Conditions)-1]
+			}
+		}
+		return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, status: %s, reason: %s", kind, obj.GetName(), obj.GetNamespace(), condition.Message, condition.Reason)
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking %s deployment %s in namespace %s as complete", kind, obj.GetName(), obj.GetNamespace()))
+		}
+		return err
+	}
+}
+
+// checkRouteStatus reports the route as ready once every parent Gateway has accepted it and resolved its backend
+// references. It reports an error when a parent rejects the current generation of the route.
+func (handler *gatewayRouteWaiter) checkRouteStatus(ctx context.Context, dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory, obj client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("routeName", obj.GetName(), "namespace", obj.GetNamespace())
+
+	item, err := dynamicInformerFactory.ForResource(gatewayRouteGVR(obj)).Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+	if err != nil {
+		logger.Info(fmt.Sprintf("Unable to get route: %s", err.Error()))
+		return false
+	}
+
+	route := item.(*unstructured.Unstructured)
+	status, err := getGatewayRouteStatus(route)
+	if err != nil {
+		logger.Info(fmt.Sprintf("Unable to convert route status: %s", err.Error()))
+		return false
+	}
+
+	// The route has not been processed by the gateway controller yet.
+	if len(status.Parents) == 0 {
+		return false
+	}
+
+	ready := true
+	for _, parent := range status.Parents {
+		for _, conditionType := range []string{GatewayRouteConditionAccepted, GatewayRouteConditionResolved} {
+			condition := meta.FindStatusCondition(parent.Conditions, conditionType)
+			if condition == nil || condition.ObservedGeneration != route.GetGeneration() {
+				ready = false
+				continue
+			}
+
+			if condition.Status == metav1.ConditionFalse {
+				doneCh <- fmt.Errorf("Failed to deploy %s. Condition: %s, Reason: %s, Message: %s", route.GetKind(), condition.Type, condition.Reason, condition.Message)
+				return false
+			}
+
+			if condition.Status != metav1.ConditionTrue {
+				ready = false
+			}
+		}
+	}
+
+	if ready {
+		// The route is ready
+		doneCh <- nil
+	}
+	return ready
+}
+
+// gatewayRouteGVR returns the GroupVersionResource of the given HTTPRoute or GRPCRoute.
+func gatewayRouteGVR(obj client.Object) schema.GroupVersionResource {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return gvk.GroupVersion().WithResource(strings.ToLower(gvk.Kind) + "s")
+}
+
+// getGatewayAddress returns the first address assigned to the parent Gateway of the route, or an empty string if the
+// Gateway has no address yet.
+func getGatewayAddress(ctx context.Context, k8sClient client.Client, route *unstructured.Unstructured) (string, error) {
+	parentRefs, _, err := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	if err != nil || len(parentRefs) == 0 {
+		return "", err
+	}
+
+	parentRef, _ := parentRefs[0].(map[string]any)
+	name, _ := parentRef["name"].(string)
+	namespace, _ := parentRef["namespace"].(string)
+	if namespace == "" {
+		namespace = route.GetNamespace()
+	}
+
+	gateway := &unstructured.Unstructured{}
+	gateway.SetGroupVersionKind(route.GroupVersionKind().GroupVersion().WithKind(resources_kubernetes.KindGatewayAPIGateway))
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, gateway); err != nil {
+		return "", fmt.Errorf("failed to get the Gateway %s in namespace %s: %w", name, namespace, err)
+	}
+
+	status := gatewayStatus{}
+	if obj, ok := gateway.Object["status"].(map[string]any); ok {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, &status); err != nil {
+			return "", err
+		}
+	}
+
+	for _, address := range status.Addresses {
+		if address.Value != "" {
+			return address.Value, nil
+		}
+	}
+
+	return "", nil
+}
+
+func getGatewayRouteStatus(route *unstructured.Unstructured) (gatewayRouteStatus, error) {
+	status := gatewayRouteStatus{}
+
+	obj, ok := route.Object["status"].(map[string]any)
+	if !ok {
+		return status, nil
+	}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, &status)
+	return status, err
+}
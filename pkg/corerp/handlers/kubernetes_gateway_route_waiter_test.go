@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/test/k8sutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+)
+
+var httpRouteGVR = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"}
+
+func makeGatewayRoute(generation int64, conditions ...map[string]any) *unstructured.Unstructured {
+	route := &unstructured.Unstructured{Object: map[string]any{}}
+	route.SetAPIVersion("gateway.networking.k8s.io/v1")
+	route.SetKind("HTTPRoute")
+	route.SetNamespace("default")
+	route.SetName("example")
+	route.SetGeneration(generation)
+
+	if len(conditions) > 0 {
+		parentConditions := []any{}
+		for _, condition := range conditions {
+			parentConditions = append(parentConditions, condition)
+		}
+
+		route.Object["status"] = map[string]any{
+			"parents": []any{
+				map[string]any{
+					"parentRef":      map[string]any{"name": "example"},
+					"controllerName": "example.com/gateway-controller",
+					"conditions":     parentConditions,
+				},
+			},
+		}
+	}
+
+	return route
+}
+
+func makeGatewayRouteCondition(conditionType string, status metav1.ConditionStatus, generation int64, reason string, message string) map[string]any {
+	return map[string]any{
+		"type":               conditionType,
+		"status":             string(status),
+		"observedGeneration": generation,
+		"lastTransitionTime": "2023-10-01T00:00:00Z",
+		"reason":             reason,
+		"message":            message,
+	}
+}
+
+func checkGatewayRoute(t *testing.T, route *unstructured.Unstructured, doneCh chan error) bool {
+	fakeClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{httpRouteGVR: "HTTPRouteList"}, route)
+
+	// create a fake dynamic informer factory with the route in the HTTPRoute informer cache
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(fakeClient, 0, "default", nil)
+	err := dynamicInformerFactory.ForResource(httpRouteGVR).Informer().GetIndexer().Add(route)
+	require.NoError(t, err, "Could not add test route to informer cache")
+
+	ctx := context.Background()
+	dynamicInformerFactory.Start(ctx.Done())
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	// create the object the handler applied
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("gateway.networking.k8s.io/v1")
+	obj.SetKind("HTTPRoute")
+	obj.SetNamespace("default")
+	obj.SetName("example")
+
+	gatewayRouteWaiter := &gatewayRouteWaiter{
+		dynamicClientSet: fakeClient,
+	}
+
+	return gatewayRouteWaiter.checkRouteStatus(ctx, dynamicInformerFactory, obj, doneCh)
+}
+
+func TestCheckGatewayRouteStatus_Ready(t *testing.T) {
+	route := makeGatewayRoute(2,
+		makeGatewayRouteCondition(GatewayRouteConditionAccepted, metav1.ConditionTrue, 2, "Accepted", "Route is accepted"),
+		makeGatewayRouteCondition(GatewayRouteConditionResolved, metav1.ConditionTrue, 2, "ResolvedRefs", "Resolved all the Object references for the Route"))
+
+	doneCh := make(chan error, 1)
+	require.True(t, checkGatewayRoute(t, route, doneCh))
+	require.NoError(t, <-doneCh)
+}
+
+func TestCheckGatewayRouteStatus_NotAccepted(t *testing.T) {
+	route := makeGatewayRoute(1,
+		makeGatewayRouteCondition(GatewayRouteConditionAccepted, metav1.ConditionFalse, 1, "NotAllowedByListeners", "No listener allows the route"),
+		makeGatewayRouteCondition(GatewayRouteConditionResolved, metav1.ConditionTrue, 1, "ResolvedRefs", "Resolved all the Object references for the Route"))
+
+	doneCh := make(chan error, 1)
+	require.False(t, checkGatewayRoute(t, route, doneCh))
+	require.EqualError(t, <-doneCh, "Failed to deploy HTTPRoute. Condition: Accepted, Reason: NotAllowedByListeners, Message: No listener allows the route")
+}
+
+func TestCheckGatewayRouteStatus_UnresolvedRefs(t *testing.T) {
+	route := makeGatewayRoute(1,
+		makeGatewayRouteCondition(GatewayRouteConditionAccepted, metav1.ConditionTrue, 1, "Accepted", "Route is accepted"),
+		makeGatewayRouteCondition(GatewayRouteConditionResolved, metav1.ConditionFalse, 1, "BackendNotFound", "Service default/backend not found"))
+
+	doneCh := make(chan error, 1)
+	require.False(t, checkGatewayRoute(t, route, doneCh))
+	require.EqualError(t, <-doneCh, "Failed to deploy HTTPRoute. Condition: ResolvedRefs, Reason: BackendNotFound, Message: Service default/backend not found")
+}
+
+func TestCheckGatewayRouteStatus_Pending(t *testing.T) {
+	tests := []struct {
+		name  string
+		route *unstructured.Unstructured
+	}{
+		{
+			name:  "no status",
+			route: makeGatewayRoute(1),
+		},
+		{
+			name: "missing condition",
+			route: makeGatewayRoute(1,
+				makeGatewayRouteCondition(GatewayRouteConditionAccepted, metav1.ConditionTrue, 1, "Accepted", "Route is accepted")),
+		},
+		{
+			name: "stale conditions",
+			route: makeGatewayRoute(2,
+				makeGatewayRouteCondition(GatewayRouteConditionAccepted, metav1.ConditionFalse, 1, "NotAllowedByListeners", "No listener allows the route"),
+				makeGatewayRouteCondition(GatewayRouteConditionResolved, metav1.ConditionTrue, 1, "ResolvedRefs", "Resolved all the Object references for the Route")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			doneCh := make(chan error, 1)
+			require.False(t, checkGatewayRoute(t, tc.route, doneCh))
+			require.Empty(t, doneCh)
+		})
+	}
+}
+
+func TestGetGatewayAddress(t *testing.T) {
+	makeGateway := func(addresses ...string) *unstructured.Unstructured {
+		gateway := &unstructured.Unstructured{Object: map[string]any{}}
+		gateway.SetAPIVersion("gateway.networking.k8s.io/v1")
+		gateway.SetKind("Gateway")
+		gateway.SetNamespace("default")
+		gateway.SetName("example")
+
+		statusAddresses := []any{}
+		for _, address := range addresses {
+			statusAddresses = append(statusAddresses, map[string]any{"type": "IPAddress", "value": address})
+		}
+		gateway.Object["status"] = map[string]any{"addresses": statusAddresses}
+		return gateway
+	}
+
+	route := makeGatewayRoute(1)
+	route.Object["spec"] = map[string]any{
+		"parentRefs": []any{
+			map[string]any{"name": "example"},
+		},
+	}
+
+	t.Run("address", func(t *testing.T) {
+		k8sClient := k8sutil.NewFakeKubeClient(nil, makeGateway("10.0.0.1", "10.0.0.2"))
+		address, err := getGatewayAddress(context.Background(), k8sClient, route)
+		require.NoError(t, err)
+		require.Equal(t, "10.0.0.1", address)
+	})
+
+	t.Run("no address", func(t *testing.T) {
+		k8sClient := k8sutil.NewFakeKubeClient(nil, makeGateway())
+		address, err := getGatewayAddress(context.Background(), k8sClient, route)
+		require.NoError(t, err)
+		require.Empty(t, address)
+	})
+
+	t.Run("no gateway", func(t *testing.T) {
+		k8sClient := k8sutil.NewFakeKubeClient(nil)
+		_, err := getGatewayAddress(context.Background(), k8sClient, route)
+		require.Error(t, err)
+	})
+}
@@ -22,8 +22,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
@@ -177,6 +179,58 @@ func TestDelete(t *testing.T) {
 	})
 }
 
+func TestPreserveReplicas(t *testing.T) {
+	newItem := func(t *testing.T) unstructured.Unstructured {
+		item, err := convertToUnstructured(rpv1.OutputResource{
+			CreateResource: &rpv1.Resource{
+				ResourceType: resourcemodel.ResourceType{
+					Provider: resourcemodel.ProviderKubernetes,
+					Type:     resources_kubernetes.ResourceTypeDeployment,
+				},
+				Data: &v1.Deployment{
+					TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "test-deployment",
+						Namespace:   "test-namespace",
+						Annotations: map[string]string{kubernetes.AnnotationAutoscaled: "true"},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		return item
+	}
+
+	t.Run("live deployment", func(t *testing.T) {
+		live := &v1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "test-namespace"},
+			Spec:       v1.DeploymentSpec{Replicas: to.Ptr[int32](4)},
+		}
+		handler := kubernetesHandler{client: k8sutil.NewFakeKubeClient(nil, live)}
+
+		item := newItem(t)
+		err := handler.preserveReplicas(context.Background(), &item)
+		require.NoError(t, err)
+
+		replicas, found, err := unstructured.NestedInt64(item.Object, "spec", "replicas")
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, int64(4), replicas)
+	})
+
+	t.Run("new deployment", func(t *testing.T) {
+		handler := kubernetesHandler{client: k8sutil.NewFakeKubeClient(nil)}
+
+		item := newItem(t)
+		err := handler.preserveReplicas(context.Background(), &item)
+		require.NoError(t, err)
+
+		_, found, err := unstructured.NestedInt64(item.Object, "spec", "replicas")
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+}
+
 func TestConvertToUnstructured(t *testing.T) {
 	convertTests := []struct {
 		name string
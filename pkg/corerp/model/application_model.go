@@ -22,9 +22,11 @@ import (
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers/autoscale"
 	"github.com/radius-project/radius/pkg/corerp/renderers/container"
 	azcontainer "github.com/radius-project/radius/pkg/corerp/renderers/container/azure"
 	"github.com/radius-project/radius/pkg/corerp/renderers/daprextension"
+	"github.com/radius-project/radius/pkg/corerp/renderers/eventscale"
 	"github.com/radius-project/radius/pkg/corerp/renderers/gateway"
 	"github.com/radius-project/radius/pkg/corerp/renderers/httproute"
 	"github.com/radius-project/radius/pkg/corerp/renderers/kubernetesmetadata"
@@ -89,9 +91,13 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 			ResourceType: container.ResourceType,
 			Renderer: &kubernetesmetadata.Renderer{
 				Inner: &manualscale.Renderer{
-					Inner: &daprextension.Renderer{
-						Inner: &container.Renderer{
-							RoleAssignmentMap: roleAssignmentMap,
+					Inner: &autoscale.Renderer{
+						Inner: &eventscale.Renderer{
+							Inner: &daprextension.Renderer{
+								Inner: &container.Renderer{
+									RoleAssignmentMap: roleAssignmentMap,
+								},
+							},
 						},
 					},
 				},
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Renderer is the renderers.Renderer implementation for the autoscale extension.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render checks if the DataModelInterface is a ContainerResource and if so, checks for an AutoScaling extension. If it
+// is found, a HorizontalPodAutoscaler targeting the Deployment of the container is added to the output resources, and
+// the replica count of the Deployment is left to the HorizontalPodAutoscaler.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	extension := datamodel.FindExtension(resource.Properties.Extensions, datamodel.AutoScaling)
+	if extension == nil || extension.AutoScaling == nil {
+		return output, nil
+	}
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	if deployment == nil {
+		// The container is not rendered as a Deployment, so there is nothing to scale.
+		return output, nil
+	}
+
+	hpa, err := makeHorizontalPodAutoscaler(&deployment.ObjectMeta, extension.AutoScaling)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	// The replica count is omitted so that applying the Deployment does not override the count set by the
+	// HorizontalPodAutoscaler.
+	deployment.Spec.Replicas = nil
+	if deployment.Annotations == nil {
+		deployment.Annotations = map[string]string{}
+	}
+	deployment.Annotations[kubernetes.AnnotationAutoscaled] = "true"
+
+	hpaOutput := rpv1.NewKubernetesOutputResource(rpv1.LocalIDHorizontalPodAutoscaler, hpa, hpa.ObjectMeta)
+	hpaOutput.CreateResource.Dependencies = []string{rpv1.LocalIDDeployment}
+	output.Resources = append(output.Resources, hpaOutput)
+
+	return output, nil
+}
+
+// makeHorizontalPodAutoscaler creates the HorizontalPodAutoscaler which scales the deployment as specified by the
+// extension.
+func makeHorizontalPodAutoscaler(deployment *metav1.ObjectMeta, ext *datamodel.AutoScalingExtension) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	metrics := []autoscalingv2.MetricSpec{}
+	if ext.TargetCPUUtilization != nil {
+		metrics = append(metrics, makeResourceMetric(corev1.ResourceCPU, *ext.TargetCPUUtilization))
+	}
+	if ext.TargetMemoryUtilization != nil {
+		metrics = append(metrics, makeResourceMetric(corev1.ResourceMemory, *ext.TargetMemoryUtilization))
+	}
+	for _, metric := range ext.Metrics {
+		value, err := resource.ParseQuantity(metric.TargetAverageValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target average value %q of metric %q: %w", metric.TargetAverageValue, metric.Name, err)
+		}
+
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: metric.Name},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &value,
+				},
+			},
+		})
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: "autoscaling/v2",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+			Labels:    maps.Clone(deployment.Labels),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       deployment.Name,
+				APIVersion: "apps/v1",
+			},
+			MinReplicas: ext.MinReplicas,
+			MaxReplicas: ext.MaxReplicas,
+		},
+	}
+
+	// Without metrics, the HorizontalPodAutoscaler uses its default target of 80% CPU utilization.
+	if len(metrics) > 0 {
+		hpa.Spec.Metrics = metrics
+	}
+
+	return hpa, nil
+}
+
+func makeResourceMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			},
+		},
+	}
+}
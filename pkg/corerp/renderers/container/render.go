@@ -27,6 +27,7 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -131,17 +132,22 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 		}
 	}
 
-	for _, volume := range properties.Container.Volumes {
-		switch volume.Kind {
-		case datamodel.Persistent:
-			resourceID, err := resources.ParseResource(volume.Persistent.Source)
-			if err != nil {
-				return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
-			}
+	// The volumes of the init containers and sidecars are dependencies as well.
+	containers := append([]datamodel.Container{properties.Container}, properties.InitContainers...)
+	containers = append(containers, properties.Sidecars...)
+	for _, container := range containers {
+		for _, volume := range container.Volumes {
+			switch volume.Kind {
+			case datamodel.Persistent:
+				resourceID, err := resources.ParseResource(volume.Persistent.Source)
+				if err != nil {
+					return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+				}
 
-			if resources_radius.IsRadiusResource(resourceID) {
-				radiusResourceIDs = append(radiusResourceIDs, resourceID)
-				continue
+				if resources_radius.IsRadiusResource(resourceID) {
+					radiusResourceIDs = append(radiusResourceIDs, resourceID)
+					continue
+				}
 			}
 		}
 	}
@@ -394,6 +400,11 @@ func (r Renderer) makeDeployment(
 		container.ImagePullPolicy = corev1.PullPolicy(properties.Container.ImagePullPolicy)
 	}
 
+	resources := applyContainerResourceDefaults(properties.Container.Resources, options.Environment.ContainerResourceDefaults)
+	if err := makeResourceRequirements(&container.Resources, resources); err != nil {
+		return []rpv1.OutputResource{}, nil, err
+	}
+
 	var err error
 	if !properties.Container.ReadinessProbe.IsEmpty() {
 		container.ReadinessProbe, err = r.makeHealthProbe(properties.Container.ReadinessProbe)
@@ -408,21 +419,42 @@ func (r Renderer) makeDeployment(
 		}
 	}
 
+	// Init containers and sidecars are named after their position. If the base manifest defines a container with
+	// the same name, it is used as the base of the container.
+	initContainers := []corev1.Container{}
+	for i, c := range properties.InitContainers {
+		base := findContainer(podSpec.InitContainers, fmt.Sprintf("init-%d", i))
+		initContainer, err := r.makeAdditionalContainer(base, c, options.Environment.ContainerResourceDefaults)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, fmt.Errorf("init container %d encountered errors: %w", i, err)
+		}
+		initContainers = append(initContainers, initContainer)
+	}
+
+	sidecars := []corev1.Container{}
+	for i, c := range properties.Sidecars {
+		base := findContainer(podSpec.Containers, fmt.Sprintf("sidecar-%d", i))
+		sidecar, err := r.makeAdditionalContainer(base, c, options.Environment.ContainerResourceDefaults)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, fmt.Errorf("sidecar %d encountered errors: %w", i, err)
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+
 	// We build the environment variable list in a stable order for testability
 	// For the values that come from connections we back them with secretData. We'll extract the values
-	// and return them.
+	// and return them. The connection environment variables are shared by all containers of the pod.
 	env, secretData, err := getEnvVarsAndSecretData(resource, applicationName, dependencies)
 	if err != nil {
 		return []rpv1.OutputResource{}, nil, fmt.Errorf("failed to obtain environment variables and secret data: %w", err)
 	}
 
-	for k, v := range properties.Container.Env {
-		env[k] = corev1.EnvVar{Name: k, Value: v}
+	container.Env = append(container.Env, makeEnvVars(env, properties.Container.Env)...)
+	for i := range initContainers {
+		initContainers[i].Env = append(initContainers[i].Env, makeEnvVars(env, properties.InitContainers[i].Env)...)
 	}
-
-	// Append in sorted order
-	for _, key := range getSortedKeys(env) {
-		container.Env = append(container.Env, env[key])
+	for i := range sidecars {
+		sidecars[i].Env = append(sidecars[i].Env, makeEnvVars(env, properties.Sidecars[i].Env)...)
 	}
 
 	outputResources := []rpv1.OutputResource{}
@@ -441,95 +473,126 @@ func (r Renderer) makeDeployment(
 	// To avoid the naming conflicts, we add the application name prefix to resource name.
 	azIdentityName := azrenderer.MakeResourceName(applicationName, resource.Name, azrenderer.Separator)
 
-	for volumeName, volumeProperties := range properties.Container.Volumes {
-		// Based on the kind, create a persistent/ephemeral volume
-		switch volumeProperties.Kind {
-		case datamodel.Ephemeral:
-			volumeSpec, volumeMountSpec, err := makeEphemeralVolume(volumeName, volumeProperties.Ephemeral)
-			if err != nil {
-				return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create ephemeral volume spec for volume: %s - %w", volumeName, err)
-			}
-			// Add the volume mount to the Container spec
-			container.VolumeMounts = append(container.VolumeMounts, volumeMountSpec)
-			// Add the volume to the list of volumes to be added to the Volumes spec
-			volumes = append(volumes, volumeSpec)
-		case datamodel.Persistent:
-			var volumeSpec corev1.Volume
-			var volumeMountSpec corev1.VolumeMount
-
-			properties, ok := dependencies[volumeProperties.Persistent.Source]
-			if !ok {
-				return []rpv1.OutputResource{}, nil, errors.New("volume dependency resource not found")
-			}
-
-			vol, ok := properties.Resource.(*datamodel.VolumeResource)
-			if !ok {
-				return []rpv1.OutputResource{}, nil, errors.New("invalid dependency resource")
-			}
+	// A volume that is declared by more than one container of the pod is added to the pod once and mounted into
+	// each of the containers. The frontend validates that the declarations of a shared volume are the same.
+	type workload struct {
+		container *corev1.Container
+		volumes   map[string]datamodel.VolumeProperties
+	}
+	workloads := []workload{{container, properties.Container.Volumes}}
+	for i := range initContainers {
+		workloads = append(workloads, workload{&initContainers[i], properties.InitContainers[i].Volumes})
+	}
+	for i := range sidecars {
+		workloads = append(workloads, workload{&sidecars[i], properties.Sidecars[i].Volumes})
+	}
 
-			switch vol.Properties.Kind {
-			case datamodel.AzureKeyVaultVolume:
-				// This will add the required managed identity resources.
-				identityRequired = true
+	addedVolumes := map[string]bool{}
+	for _, workload := range workloads {
+		for volumeName, volumeProperties := range workload.volumes {
+			added := addedVolumes[volumeName]
+			addedVolumes[volumeName] = true
 
-				// Prepare role assignments
-				roleNames := []string{}
-				if len(vol.Properties.AzureKeyVault.Secrets) > 0 {
-					roleNames = append(roleNames, AzureKeyVaultSecretsUserRole)
+			// Based on the kind, create a persistent/ephemeral volume
+			switch volumeProperties.Kind {
+			case datamodel.Ephemeral:
+				volumeSpec, volumeMountSpec, err := makeEphemeralVolume(volumeName, volumeProperties.Ephemeral)
+				if err != nil {
+					return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create ephemeral volume spec for volume: %s - %w", volumeName, err)
 				}
-				if len(vol.Properties.AzureKeyVault.Certificates) > 0 || len(vol.Properties.AzureKeyVault.Keys) > 0 {
-					roleNames = append(roleNames, AzureKeyVaultCryptoUserRole)
+				// Add the volume mount to the Container spec
+				workload.container.VolumeMounts = append(workload.container.VolumeMounts, volumeMountSpec)
+				// Add the volume to the list of volumes to be added to the Volumes spec
+				if !added {
+					volumes = append(volumes, volumeSpec)
 				}
+			case datamodel.Persistent:
+				var volumeSpec corev1.Volume
+				var volumeMountSpec corev1.VolumeMount
 
-				// Build RoleAssignment output.resource
-				kvID := vol.Properties.AzureKeyVault.Resource
-				roleAssignments, raDeps := azrenderer.MakeRoleAssignments(kvID, roleNames)
-				outputResources = append(outputResources, roleAssignments...)
-				deps = append(deps, raDeps...)
-
-				// Create Per-Pod SecretProviderClass for the selected volume
-				// csiobjectspec must be generated when volume is updated.
-				objectSpec, err := handlers.GetMapValue[string](properties.ComputedValues, azvolrenderer.SPCVolumeObjectSpecKey)
-				if err != nil {
-					return []rpv1.OutputResource{}, nil, err
+				properties, ok := dependencies[volumeProperties.Persistent.Source]
+				if !ok {
+					return []rpv1.OutputResource{}, nil, errors.New("volume dependency resource not found")
 				}
 
-				spcName := kubernetes.NormalizeResourceName(vol.Name)
-				secretProvider, err := azrenderer.MakeKeyVaultSecretProviderClass(applicationName, spcName, vol, objectSpec, &options.Environment)
-				if err != nil {
-					return []rpv1.OutputResource{}, nil, err
+				vol, ok := properties.Resource.(*datamodel.VolumeResource)
+				if !ok {
+					return []rpv1.OutputResource{}, nil, errors.New("invalid dependency resource")
 				}
-				outputResources = append(outputResources, *secretProvider)
-				deps = append(deps, rpv1.LocalIDSecretProviderClass)
 
-				// Create volume spec which associated with secretProviderClass.
-				volumeSpec, volumeMountSpec, err = azrenderer.MakeKeyVaultVolumeSpec(volumeName, volumeProperties.Persistent.MountPath, spcName)
-				if err != nil {
-					return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create secretstore volume spec for volume: %s - %w", volumeName, err)
+				switch vol.Properties.Kind {
+				case datamodel.AzureKeyVaultVolume:
+					spcName := kubernetes.NormalizeResourceName(vol.Name)
+
+					// The identity, role assignments and secret provider class are only created once for a volume
+					// that is shared by more than one container.
+					if !added {
+						// This will add the required managed identity resources.
+						identityRequired = true
+
+						// Prepare role assignments
+						roleNames := []string{}
+						if len(vol.Properties.AzureKeyVault.Secrets) > 0 {
+							roleNames = append(roleNames, AzureKeyVaultSecretsUserRole)
+						}
+						if len(vol.Properties.AzureKeyVault.Certificates) > 0 || len(vol.Properties.AzureKeyVault.Keys) > 0 {
+							roleNames = append(roleNames, AzureKeyVaultCryptoUserRole)
+						}
+
+						// Build RoleAssignment output.resource
+						kvID := vol.Properties.AzureKeyVault.Resource
+						roleAssignments, raDeps := azrenderer.MakeRoleAssignments(kvID, roleNames)
+						outputResources = append(outputResources, roleAssignments...)
+						deps = append(deps, raDeps...)
+
+						// Create Per-Pod SecretProviderClass for the selected volume
+						// csiobjectspec must be generated when volume is updated.
+						objectSpec, err := handlers.GetMapValue[string](properties.ComputedValues, azvolrenderer.SPCVolumeObjectSpecKey)
+						if err != nil {
+							return []rpv1.OutputResource{}, nil, err
+						}
+
+						secretProvider, err := azrenderer.MakeKeyVaultSecretProviderClass(applicationName, spcName, vol, objectSpec, &options.Environment)
+						if err != nil {
+							return []rpv1.OutputResource{}, nil, err
+						}
+						outputResources = append(outputResources, *secretProvider)
+						deps = append(deps, rpv1.LocalIDSecretProviderClass)
+					}
+
+					// Create volume spec which associated with secretProviderClass.
+					volumeSpec, volumeMountSpec, err = azrenderer.MakeKeyVaultVolumeSpec(volumeName, volumeProperties.Persistent.MountPath, spcName)
+					if err != nil {
+						return []rpv1.OutputResource{}, nil, fmt.Errorf("unable to create secretstore volume spec for volume: %s - %w", volumeName, err)
+					}
+				default:
+					return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Unsupported volume kind: %s for volume: %s. Supported kinds are: %v", vol.Properties.Kind, volumeName, GetSupportedKinds()))
 				}
-			default:
-				return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Unsupported volume kind: %s for volume: %s. Supported kinds are: %v", vol.Properties.Kind, volumeName, GetSupportedKinds()))
-			}
 
-			// Add the volume mount to the Container spec
-			container.VolumeMounts = append(container.VolumeMounts, volumeMountSpec)
-			// Add the volume to the list of volumes to be added to the Volumes spec
-			volumes = append(volumes, volumeSpec)
+				// Add the volume mount to the Container spec
+				workload.container.VolumeMounts = append(workload.container.VolumeMounts, volumeMountSpec)
+				if added {
+					continue
+				}
 
-			// Add azurestorageaccountname and azurestorageaccountkey as secrets
-			// These will be added as key-value pairs to the kubernetes secret created for the container
-			// The key values are as per: https://docs.microsoft.com/en-us/azure/aks/azure-files-volume
-			for key, value := range properties.ComputedValues {
-				if value.(string) == rpv1.LocalIDAzureFileShareStorageAccount {
-					// The storage account was not created when the computed value was rendered
-					// Lookup the actual storage account name from the local id
-					id := properties.OutputResources[value.(string)]
-					value = id.Name()
+				// Add the volume to the list of volumes to be added to the Volumes spec
+				volumes = append(volumes, volumeSpec)
+
+				// Add azurestorageaccountname and azurestorageaccountkey as secrets
+				// These will be added as key-value pairs to the kubernetes secret created for the container
+				// The key values are as per: https://docs.microsoft.com/en-us/azure/aks/azure-files-volume
+				for key, value := range properties.ComputedValues {
+					if value.(string) == rpv1.LocalIDAzureFileShareStorageAccount {
+						// The storage account was not created when the computed value was rendered
+						// Lookup the actual storage account name from the local id
+						id := properties.OutputResources[value.(string)]
+						value = id.Name()
+					}
+					secretData[key] = []byte(value.(string))
 				}
-				secretData[key] = []byte(value.(string))
+			default:
+				return []rpv1.OutputResource{}, secretData, v1.NewClientErrInvalidRequest(fmt.Sprintf("Only ephemeral or persistent volumes are supported. Got kind: %v", volumeProperties.Kind))
 			}
-		default:
-			return []rpv1.OutputResource{}, secretData, v1.NewClientErrInvalidRequest(fmt.Sprintf("Only ephemeral or persistent volumes are supported. Got kind: %v", volumeProperties.Kind))
 		}
 	}
 
@@ -634,6 +697,12 @@ func (r Renderer) makeDeployment(
 	})
 
 	podSpec.Volumes = append(podSpec.Volumes, volumes...)
+	for _, c := range initContainers {
+		podSpec.InitContainers = upsertContainer(podSpec.InitContainers, c)
+	}
+	for _, c := range sidecars {
+		podSpec.Containers = upsertContainer(podSpec.Containers, c)
+	}
 
 	// See: https://github.com/kubernetes/kubernetes/issues/92226 and
 	// 		https://github.com/radius-project/radius/issues/3002
@@ -673,6 +742,93 @@ func (r Renderer) makeDeployment(
 	return outputResources, secretData, nil
 }
 
+// makeAdditionalContainer creates an init container or a sidecar of the pod from the container definition. The ports
+// of an additional container are only exposed in the pod, they are not provided to routes or services. The environment
+// variables and volume mounts are added by makeDeployment.
+func (r Renderer) makeAdditionalContainer(base corev1.Container, properties datamodel.Container, defaults datamodel.ContainerResourceRequirements) (corev1.Container, error) {
+	container := base
+	container.Image = properties.Image
+	container.Command = properties.Command
+	container.Args = properties.Args
+	container.WorkingDir = properties.WorkingDir
+
+	portNames := []string{}
+	for name := range properties.Ports {
+		portNames = append(portNames, name)
+	}
+	sort.Strings(portNames)
+	for _, name := range portNames {
+		container.Ports = append(container.Ports, corev1.ContainerPort{
+			ContainerPort: properties.Ports[name].ContainerPort,
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
+
+	if properties.ImagePullPolicy != "" {
+		container.ImagePullPolicy = corev1.PullPolicy(properties.ImagePullPolicy)
+	}
+
+	var err error
+	if !properties.ReadinessProbe.IsEmpty() {
+		container.ReadinessProbe, err = r.makeHealthProbe(properties.ReadinessProbe)
+		if err != nil {
+			return corev1.Container{}, fmt.Errorf("readiness probe encountered errors: %w ", err)
+		}
+	}
+	if !properties.LivenessProbe.IsEmpty() {
+		container.LivenessProbe, err = r.makeHealthProbe(properties.LivenessProbe)
+		if err != nil {
+			return corev1.Container{}, fmt.Errorf("liveness probe encountered errors: %w ", err)
+		}
+	}
+
+	resources := applyContainerResourceDefaults(properties.Resources, defaults)
+	if err := makeResourceRequirements(&container.Resources, resources); err != nil {
+		return corev1.Container{}, err
+	}
+
+	return container, nil
+}
+
+// findContainer returns the container with the given name, or a new container with the name if there is none.
+func findContainer(containers []corev1.Container, name string) corev1.Container {
+	for _, c := range containers {
+		if strings.EqualFold(c.Name, name) {
+			return c
+		}
+	}
+	return corev1.Container{Name: name}
+}
+
+// upsertContainer replaces the container with the same name, or appends the container if there is none.
+func upsertContainer(containers []corev1.Container, container corev1.Container) []corev1.Container {
+	for i, c := range containers {
+		if strings.EqualFold(c.Name, container.Name) {
+			containers[i] = container
+			return containers
+		}
+	}
+	return append(containers, container)
+}
+
+// makeEnvVars merges the environment variables of a container into the environment variables of the connections, and
+// returns them sorted by name.
+func makeEnvVars(connectionEnv map[string]corev1.EnvVar, values map[string]string) []corev1.EnvVar {
+	env := map[string]corev1.EnvVar{}
+	for k, v := range connectionEnv {
+		env[k] = v
+	}
+	for k, v := range values {
+		env[k] = corev1.EnvVar{Name: k, Value: v}
+	}
+
+	result := []corev1.EnvVar{}
+	for _, key := range getSortedKeys(env) {
+		result = append(result, env[key])
+	}
+	return result
+}
+
 func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, applicationName string, dependencies map[string]renderers.RendererDependency) (map[string]corev1.EnvVar, map[string][]byte, error) {
 	env := map[string]corev1.EnvVar{}
 	secretData := map[string][]byte{}
@@ -739,6 +895,61 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, applicationN
 	return env, secretData, nil
 }
 
+// applyContainerResourceDefaults returns the compute resources of the container with the defaults of the environment
+// applied. The default of a resource is only applied when the container specifies neither a request nor a limit for it.
+func applyContainerResourceDefaults(resources, defaults datamodel.ContainerResourceRequirements) datamodel.ContainerResourceRequirements {
+	if resources.Requests.CPU == "" && resources.Limits.CPU == "" {
+		resources.Requests.CPU = defaults.Requests.CPU
+		resources.Limits.CPU = defaults.Limits.CPU
+	}
+	if resources.Requests.Memory == "" && resources.Limits.Memory == "" {
+		resources.Requests.Memory = defaults.Requests.Memory
+		resources.Limits.Memory = defaults.Limits.Memory
+	}
+	if resources.Requests.EphemeralStorage == "" && resources.Limits.EphemeralStorage == "" {
+		resources.Requests.EphemeralStorage = defaults.Requests.EphemeralStorage
+		resources.Limits.EphemeralStorage = defaults.Limits.EphemeralStorage
+	}
+	return resources
+}
+
+// makeResourceRequirements sets the requests and limits of the container. The requests and limits that are not
+// specified are left unchanged so that the values of the base manifest are preserved.
+func makeResourceRequirements(requirements *corev1.ResourceRequirements, resources datamodel.ContainerResourceRequirements) error {
+	var err error
+	requirements.Requests, err = mergeResourceList(requirements.Requests, resources.Requests)
+	if err != nil {
+		return err
+	}
+	requirements.Limits, err = mergeResourceList(requirements.Limits, resources.Limits)
+	return err
+}
+
+func mergeResourceList(list corev1.ResourceList, quantities datamodel.ContainerResourceQuantities) (corev1.ResourceList, error) {
+	values := map[corev1.ResourceName]string{
+		corev1.ResourceCPU:              quantities.CPU,
+		corev1.ResourceMemory:           quantities.Memory,
+		corev1.ResourceEphemeralStorage: quantities.EphemeralStorage,
+	}
+
+	for name, value := range values {
+		if value == "" {
+			continue
+		}
+
+		quantity, err := apiresource.ParseQuantity(value)
+		if err != nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("%q is not a valid quantity for %s.", value, name))
+		}
+		if list == nil {
+			list = corev1.ResourceList{}
+		}
+		list[name] = quantity
+	}
+
+	return list, nil
+}
+
 func (r Renderer) makeHealthProbe(p datamodel.HealthProbeProperties) (*corev1.Probe, error) {
 	probeSpec := corev1.Probe{}
 
@@ -41,6 +41,7 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -1663,6 +1664,165 @@ func Test_Render_ImagePullPolicySpecified(t *testing.T) {
 	})
 }
 
+func Test_Render_Resources(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Resources: datamodel.ContainerResourceRequirements{
+				Requests: datamodel.ContainerResourceQuantities{CPU: "250m"},
+				Limits:   datamodel.ContainerResourceQuantities{CPU: "1", EphemeralStorage: "2Gi"},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{}
+	environment := renderers.EnvironmentOptions{
+		ContainerResourceDefaults: datamodel.ContainerResourceRequirements{
+			Requests: datamodel.ContainerResourceQuantities{CPU: "100m", Memory: "128Mi"},
+			Limits:   datamodel.ContainerResourceQuantities{Memory: "512Mi", EphemeralStorage: "1Gi"},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environment})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Len(t, deployment.Spec.Template.Spec.Containers, 1)
+
+	// The defaults are only applied to the memory since the container specifies the cpu and the ephemeral storage.
+	expected := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    apiresource.MustParse("250m"),
+			corev1.ResourceMemory: apiresource.MustParse("128Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:              apiresource.MustParse("1"),
+			corev1.ResourceMemory:           apiresource.MustParse("512Mi"),
+			corev1.ResourceEphemeralStorage: apiresource.MustParse("2Gi"),
+		},
+	}
+	require.Equal(t, expected, deployment.Spec.Template.Spec.Containers[0].Resources)
+}
+
+func Test_Render_InvalidResources(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Resources: datamodel.ContainerResourceRequirements{
+				Limits: datamodel.ContainerResourceQuantities{Memory: "lots"},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	_, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.Equal(t, apiv1.NewClientErrInvalidRequest("\"lots\" is not a valid quantity for memory."), err)
+}
+
+func Test_Render_InitContainersAndSidecars(t *testing.T) {
+	configVolume := func(mountPath string) map[string]datamodel.VolumeProperties {
+		return map[string]datamodel.VolumeProperties{
+			"config": {
+				Kind: datamodel.Ephemeral,
+				Ephemeral: &datamodel.EphemeralVolume{
+					VolumeBase:   datamodel.VolumeBase{MountPath: mountPath},
+					ManagedStore: datamodel.ManagedStoreDisk,
+				},
+			},
+		}
+	}
+
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"A": {
+				Source: makeRadiusResourceID(t, "SomeProvider/ResourceType", "A").String(),
+			},
+		},
+		Container: datamodel.Container{
+			Image:   "someimage:latest",
+			Volumes: configVolume("/etc/app"),
+		},
+		InitContainers: []datamodel.Container{
+			{
+				Image:   "migrations:latest",
+				Command: []string{"migrate"},
+				Volumes: configVolume("/config"),
+			},
+		},
+		Sidecars: []datamodel.Container{
+			{
+				Image: "fluent-bit:latest",
+				Env:   map[string]string{"LOG_LEVEL": "info"},
+				Ports: map[string]datamodel.ContainerPort{"metrics": {ContainerPort: 2020}},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{
+		(makeRadiusResourceID(t, "SomeProvider/ResourceType", "A").String()): {
+			ResourceID:     makeRadiusResourceID(t, "SomeProvider/ResourceType", "A"),
+			ComputedValues: map[string]any{"host": "a.svc"},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	podSpec := deployment.Spec.Template.Spec
+
+	connectionEnv := corev1.EnvVar{
+		Name: "CONNECTION_A_HOST",
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: resourceName},
+				Key:                  "CONNECTION_A_HOST",
+			},
+		},
+	}
+
+	// The volume shared by the container and the init container is added to the pod once.
+	require.Len(t, podSpec.Volumes, 1)
+	require.Equal(t, "config", podSpec.Volumes[0].Name)
+
+	require.Len(t, podSpec.InitContainers, 1)
+	initContainer := podSpec.InitContainers[0]
+	require.Equal(t, "init-0", initContainer.Name)
+	require.Equal(t, "migrations:latest", initContainer.Image)
+	require.Equal(t, []string{"migrate"}, initContainer.Command)
+	require.Equal(t, []corev1.EnvVar{connectionEnv}, initContainer.Env)
+	require.Equal(t, []corev1.VolumeMount{{Name: "config", MountPath: "/config"}}, initContainer.VolumeMounts)
+
+	require.Len(t, podSpec.Containers, 2)
+	require.Equal(t, resourceName, podSpec.Containers[0].Name)
+	require.Equal(t, []corev1.EnvVar{connectionEnv}, podSpec.Containers[0].Env)
+	require.Equal(t, []corev1.VolumeMount{{Name: "config", MountPath: "/etc/app"}}, podSpec.Containers[0].VolumeMounts)
+
+	sidecar := podSpec.Containers[1]
+	require.Equal(t, "sidecar-0", sidecar.Name)
+	require.Equal(t, "fluent-bit:latest", sidecar.Image)
+	require.Equal(t, []corev1.EnvVar{connectionEnv, {Name: "LOG_LEVEL", Value: "info"}}, sidecar.Env)
+	require.Equal(t, []corev1.ContainerPort{{ContainerPort: 2020, Protocol: corev1.ProtocolTCP}}, sidecar.Ports)
+	require.Empty(t, sidecar.VolumeMounts)
+}
+
 func Test_Render_StrategicPatchMerge(t *testing.T) {
 	const contianerPatchObject = `
 {
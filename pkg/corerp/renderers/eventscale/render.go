@@ -0,0 +1,440 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventscale
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	dapr_dm "github.com/radius-project/radius/pkg/daprrp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
+	pr_renderers "github.com/radius-project/radius/pkg/portableresources/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// KEDAAPIVersion is the API version of the KEDA resources.
+	KEDAAPIVersion = "keda.sh/v1alpha1"
+
+	// DefaultTargetLength is the target number of pending messages for each replica when the trigger does not specify one.
+	DefaultTargetLength = 5
+)
+
+// Renderer is the renderers.Renderer implementation for the eventscale extension.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// trigger is a KEDA trigger derived from a connection. Secrets holds the authentication parameters of the trigger, which
+// are read by KEDA from a Secret through a TriggerAuthentication.
+type trigger struct {
+	Connection string
+	Type       string
+	Metadata   map[string]string
+	Secrets    map[string]string
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render checks if the DataModelInterface is a ContainerResource and if so, checks for an EventScaling extension. If it
+// is found, a KEDA ScaledObject targeting the Deployment of the container is added to the output resources, with a
+// trigger for each connection to a RabbitMQ queue or a Dapr pub/sub broker. The replica count of the Deployment is left
+// to KEDA.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	extension := datamodel.FindExtension(resource.Properties.Extensions, datamodel.EventScaling)
+	if extension == nil || extension.EventScaling == nil {
+		return output, nil
+	}
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	if deployment == nil {
+		// The container is not rendered as a Deployment, so there is nothing to scale.
+		return output, nil
+	}
+
+	triggers, err := makeTriggers(resource, extension.EventScaling, options.Dependencies)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	scaledObject, scaledObjectDeps := makeScaledObject(&deployment.ObjectMeta, extension.EventScaling, triggers)
+
+	secretData := map[string][]byte{}
+	for _, t := range triggers {
+		if len(t.Secrets) == 0 {
+			continue
+		}
+
+		auth := makeTriggerAuthentication(&deployment.ObjectMeta, t)
+		authOutput := rpv1.NewKubernetesOutputResource(rpv1.NewLocalID(rpv1.LocalIDTriggerAuthenticationPrefix, t.Connection), auth, metav1.ObjectMeta{Name: auth.GetName(), Namespace: auth.GetNamespace()})
+		authOutput.CreateResource.Dependencies = []string{rpv1.LocalIDScaledObjectSecret}
+		output.Resources = append(output.Resources, authOutput)
+
+		for parameter, value := range t.Secrets {
+			secretData[secretKey(t.Connection, parameter)] = []byte(value)
+		}
+	}
+
+	if len(secretData) > 0 {
+		secret := makeSecret(&deployment.ObjectMeta, secretData)
+		output.Resources = append(output.Resources, rpv1.NewKubernetesOutputResource(rpv1.LocalIDScaledObjectSecret, secret, secret.ObjectMeta))
+	}
+
+	// The replica count is omitted so that applying the Deployment does not override the count set by KEDA.
+	deployment.Spec.Replicas = nil
+	if deployment.Annotations == nil {
+		deployment.Annotations = map[string]string{}
+	}
+	deployment.Annotations[kubernetes.AnnotationAutoscaled] = "true"
+
+	scaledObjectOutput := rpv1.NewKubernetesOutputResource(rpv1.LocalIDScaledObject, scaledObject, metav1.ObjectMeta{Name: scaledObject.GetName(), Namespace: scaledObject.GetNamespace()})
+	scaledObjectOutput.CreateResource.Dependencies = append([]string{rpv1.LocalIDDeployment}, scaledObjectDeps...)
+	output.Resources = append(output.Resources, scaledObjectOutput)
+
+	return output, nil
+}
+
+// makeTriggers derives the triggers from the connections named by the extension, or from all connections to supported
+// resources if the extension does not name any.
+func makeTriggers(resource *datamodel.ContainerResource, ext *datamodel.EventScalingExtension, dependencies map[string]renderers.RendererDependency) ([]trigger, error) {
+	explicit := len(ext.Triggers) > 0
+	names := sortedKeys(resource.Properties.Connections)
+	if explicit {
+		names = sortedKeys(ext.Triggers)
+	}
+
+	triggers := []trigger{}
+	for _, name := range names {
+		connection, ok := resource.Properties.Connections[name]
+		if !ok {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("the trigger %q of the eventScaling extension does not match a connection of the container", name))
+		}
+
+		dependency, ok := dependencies[connection.Source]
+		if !ok {
+			if explicit {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("the connection %q cannot be used as a trigger of the eventScaling extension: the source %q is not a Radius resource", name, connection.Source))
+			}
+			continue
+		}
+
+		config := ext.Triggers[name]
+		targetLength := strconv.Itoa(DefaultTargetLength)
+		if config.TargetLength != nil {
+			targetLength = strconv.Itoa(int(*config.TargetLength))
+		}
+
+		var t *trigger
+		var err error
+		switch dep := dependency.Resource.(type) {
+		case *msg_dm.RabbitMQQueue:
+			t, err = makeRabbitMQTrigger(dependency.ComputedValues, targetLength)
+		case *dapr_dm.DaprPubSubBroker:
+			t, err = makeDaprPubSubTrigger(resource, dep, config.Topic, targetLength)
+		default:
+			if explicit {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("the connection %q cannot be used as a trigger of the eventScaling extension: only RabbitMQ queues and Dapr pub/sub brokers are supported", name))
+			}
+			continue
+		}
+		if err != nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("the connection %q cannot be used as a trigger of the eventScaling extension: %s", name, err.Error()))
+		}
+
+		t.Connection = name
+		triggers = append(triggers, *t)
+	}
+
+	if len(triggers) == 0 {
+		return nil, v1.NewClientErrInvalidRequest("the eventScaling extension requires at least one connection to a RabbitMQ queue or a Dapr pub/sub broker")
+	}
+
+	return triggers, nil
+}
+
+// makeRabbitMQTrigger creates a trigger on the length of the queue of a RabbitMQ queue resource.
+func makeRabbitMQTrigger(values map[string]any, targetLength string) (*trigger, error) {
+	queue, _ := values["queue"].(string)
+	if queue == "" {
+		return nil, fmt.Errorf("the queue name is not known")
+	}
+	uri, _ := values[pr_renderers.URI].(string)
+	if uri == "" {
+		return nil, fmt.Errorf("the connection string of the queue is not known")
+	}
+
+	return &trigger{
+		Type: "rabbitmq",
+		Metadata: map[string]string{
+			"mode":      "QueueLength",
+			"queueName": queue,
+			"value":     targetLength,
+		},
+		Secrets: map[string]string{"host": uri},
+	}, nil
+}
+
+// makeDaprPubSubTrigger creates a trigger on the pending messages of a topic of a Dapr pub/sub broker. The subscriber
+// queue or consumer group is named by Dapr after the app ID of the container, unless the broker sets a consumer ID.
+func makeDaprPubSubTrigger(resource *datamodel.ContainerResource, broker *dapr_dm.DaprPubSubBroker, topic string, targetLength string) (*trigger, error) {
+	if topic == "" {
+		return nil, fmt.Errorf("a topic is required for Dapr pub/sub brokers")
+	}
+
+	metadata, err := literalMetadata(broker.Properties.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	consumerID := metadata["consumerID"]
+	if consumerID == "" {
+		sidecar := datamodel.FindExtension(resource.Properties.Extensions, datamodel.DaprSidecar)
+		if sidecar == nil || sidecar.DaprSidecar == nil || sidecar.DaprSidecar.AppID == "" {
+			return nil, fmt.Errorf("the daprSidecar extension with an appId is required to subscribe to Dapr pub/sub brokers")
+		}
+		consumerID = sidecar.DaprSidecar.AppID
+	}
+
+	switch broker.Properties.Type {
+	case "pubsub.rabbitmq":
+		connectionString := metadata["connectionString"]
+		if connectionString == "" {
+			connectionString = metadata["host"]
+		}
+		if connectionString == "" {
+			return nil, fmt.Errorf("the connectionString metadata of the Dapr pub/sub broker is required")
+		}
+
+		return &trigger{
+			Type: "rabbitmq",
+			Metadata: map[string]string{
+				"mode":      "QueueLength",
+				"queueName": consumerID + "-" + topic,
+				"value":     targetLength,
+			},
+			Secrets: map[string]string{"host": connectionString},
+		}, nil
+
+	case "pubsub.redis":
+		if metadata["redisHost"] == "" {
+			return nil, fmt.Errorf("the redisHost metadata of the Dapr pub/sub broker is required")
+		}
+
+		t := &trigger{
+			Type: "redis-streams",
+			Metadata: map[string]string{
+				"address":             metadata["redisHost"],
+				"stream":              topic,
+				"consumerGroup":       consumerID,
+				"pendingEntriesCount": targetLength,
+			},
+		}
+		if metadata["enableTLS"] == "true" {
+			t.Metadata["enableTLS"] = "true"
+		}
+		if metadata["redisPassword"] != "" {
+			t.Secrets = map[string]string{"password": metadata["redisPassword"]}
+		}
+		return t, nil
+
+	case "pubsub.kafka":
+		if metadata["brokers"] == "" {
+			return nil, fmt.Errorf("the brokers metadata of the Dapr pub/sub broker is required")
+		}
+
+		consumerGroup := metadata["consumerGroup"]
+		if consumerGroup == "" {
+			consumerGroup = consumerID
+		}
+		return &trigger{
+			Type: "kafka",
+			Metadata: map[string]string{
+				"bootstrapServers": metadata["brokers"],
+				"consumerGroup":    consumerGroup,
+				"topic":            topic,
+				"lagThreshold":     targetLength,
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("the Dapr pub/sub broker type %q is not supported", broker.Properties.Type)
+	}
+}
+
+// literalMetadata converts the metadata of a Dapr component to strings. Values that are not literals, such as secret
+// references, are not supported since they cannot be read by KEDA.
+func literalMetadata(metadata map[string]any) (map[string]string, error) {
+	result := map[string]string{}
+	for key, value := range metadata {
+		switch v := value.(type) {
+		case string:
+			result[key] = v
+		case bool, float64, int, int32, int64:
+			result[key] = fmt.Sprint(v)
+		default:
+			return nil, fmt.Errorf("the metadata %q of the Dapr pub/sub broker must be a literal value", key)
+		}
+	}
+	return result, nil
+}
+
+func makeScaledObject(deployment *metav1.ObjectMeta, ext *datamodel.EventScalingExtension, triggers []trigger) (*unstructured.Unstructured, []string) {
+	minReplicas := int64(0)
+	if ext.MinReplicas != nil {
+		minReplicas = int64(*ext.MinReplicas)
+	}
+
+	deps := []string{}
+	kedaTriggers := []any{}
+	for _, t := range triggers {
+		metadata := map[string]any{}
+		for k, v := range t.Metadata {
+			metadata[k] = v
+		}
+
+		kedaTrigger := map[string]any{
+			"type":     t.Type,
+			"name":     t.Connection,
+			"metadata": metadata,
+		}
+		if len(t.Secrets) > 0 {
+			kedaTrigger["authenticationRef"] = map[string]any{"name": triggerAuthenticationName(deployment, t)}
+			deps = append(deps, rpv1.NewLocalID(rpv1.LocalIDTriggerAuthenticationPrefix, t.Connection))
+		}
+		kedaTriggers = append(kedaTriggers, kedaTrigger)
+	}
+
+	spec := map[string]any{
+		"scaleTargetRef": map[string]any{
+			"name": deployment.Name,
+		},
+		"minReplicaCount": minReplicas,
+		"maxReplicaCount": int64(ext.MaxReplicas),
+		"triggers":        kedaTriggers,
+	}
+	if ext.PollingInterval != nil {
+		spec["pollingInterval"] = int64(*ext.PollingInterval)
+	}
+	if ext.CooldownPeriod != nil {
+		spec["cooldownPeriod"] = int64(*ext.CooldownPeriod)
+	}
+
+	scaledObject := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	scaledObject.SetAPIVersion(KEDAAPIVersion)
+	scaledObject.SetKind(resources_kubernetes.KindScaledObject)
+	scaledObject.SetName(deployment.Name)
+	scaledObject.SetNamespace(deployment.Namespace)
+	scaledObject.SetLabels(maps.Clone(deployment.Labels))
+
+	return scaledObject, deps
+}
+
+func makeTriggerAuthentication(deployment *metav1.ObjectMeta, t trigger) *unstructured.Unstructured {
+	refs := []any{}
+	for _, parameter := range sortedKeys(t.Secrets) {
+		refs = append(refs, map[string]any{
+			"parameter": parameter,
+			"name":      secretName(deployment),
+			"key":       secretKey(t.Connection, parameter),
+		})
+	}
+
+	auth := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"secretTargetRef": refs,
+		},
+	}}
+	auth.SetAPIVersion(KEDAAPIVersion)
+	auth.SetKind(resources_kubernetes.KindTriggerAuthentication)
+	auth.SetName(triggerAuthenticationName(deployment, t))
+	auth.SetNamespace(deployment.Namespace)
+	auth.SetLabels(maps.Clone(deployment.Labels))
+
+	return auth
+}
+
+func makeSecret(deployment *metav1.ObjectMeta, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName(deployment),
+			Namespace: deployment.Namespace,
+			Labels:    maps.Clone(deployment.Labels),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+}
+
+func secretName(deployment *metav1.ObjectMeta) string {
+	return kubernetes.NormalizeResourceName(deployment.Name + "-scaler")
+}
+
+func secretKey(connection string, parameter string) string {
+	return connection + "-" + parameter
+}
+
+func triggerAuthenticationName(deployment *metav1.ObjectMeta, t trigger) string {
+	// Connection names are not restricted to the characters allowed in object names.
+	connection := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '-'
+	}, t.Connection)
+	return kubernetes.NormalizeResourceName(deployment.Name + "-" + connection)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+const (
+	// GatewayAPIGroup is the API group of the Kubernetes Gateway API.
+	GatewayAPIGroup = "gateway.networking.k8s.io"
+	// GatewayAPIVersion is the API version of the Gateway, HTTPRoute and GRPCRoute resources.
+	GatewayAPIVersion = GatewayAPIGroup + "/v1"
+	// GatewayAPIReferenceGrantVersion is the API version of the ReferenceGrant resource.
+	GatewayAPIReferenceGrantVersion = GatewayAPIGroup + "/v1beta1"
+
+	// grpcScheme is the URL scheme of route destinations that are routed with a GRPCRoute.
+	grpcScheme = "grpc"
+)
+
+// MakeGatewayAPIResources validates the Gateway resource and its dependencies, and creates the Kubernetes Gateway API
+// Gateway, HTTPRoute, GRPCRoute and ReferenceGrant resources that implement it.
+func MakeGatewayAPIResources(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, hostname string) ([]rpv1.OutputResource, error) {
+	if len(gateway.Properties.Routes) < 1 {
+		return nil, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
+	}
+
+	className := options.Environment.Gateway.ClassName
+	if className == "" {
+		return nil, v1.NewClientErrInvalidRequest("the environment must specify a gatewayClassName when the gateway implementation is gatewayAPI")
+	}
+
+	gatewayName := kubernetes.NormalizeResourceName(gateway.Name)
+	namespace := options.Environment.Namespace
+	labels := renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName())
+	annotations := renderers.GetAnnotations(options)
+
+	listener := map[string]any{
+		"name":     "http",
+		"protocol": "HTTP",
+		"port":     int64(80),
+	}
+
+	outputResources := []rpv1.OutputResource{}
+	gatewayDependencies := []string{}
+
+	tls := gateway.Properties.TLS
+	if tls != nil {
+		if tls.SSLPassthrough {
+			return nil, v1.NewClientErrInvalidRequest("sslPassthrough is not supported when the gateway implementation is gatewayAPI")
+		}
+
+		if tls.MinimumProtocolVersion == datamodel.TLSMinVersion13 {
+			return nil, v1.NewClientErrInvalidRequest("minimumProtocolVersion 1.3 is not supported when the gateway implementation is gatewayAPI")
+		}
+
+		if tls.CertificateFrom != "" {
+			secretName, secretNamespace, err := getCertificateSecret(options.Dependencies, tls.CertificateFrom)
+			if err != nil {
+				return nil, err
+			}
+
+			certificateRef := map[string]any{
+				"kind": resources_kubernetes.KindSecret,
+				"name": secretName,
+			}
+
+			// A Gateway can only reference a secret in another namespace when a ReferenceGrant in that
+			// namespace allows it.
+			if secretNamespace != namespace {
+				certificateRef["namespace"] = secretNamespace
+
+				referenceGrant := makeReferenceGrant(namespace, gatewayName, secretNamespace, secretName, labels)
+				outputResources = append(outputResources, rpv1.NewKubernetesOutputResource(rpv1.LocalIDGatewayReferenceGrant, referenceGrant, metav1.ObjectMeta{Name: referenceGrant.GetName(), Namespace: referenceGrant.GetNamespace()}))
+				gatewayDependencies = append(gatewayDependencies, rpv1.LocalIDGatewayReferenceGrant)
+			}
+
+			listener = map[string]any{
+				"name":     "https",
+				"protocol": "HTTPS",
+				"port":     int64(443),
+				"tls": map[string]any{
+					"mode":            "Terminate",
+					"certificateRefs": []any{certificateRef},
+				},
+			}
+		}
+	}
+
+	// Gateway API listeners and routes only accept DNS names as hostnames.
+	hostnames := []any{}
+	if hostname != "" && net.ParseIP(hostname) == nil {
+		listener["hostname"] = hostname
+		hostnames = append(hostnames, hostname)
+	}
+
+	gatewayObject := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"gatewayClassName": className,
+			"listeners":        []any{listener},
+		},
+	}}
+	gatewayObject.SetAPIVersion(GatewayAPIVersion)
+	gatewayObject.SetKind(resources_kubernetes.KindGatewayAPIGateway)
+	gatewayObject.SetName(gatewayName)
+	gatewayObject.SetNamespace(namespace)
+	gatewayObject.SetLabels(maps.Clone(labels))
+	gatewayObject.SetAnnotations(maps.Clone(annotations))
+
+	gatewayOutputResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, gatewayObject, metav1.ObjectMeta{Name: gatewayName, Namespace: namespace})
+	gatewayOutputResource.CreateResource.Dependencies = gatewayDependencies
+	outputResources = append(outputResources, gatewayOutputResource)
+
+	httpRules := []any{}
+	grpcRules := []any{}
+	for _, route := range gateway.Properties.Routes {
+		routeName, err := getRouteName(&route)
+		if err != nil {
+			return nil, err
+		}
+
+		port := renderers.DefaultPort
+		scheme := ""
+		if isURL(route.Destination) {
+			scheme, _, port, err = parseURL(route.Destination)
+			if err != nil {
+				return nil, v1.NewClientErrInvalidRequest(err.Error())
+			}
+		} else {
+			routePort, ok := options.Dependencies[route.Destination].ComputedValues["port"].(float64)
+			if ok {
+				port = int32(routePort)
+			}
+		}
+
+		backendRefs := []any{
+			map[string]any{
+				"name": kubernetes.NormalizeResourceName(routeName),
+				"port": int64(port),
+			},
+		}
+
+		if scheme == grpcScheme {
+			if route.ReplacePrefix != "" {
+				return nil, v1.NewClientErrInvalidRequest("cannot support `replacePrefix` in routes with a grpc destination")
+			}
+
+			grpcRules = append(grpcRules, makeGRPCRouteRule(route.Path, backendRefs))
+			continue
+		}
+
+		httpRules = append(httpRules, makeHTTPRouteRule(route.Path, route.ReplacePrefix, backendRefs))
+	}
+
+	parentRefs := []any{
+		map[string]any{
+			"name": gatewayName,
+		},
+	}
+
+	if len(httpRules) > 0 {
+		httpRoute := makeRoute(resources_kubernetes.KindGatewayAPIHTTPRoute, gatewayName, namespace, parentRefs, hostnames, httpRules, labels, annotations)
+		httpRouteOutputResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDGatewayHTTPRoute, httpRoute, metav1.ObjectMeta{Name: gatewayName, Namespace: namespace})
+		httpRouteOutputResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway}
+		outputResources = append(outputResources, httpRouteOutputResource)
+	}
+
+	if len(grpcRules) > 0 {
+		grpcRoute := makeRoute(resources_kubernetes.KindGatewayAPIGRPCRoute, gatewayName, namespace, parentRefs, hostnames, grpcRules, labels, annotations)
+		grpcRouteOutputResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDGatewayGRPCRoute, grpcRoute, metav1.ObjectMeta{Name: gatewayName, Namespace: namespace})
+		grpcRouteOutputResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway}
+		outputResources = append(outputResources, grpcRouteOutputResource)
+	}
+
+	return outputResources, nil
+}
+
+// makeGatewayAPIURLReference creates the computed value of the URL of a Gateway API gateway from the address of the
+// Gateway returned when its first route is deployed. The URL is "unknown" when the Gateway has no address.
+func makeGatewayAPIURLReference(outputResources []rpv1.OutputResource, isHttps bool) rpv1.ComputedValueReference {
+	localID := rpv1.LocalIDGatewayHTTPRoute
+	for _, outputResource := range outputResources {
+		if outputResource.LocalID == rpv1.LocalIDGatewayHTTPRoute || outputResource.LocalID == rpv1.LocalIDGatewayGRPCRoute {
+			localID = outputResource.LocalID
+			break
+		}
+	}
+
+	return rpv1.ComputedValueReference{
+		LocalID:           localID,
+		PropertyReference: handlers.GatewayAddressKey,
+		Transformer: func(_ v1.DataModelInterface, cv map[string]any) error {
+			address, _ := cv["url"].(string)
+			if address == "" {
+				cv["url"] = "unknown"
+				return nil
+			}
+
+			// IPv6 addresses are enclosed in brackets in URLs.
+			if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+				address = "[" + address + "]"
+			}
+			cv["url"] = getPublicEndpoint(address, "", isHttps)
+			return nil
+		},
+	}
+}
+
+// makeHTTPRouteRule creates an HTTPRoute rule that matches the path prefix and optionally rewrites it.
+func makeHTTPRouteRule(path string, replacePrefix string, backendRefs []any) map[string]any {
+	if path == "" {
+		path = "/"
+	}
+
+	rule := map[string]any{
+		"matches": []any{
+			map[string]any{
+				"path": map[string]any{
+					"type":  "PathPrefix",
+					"value": path,
+				},
+			},
+		},
+		"backendRefs": backendRefs,
+	}
+
+	if replacePrefix != "" {
+		rule["filters"] = []any{
+			map[string]any{
+				"type": "URLRewrite",
+				"urlRewrite": map[string]any{
+					"path": map[string]any{
+						"type":               "ReplacePrefixMatch",
+						"replacePrefixMatch": replacePrefix,
+					},
+				},
+			},
+		}
+	}
+
+	return rule
+}
+
+// makeGRPCRouteRule creates a GRPCRoute rule. The path of the route is interpreted as "/<service>/<method>",
+// where both segments are optional.
+func makeGRPCRouteRule(path string, backendRefs []any) map[string]any {
+	rule := map[string]any{
+		"backendRefs": backendRefs,
+	}
+
+	service, method, _ := strings.Cut(strings.Trim(path, "/"), "/")
+	if service == "" {
+		return rule
+	}
+
+	match := map[string]any{
+		"type":    "Exact",
+		"service": service,
+	}
+	if method != "" {
+		match["method"] = method
+	}
+
+	rule["matches"] = []any{
+		map[string]any{
+			"method": match,
+		},
+	}
+
+	return rule
+}
+
+// makeRoute creates an HTTPRoute or GRPCRoute resource attached to the Gateway.
+func makeRoute(kind string, name string, namespace string, parentRefs []any, hostnames []any, rules []any, labels map[string]string, annotations map[string]string) *unstructured.Unstructured {
+	spec := map[string]any{
+		"parentRefs": parentRefs,
+		"rules":      rules,
+	}
+	if len(hostnames) > 0 {
+		spec["hostnames"] = hostnames
+	}
+
+	route := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	route.SetAPIVersion(GatewayAPIVersion)
+	route.SetKind(kind)
+	route.SetName(name)
+	route.SetNamespace(namespace)
+	route.SetLabels(maps.Clone(labels))
+	route.SetAnnotations(maps.Clone(annotations))
+
+	return route
+}
+
+// makeReferenceGrant creates a ReferenceGrant that allows the Gateway to reference the certificate secret
+// in the secret's namespace.
+func makeReferenceGrant(gatewayNamespace string, gatewayName string, secretNamespace string, secretName string, labels map[string]string) *unstructured.Unstructured {
+	referenceGrant := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"from": []any{
+				map[string]any{
+					"group":     GatewayAPIGroup,
+					"kind":      resources_kubernetes.KindGatewayAPIGateway,
+					"namespace": gatewayNamespace,
+				},
+			},
+			"to": []any{
+				map[string]any{
+					"group": "",
+					"kind":  resources_kubernetes.KindSecret,
+					"name":  secretName,
+				},
+			},
+		},
+	}}
+	referenceGrant.SetAPIVersion(GatewayAPIReferenceGrantVersion)
+	referenceGrant.SetKind(resources_kubernetes.KindGatewayAPIReferenceGrant)
+	referenceGrant.SetName(kubernetes.NormalizeResourceName(fmt.Sprintf("%s-%s", gatewayNamespace, gatewayName)))
+	referenceGrant.SetNamespace(secretNamespace)
+	referenceGrant.SetLabels(maps.Clone(labels))
+
+	return referenceGrant
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+const testGatewayClassName = "test-gateway-class"
+
+func Test_Render_GatewayAPI(t *testing.T) {
+	r := &Renderer{}
+
+	routeDestination := makeRouteResourceID("frontend")
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: routeDestination,
+				Path:        "/",
+			},
+			{
+				Destination:   "http://backend:3000",
+				Path:          "/api",
+				ReplacePrefix: "/",
+			},
+			{
+				Destination: "grpc://greeter:50051",
+				Path:        "/helloworld.Greeter/SayHello",
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{
+		routeDestination: {
+			ComputedValues: map[string]any{
+				"port": float64(8080),
+			},
+		},
+	}
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "http://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	gateway, gatewayOutput := findUnstructured(t, output.Resources, rpv1.LocalIDGateway)
+	require.Equal(t, GatewayAPIVersion, gateway.GetAPIVersion())
+	require.Equal(t, resources_kubernetes.KindGatewayAPIGateway, gateway.GetKind())
+	require.Equal(t, resourceName, gateway.GetName())
+	require.Equal(t, environmentOptions.Namespace, gateway.GetNamespace())
+	require.Equal(t, resources_kubernetes.ResourceTypeGatewayAPIGateway, gatewayOutput.GetResourceType().Type)
+	require.Empty(t, gatewayOutput.CreateResource.Dependencies)
+	require.Equal(t, map[string]any{
+		"gatewayClassName": testGatewayClassName,
+		"listeners": []any{
+			map[string]any{
+				"name":     "http",
+				"protocol": "HTTP",
+				"port":     int64(80),
+				"hostname": expectedHostname,
+			},
+		},
+	}, gateway.Object["spec"])
+
+	parentRefs := []any{
+		map[string]any{
+			"name": resourceName,
+		},
+	}
+
+	httpRoute, httpRouteOutput := findUnstructured(t, output.Resources, rpv1.LocalIDGatewayHTTPRoute)
+	require.Equal(t, resources_kubernetes.KindGatewayAPIHTTPRoute, httpRoute.GetKind())
+	require.Equal(t, resourceName, httpRoute.GetName())
+	require.Equal(t, []string{rpv1.LocalIDGateway}, httpRouteOutput.CreateResource.Dependencies)
+	require.Equal(t, map[string]any{
+		"parentRefs": parentRefs,
+		"hostnames":  []any{expectedHostname},
+		"rules": []any{
+			map[string]any{
+				"matches": []any{
+					map[string]any{
+						"path": map[string]any{"type": "PathPrefix", "value": "/"},
+					},
+				},
+				"backendRefs": []any{
+					map[string]any{"name": "frontend", "port": int64(8080)},
+				},
+			},
+			map[string]any{
+				"matches": []any{
+					map[string]any{
+						"path": map[string]any{"type": "PathPrefix", "value": "/api"},
+					},
+				},
+				"filters": []any{
+					map[string]any{
+						"type": "URLRewrite",
+						"urlRewrite": map[string]any{
+							"path": map[string]any{"type": "ReplacePrefixMatch", "replacePrefixMatch": "/"},
+						},
+					},
+				},
+				"backendRefs": []any{
+					map[string]any{"name": "backend", "port": int64(3000)},
+				},
+			},
+		},
+	}, httpRoute.Object["spec"])
+
+	grpcRoute, grpcRouteOutput := findUnstructured(t, output.Resources, rpv1.LocalIDGatewayGRPCRoute)
+	require.Equal(t, resources_kubernetes.KindGatewayAPIGRPCRoute, grpcRoute.GetKind())
+	require.Equal(t, []string{rpv1.LocalIDGateway}, grpcRouteOutput.CreateResource.Dependencies)
+	require.Equal(t, map[string]any{
+		"parentRefs": parentRefs,
+		"hostnames":  []any{expectedHostname},
+		"rules": []any{
+			map[string]any{
+				"matches": []any{
+					map[string]any{
+						"method": map[string]any{"type": "Exact", "service": "helloworld.Greeter", "method": "SayHello"},
+					},
+				},
+				"backendRefs": []any{
+					map[string]any{"name": "greeter", "port": int64(50051)},
+				},
+			},
+		},
+	}, grpcRoute.Object["spec"])
+}
+
+func Test_Render_GatewayAPI_NoPublicEndpoint(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", "")
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	// The URL is built from the address of the Gateway returned when the HTTPRoute is deployed.
+	url := output.ComputedValues["url"]
+	require.Nil(t, url.Value)
+	require.Equal(t, rpv1.LocalIDGatewayHTTPRoute, url.LocalID)
+	require.Equal(t, handlers.GatewayAddressKey, url.PropertyReference)
+
+	for address, expected := range map[string]string{
+		"":                    "unknown",
+		"10.0.0.1":            "http://10.0.0.1",
+		"fd00::1":             "http://[fd00::1]",
+		"gateway.example.com": "http://gateway.example.com",
+	} {
+		computedValues := map[string]any{"url": address}
+		require.NoError(t, url.Transformer(resource, computedValues))
+		require.Equal(t, expected, computedValues["url"])
+	}
+
+	gateway, _ := findUnstructured(t, output.Resources, rpv1.LocalIDGateway)
+	listeners, _, err := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	require.NoError(t, err)
+	require.NotContains(t, listeners[0], "hostname")
+
+	httpRoute, _ := findUnstructured(t, output.Resources, rpv1.LocalIDGatewayHTTPRoute)
+	require.NotContains(t, httpRoute.Object["spec"], "hostnames")
+}
+
+func Test_Render_GatewayAPI_WithTLSTermination(t *testing.T) {
+	r := &Renderer{}
+
+	secretName := "myapp-tls-secret"
+	secretNamespace := "certificates"
+	secretStoreResourceId := makeSecretStoreResourceID(secretName)
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			MinimumProtocolVersion: datamodel.TLSMinVersion12,
+			CertificateFrom:        secretStoreResourceId,
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+	dependencies := map[string]renderers.RendererDependency{
+		secretStoreResourceId: makeCertificateDependency(t, secretStoreResourceId, secretNamespace, secretName),
+	}
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	gateway, gatewayOutput := findUnstructured(t, output.Resources, rpv1.LocalIDGateway)
+	require.Equal(t, []string{rpv1.LocalIDGatewayReferenceGrant}, gatewayOutput.CreateResource.Dependencies)
+	require.Equal(t, []any{
+		map[string]any{
+			"name":     "https",
+			"protocol": "HTTPS",
+			"port":     int64(443),
+			"hostname": expectedHostname,
+			"tls": map[string]any{
+				"mode": "Terminate",
+				"certificateRefs": []any{
+					map[string]any{"kind": "Secret", "name": secretName, "namespace": secretNamespace},
+				},
+			},
+		},
+	}, gateway.Object["spec"].(map[string]any)["listeners"])
+
+	referenceGrant, referenceGrantOutput := findUnstructured(t, output.Resources, rpv1.LocalIDGatewayReferenceGrant)
+	require.Equal(t, GatewayAPIReferenceGrantVersion, referenceGrant.GetAPIVersion())
+	require.Equal(t, resources_kubernetes.KindGatewayAPIReferenceGrant, referenceGrant.GetKind())
+	require.Equal(t, secretNamespace, referenceGrant.GetNamespace())
+	require.Equal(t, environmentOptions.Namespace+"-"+resourceName, referenceGrant.GetName())
+	require.Equal(t, resources_kubernetes.ResourceTypeGatewayAPIReferenceGrant, referenceGrantOutput.GetResourceType().Type)
+	require.Equal(t, map[string]any{
+		"from": []any{
+			map[string]any{"group": GatewayAPIGroup, "kind": "Gateway", "namespace": environmentOptions.Namespace},
+		},
+		"to": []any{
+			map[string]any{"group": "", "kind": "Secret", "name": secretName},
+		},
+	}, referenceGrant.Object["spec"])
+}
+
+func Test_Render_GatewayAPI_Fails(t *testing.T) {
+	application := "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application"
+
+	tests := []struct {
+		name       string
+		properties datamodel.GatewayProperties
+		className  string
+		err        string
+	}{
+		{
+			name: "missing gateway class name",
+			properties: datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{Application: application},
+				Routes:                  []datamodel.GatewayRoute{{Destination: makeRouteResourceID("frontend")}},
+			},
+			err: "the environment must specify a gatewayClassName when the gateway implementation is gatewayAPI",
+		},
+		{
+			name: "no routes",
+			properties: datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{Application: application},
+			},
+			className: testGatewayClassName,
+			err:       "must have at least one route when declaring a Gateway resource",
+		},
+		{
+			name: "ssl passthrough",
+			properties: datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{Application: application},
+				Routes:                  []datamodel.GatewayRoute{{Destination: makeRouteResourceID("frontend")}},
+				TLS:                     &datamodel.GatewayPropertiesTLS{SSLPassthrough: true},
+			},
+			className: testGatewayClassName,
+			err:       "sslPassthrough is not supported when the gateway implementation is gatewayAPI",
+		},
+		{
+			name: "tls 1.3",
+			properties: datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{Application: application},
+				Routes:                  []datamodel.GatewayRoute{{Destination: makeRouteResourceID("frontend")}},
+				TLS:                     &datamodel.GatewayPropertiesTLS{MinimumProtocolVersion: datamodel.TLSMinVersion13},
+			},
+			className: testGatewayClassName,
+			err:       "minimumProtocolVersion 1.3 is not supported when the gateway implementation is gatewayAPI",
+		},
+		{
+			name: "grpc route with replacePrefix",
+			properties: datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{Application: application},
+				Routes:                  []datamodel.GatewayRoute{{Destination: "grpc://greeter:50051", Path: "/helloworld.Greeter", ReplacePrefix: "/"}},
+			},
+			className: testGatewayClassName,
+			err:       "cannot support `replacePrefix` in routes with a grpc destination",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Renderer{}
+			environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+			environmentOptions.Gateway.ClassName = tc.className
+
+			_, err := r.Render(context.Background(), makeResource(t, tc.properties), renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+			require.Error(t, err)
+			require.Equal(t, v1.NewClientErrInvalidRequest(tc.err), err)
+		})
+	}
+}
+
+func getGatewayAPIEnvironmentOptions(hostname, externalIP string) renderers.EnvironmentOptions {
+	environmentOptions := getEnvironmentOptions(hostname, externalIP, "", false, false)
+	environmentOptions.Gateway.Implementation = datamodel.GatewayImplementationGatewayAPI
+	environmentOptions.Gateway.ClassName = testGatewayClassName
+
+	return environmentOptions
+}
+
+func makeCertificateDependency(t *testing.T, secretStoreResourceId string, secretNamespace string, secretName string) renderers.RendererDependency {
+	return renderers.RendererDependency{
+		ResourceID: makeResourceID(t, secretStoreResourceId),
+		Resource: &datamodel.SecretStore{
+			Properties: &datamodel.SecretStoreProperties{
+				Type: datamodel.SecretTypeCert,
+				Data: map[string]*datamodel.SecretStoreDataValue{
+					"tls.crt": {
+						Value: to.Ptr("test-crt"),
+					},
+					"tls.key": {
+						Value: to.Ptr("test-key"),
+					},
+				},
+			},
+		},
+		OutputResources: map[string]resources.ID{
+			rpv1.LocalIDSecret: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", resources_kubernetes.KindSecret, secretNamespace, secretName),
+		},
+	}
+}
+
+func findUnstructured(t *testing.T, outputResources []rpv1.OutputResource, localID string) (*unstructured.Unstructured, rpv1.OutputResource) {
+	for _, outputResource := range outputResources {
+		if outputResource.LocalID != localID {
+			continue
+		}
+
+		obj, ok := outputResource.CreateResource.Data.(*unstructured.Unstructured)
+		require.True(t, ok)
+		return obj, outputResource
+	}
+
+	require.Failf(t, "output resource not found", "local ID %s", localID)
+	return nil, rpv1.OutputResource{}
+}
@@ -78,7 +78,8 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 }
 
 // Render creates a gateway object and http route objects based on the given parameters, and returns them along
-// with a computed value for the gateway's public endpoint.
+// with a computed value for the gateway's public endpoint. The objects are Contour HTTPProxies unless the environment
+// uses the Kubernetes Gateway API implementation.
 func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
 	outputResources := []rpv1.OutputResource{}
 	gateway, ok := dm.(*datamodel.Gateway)
@@ -94,15 +95,44 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	hostname, err := getHostname(*gateway, &gateway.Properties, applicationName, options.Environment.Gateway)
 
 	var publicEndpoint string
-	if errors.Is(err, &ErrNoPublicEndpoint{}) {
+
+	// Without a public endpoint, a Gateway API gateway accepts requests for any hostname.
+	gatewayAPIHostname := hostname
+	noPublicEndpoint := errors.Is(err, &ErrNoPublicEndpoint{})
+	isHttps := gateway.Properties.TLS != nil && (gateway.Properties.TLS.SSLPassthrough || gateway.Properties.TLS.CertificateFrom != "")
+	if noPublicEndpoint {
 		publicEndpoint = "unknown"
+		gatewayAPIHostname = ""
 	} else if err != nil {
 		return renderers.RendererOutput{}, fmt.Errorf("getting hostname failed with error: %s", err)
 	} else {
-		isHttps := gateway.Properties.TLS != nil && (gateway.Properties.TLS.SSLPassthrough || gateway.Properties.TLS.CertificateFrom != "")
 		publicEndpoint = getPublicEndpoint(hostname, options.Environment.Gateway.Port, isHttps)
 	}
 
+	computedValues := map[string]rpv1.ComputedValueReference{
+		"url": {
+			Value: publicEndpoint,
+		},
+	}
+
+	if options.Environment.Gateway.Implementation == datamodel.GatewayImplementationGatewayAPI {
+		gatewayAPIResources, err := MakeGatewayAPIResources(ctx, options, gateway, applicationName, gatewayAPIHostname)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+
+		// The public endpoint of a Gateway API gateway is the address assigned to the Gateway, which is only known
+		// once its routes are ready.
+		if noPublicEndpoint {
+			computedValues["url"] = makeGatewayAPIURLReference(gatewayAPIResources, isHttps)
+		}
+
+		return renderers.RendererOutput{
+			Resources:      gatewayAPIResources,
+			ComputedValues: computedValues,
+		}, nil
+	}
+
 	gatewayObject, err := MakeRootHTTPProxy(ctx, options, gateway, gateway.Name, applicationName, hostname)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -110,12 +140,6 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 
 	outputResources = append(outputResources, gatewayObject)
 
-	computedValues := map[string]rpv1.ComputedValueReference{
-		"url": {
-			Value: publicEndpoint,
-		},
-	}
-
 	httpRouteObjects, err := MakeRoutesHTTPProxies(ctx, options, *gateway, &gateway.Properties, gatewayName, gatewayObject, applicationName)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -146,49 +170,9 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		sslPassthrough = gateway.Properties.TLS.SSLPassthrough
 
 		if gateway.Properties.TLS.CertificateFrom != "" {
-			secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
-			secretStoreResource, ok := dependencies[secretStoreResourceId]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			referencedResource := dependencies[secretStoreResourceId].Resource
-			if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
-			}
-
-			// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
-			secretStore, ok := referencedResource.(*datamodel.SecretStore)
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
-			}
-
-			if secretStore.Properties.Type != datamodel.SecretTypeCert {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with type certificate")
-			}
-
-			if secretStore.Properties.Data["tls.crt"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.crt")
-			}
-
-			if secretStore.Properties.Data["tls.key"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.key")
-			}
-
-			// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
-			if secretStoreResource.OutputResources == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			secretName := secretResourceID.Name()
-			secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
-			if secretNamespace == "" {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+			secretName, secretNamespace, err := getCertificateSecret(dependencies, gateway.Properties.TLS.CertificateFrom)
+			if err != nil {
+				return rpv1.OutputResource{}, err
 			}
 
 			contourTLSConfig = &contourv1.TLS{
@@ -400,6 +384,55 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	return outputResources, nil
 }
 
+// getCertificateSecret validates the secretStore resource referenced by certificateFrom and returns the name and
+// namespace of the Kubernetes secret that holds the certificate.
+func getCertificateSecret(dependencies map[string]renderers.RendererDependency, secretStoreResourceId string) (string, string, error) {
+	secretStoreResource, ok := dependencies[secretStoreResourceId]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	referencedResource := secretStoreResource.Resource
+	if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
+	}
+
+	// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
+	secretStore, ok := referencedResource.(*datamodel.SecretStore)
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
+	}
+
+	if secretStore.Properties.Type != datamodel.SecretTypeCert {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with type certificate")
+	}
+
+	if secretStore.Properties.Data["tls.crt"] == nil {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.crt")
+	}
+
+	if secretStore.Properties.Data["tls.key"] == nil {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.key")
+	}
+
+	// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
+	if secretStoreResource.OutputResources == nil {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
+	if secretNamespace == "" {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	return secretResourceID.Name(), secretNamespace, nil
+}
+
 func getRouteName(route *datamodel.GatewayRoute) (string, error) {
 	// if isURL, then name is hostname (DNS-SD case)
 	if isURL(route.Destination) {
@@ -72,6 +72,8 @@ type EnvironmentOptions struct {
 	Identity *rpv1.IdentitySettings
 	// KubernetesMetadata represents the Environment KubernetesMetadata extension.
 	KubernetesMetadata *datamodel.KubeMetadataExtension
+	// ContainerResourceDefaults represents the default compute resources of the containers of the environment.
+	ContainerResourceDefaults datamodel.ContainerResourceRequirements
 	// Simulated represents whether the environment is a simulated environment.
 	Simulated bool
 }
@@ -87,6 +89,10 @@ type GatewayOptions struct {
 	Hostname               string
 	Port                   string
 	ExternalIP             string
+	// Implementation is the gateway implementation configured on the environment.
+	Implementation datamodel.GatewayImplementation
+	// ClassName is the name of the GatewayClass used when Implementation is gatewayAPI.
+	ClassName string
 }
 
 type RendererOutput struct {
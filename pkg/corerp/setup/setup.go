@@ -17,6 +17,7 @@ limitations under the License.
 package setup
 
 import (
+	"strings"
 	"time"
 
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
@@ -24,6 +25,7 @@ import (
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 
 	backend_ctrl "github.com/radius-project/radius/pkg/corerp/backend/controller"
@@ -45,8 +47,9 @@ const (
 	AsyncOperationRetryAfter = time.Duration(5) * time.Second
 )
 
-// SetupNamespace builds the namespace for core resource provider.
-func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerConfig) *builder.Namespace {
+// SetupNamespace builds the namespace for core resource provider. Applications and environments are soft-deleted if
+// softDelete is set.
+func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerConfig, softDelete bool) *builder.Namespace {
 	ns := builder.NewNamespace("Applications.Core")
 
 	_ = ns.AddResource("environments", &builder.ResourceOption[*datamodel.Environment, datamodel.Environment]{
@@ -54,11 +57,16 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		ResponseConverter: converter.EnvironmentDataModelToVersioned,
 
 		Put: builder.Operation[datamodel.Environment]{
-			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
+			APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+				return env_ctrl.NewCreateOrUpdateEnvironment(opt, recipeControllerConfig.Engine)
+			},
 		},
 		Patch: builder.Operation[datamodel.Environment]{
-			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
+			APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+				return env_ctrl.NewCreateOrUpdateEnvironment(opt, recipeControllerConfig.Engine)
+			},
 		},
+		SoftDelete: softDelete,
 		Custom: map[string]builder.Operation[datamodel.Environment]{
 			"getmetadata": {
 				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
@@ -84,6 +92,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 				app_ctrl.CreateAppScopedNamespace,
 			},
 		},
+		SoftDelete: softDelete,
 	})
 
 	_ = ns.AddResource("httpRoutes", &builder.ResourceOption[*datamodel.HTTPRoute, datamodel.HTTPRoute]{
@@ -219,6 +228,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.Extender]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Extender],
+				rp_frontend.ValidateRecipeParameters[*datamodel.Extender](recipeControllerConfig.Engine),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.Extender, datamodel.Extender](options, &ext_processor.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -229,6 +239,7 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.Extender]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Extender],
+				rp_frontend.ValidateRecipeParameters[*datamodel.Extender](recipeControllerConfig.Engine),
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.Extender, datamodel.Extender](options, &ext_processor.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -247,6 +258,21 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: ext_ctrl.NewListSecretsExtender,
 			},
+			strings.ToLower(recipes.PlanRecipeAction): {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return rp_frontend.NewPlanRecipe[*datamodel.Extender, datamodel.Extender](opt,
+						apictrl.ResourceOptions[datamodel.Extender]{
+							RequestConverter:         converter.ExtenderDataModelFromVersioned,
+							ResponseConverter:        converter.ExtenderDataModelToVersioned,
+							AsyncOperationTimeout:    ext_ctrl.AsyncCreateOrUpdateExtenderTimeout,
+							AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+						},
+					)
+				},
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewPlanRecipe[*datamodel.Extender, datamodel.Extender](options, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -67,6 +67,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: app_ctrl.ResourceTypeName, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.core/applications/app0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: app_ctrl.ResourceTypeName, Method: v1.OperationRestore},
+		Path:          "/resourcegroups/testrg/providers/applications.core/applications/app0/restore",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ctr_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/containers",
@@ -115,6 +119,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: v1.OperationRestore},
+		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/restore",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONGETMETADATA"},
 		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/getmetadata",
@@ -241,7 +249,7 @@ func TestRouter(t *testing.T) {
 	mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(mockSC), nil).AnyTimes()
 
 	cfg := &controllerconfig.RecipeControllerConfig{}
-	ns := SetupNamespace(cfg)
+	ns := SetupNamespace(cfg, true)
 	nsBuilder := ns.GenerateBuilder()
 
 	rpctest.AssertRouters(t, handlerTests, "/api.ucp.dev", "/planes/radius/local", func(ctx context.Context) (chi.Router, error) {
@@ -107,6 +107,7 @@ func (dst *DaprStateStoreResource) ConvertFrom(src v1.DataModelInterface) error
 	dst.Properties = &DaprStateStoreProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprStateStore.Properties.Status.OutputResources),
+			DriftStatus:     fromDriftStatusDataModel(daprStateStore.Properties.Status.DriftStatus),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(daprStateStore.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(daprStateStore.Properties.Environment),
@@ -46,6 +46,27 @@ func PossibleCreatedByTypeValues() []CreatedByType {
 	}
 }
 
+// DriftState - The drift state of the resources deployed by a recipe.
+type DriftState string
+
+const (
+	// DriftStateDrifted - The deployed resources were changed outside of Radius and no longer match the recipe.
+	DriftStateDrifted DriftState = "Drifted"
+	// DriftStateInSync - The deployed resources match the recipe.
+	DriftStateInSync DriftState = "InSync"
+	// DriftStateUnknown - The drift check failed and the state of the deployed resources is unknown.
+	DriftStateUnknown DriftState = "Unknown"
+)
+
+// PossibleDriftStateValues returns the possible values for the DriftState const type.
+func PossibleDriftStateValues() []DriftState {
+	return []DriftState{	
+		DriftStateDrifted,
+		DriftStateInSync,
+		DriftStateUnknown,
+	}
+}
+
 // IdentitySettingKind - IdentitySettingKind is the kind of supported external identity setting
 type IdentitySettingKind string
 
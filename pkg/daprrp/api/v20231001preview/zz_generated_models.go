@@ -321,6 +321,33 @@ type DaprStateStoreResourceUpdateProperties struct {
 	Version *string
 }
 
+// DriftChange - A drifted resource deployed by a recipe.
+type DriftChange struct {
+	// REQUIRED; The change that re-deploying the recipe would make to the resource, for example Modify.
+	ChangeType *string
+
+	// REQUIRED; The ID of the drifted resource.
+	ID *string
+
+	// The type of the drifted resource.
+	Type *string
+}
+
+// DriftStatus - The result of a drift check of the resources deployed by a recipe.
+type DriftStatus struct {
+	// REQUIRED; The drift state of the resources deployed by the recipe.
+	State *DriftState
+
+	// The changes that re-deploying the recipe would make to bring the resources back in sync.
+	Changes []*DriftChange
+
+	// The time of the drift check.
+	LastCheckedTime *time.Time
+
+	// Describes why the drift check failed when the state is Unknown.
+	Message *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -515,6 +542,9 @@ type ResourceStatus struct {
 
 	// Properties of an output resource
 	OutputResources []*OutputResource
+
+	// READ-ONLY; The result of the last drift check of the resources deployed by the recipe.
+	DriftStatus *DriftStatus
 }
 
 // SystemData - Metadata pertaining to creation and last modification of the resource.
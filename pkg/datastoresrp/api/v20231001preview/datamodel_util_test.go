@@ -19,9 +19,11 @@ package v20231001preview
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/portableresources"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/stretchr/testify/require"
 )
@@ -287,3 +289,25 @@ func TestFromRecipeDataModel(t *testing.T) {
 
 	}
 }
+
+func TestFromDriftStatusDataModel(t *testing.T) {
+	require.Nil(t, fromDriftStatusDataModel(nil))
+
+	lastChecked := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	versioned := fromDriftStatusDataModel(&rpv1.DriftStatus{
+		State: rpv1.DriftStateDrifted,
+		Changes: []rpv1.DriftChange{
+			{ID: "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis", Type: "core/Service", ChangeType: "Modify"},
+		},
+		LastCheckedTime: lastChecked,
+	})
+
+	expected := &DriftStatus{
+		State: to.Ptr(DriftStateDrifted),
+		Changes: []*DriftChange{
+			{ID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"), Type: to.Ptr("core/Service"), ChangeType: to.Ptr("Modify")},
+		},
+		LastCheckedTime: to.Ptr(lastChecked),
+	}
+	require.Equal(t, expected, versioned)
+}
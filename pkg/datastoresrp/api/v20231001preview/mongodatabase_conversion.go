@@ -99,6 +99,7 @@ func (dst *MongoDatabaseResource) ConvertFrom(src v1.DataModelInterface) error {
 		Database:  to.Ptr(mongo.Properties.Database),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(mongo.Properties.Status.OutputResources),
+			DriftStatus:     fromDriftStatusDataModel(mongo.Properties.Status.DriftStatus),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(mongo.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(mongo.Properties.Environment),
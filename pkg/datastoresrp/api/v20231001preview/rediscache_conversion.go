@@ -101,6 +101,7 @@ func (dst *RedisCacheResource) ConvertFrom(src v1.DataModelInterface) error {
 		Username:             to.Ptr(redis.Properties.Username),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(redis.Properties.Status.OutputResources),
+			DriftStatus:     fromDriftStatusDataModel(redis.Properties.Status.DriftStatus),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(redis.InternalMetadata.AsyncProvisioningState),
 		Environment:       to.Ptr(redis.Properties.Environment),
@@ -99,6 +99,7 @@ func (dst *SQLDatabaseResource) ConvertFrom(src v1.DataModelInterface) error {
 		Port:                 to.Ptr(sql.Properties.Port),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(sql.Properties.Status.OutputResources),
+			DriftStatus:     fromDriftStatusDataModel(sql.Properties.Status.DriftStatus),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(sql.InternalMetadata.AsyncProvisioningState),
 		Environment:       to.Ptr(sql.Properties.Environment),
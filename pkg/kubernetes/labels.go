@@ -45,6 +45,9 @@ const (
 
 	// AnnotationIdentityType is the annotation for supported identity.
 	AnnotationIdentityType = "radapp.io/identity-type"
+
+	// AnnotationAutoscaled is the annotation of the Deployments whose replica count is managed by a HorizontalPodAutoscaler.
+	AnnotationAutoscaled = "radapp.io/autoscaled"
 )
 
 // NOTE: the difference between descriptive labels and selector labels
@@ -179,3 +179,25 @@ func toOutputResources(outputResources []rpv1.OutputResource) []*OutputResource
 	}
 	return outResources
 }
+
+func fromDriftStatusDataModel(status *rpv1.DriftStatus) *DriftStatus {
+	if status == nil {
+		return nil
+	}
+
+	converted := &DriftStatus{
+		State:           to.Ptr(DriftState(status.State)),
+		LastCheckedTime: to.Ptr(status.LastCheckedTime),
+	}
+	if status.Message != "" {
+		converted.Message = to.Ptr(status.Message)
+	}
+	for _, change := range status.Changes {
+		converted.Changes = append(converted.Changes, &DriftChange{
+			ID:         to.Ptr(change.ID),
+			Type:       to.Ptr(change.Type),
+			ChangeType: to.Ptr(change.ChangeType),
+		})
+	}
+	return converted
+}
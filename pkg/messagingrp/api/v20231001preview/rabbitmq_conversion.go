@@ -96,6 +96,7 @@ func (dst *RabbitMQQueueResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &RabbitMQQueueProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(rabbitmq.Properties.Status.OutputResources),
+			DriftStatus:     fromDriftStatusDataModel(rabbitmq.Properties.Status.DriftStatus),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(rabbitmq.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(rabbitmq.Properties.Environment),
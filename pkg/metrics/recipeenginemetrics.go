@@ -43,12 +43,21 @@ const (
 	// terraformInitializationDuration is the metric name for the Terraform initialization duration.
 	terraformInitializationDuration = "recipe.tf.init.duration"
 
+	// terraformCacheCount is the metric name for the number of lookups in the Terraform provider plugin and module cache.
+	terraformCacheCount = "recipe.tf.cache.count"
+
+	// recipeDriftCheckCount is the metric name for the number of drift checks of the resources deployed by recipes.
+	recipeDriftCheckCount = "recipe.drift.check.count"
+
 	// RecipeEngineOperationExecute represents the Execute operation of the Recipe Engine.
 	RecipeEngineOperationExecute = "execute"
 
 	// RecipeEngineOperationDelete represents the Delete operation of the Recipe Engine.
 	RecipeEngineOperationDelete = "delete"
 
+	// RecipeEngineOperationPlan represents the Plan operation of the Recipe Engine.
+	RecipeEngineOperationPlan = "plan"
+
 	// RecipeEngineOperationDownloadRecipe represents the Download Recipe operation of the Recipe Engine.
 	RecipeEngineOperationDownloadRecipe = "download.recipe"
 
@@ -93,6 +102,16 @@ func (m *recipeEngineMetrics) Init() error {
 		return err
 	}
 
+	m.counters[recipeDriftCheckCount], err = meter.Int64Counter(recipeDriftCheckCount)
+	if err != nil {
+		return err
+	}
+
+	m.counters[terraformCacheCount], err = meter.Int64Counter(terraformCacheCount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -137,6 +156,20 @@ func (m *recipeEngineMetrics) RecordRecipeGarbageCollectionDuration(ctx context.
 	}
 }
 
+// RecordRecipeDriftCheck records a drift check of the resources deployed by a recipe with the given attributes.
+func (m *recipeEngineMetrics) RecordRecipeDriftCheck(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[recipeDriftCheckCount] != nil {
+		m.counters[recipeDriftCheckCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordTerraformCacheLookup records a lookup in the Terraform provider plugin and module cache with the given attributes.
+func (m *recipeEngineMetrics) RecordTerraformCacheLookup(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[terraformCacheCount] != nil {
+		m.counters[terraformCacheCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
 // NewRecipeAttributes generates common attributes for recipe operations.
 func NewRecipeAttributes(operationType, recipeName string, definition *recipes.EnvironmentDefinition, state string) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
@@ -167,3 +200,30 @@ func NewRecipeAttributes(operationType, recipeName string, definition *recipes.E
 
 	return attrs
 }
+
+// NewRecipeDriftAttributes generates attributes for recipe drift checks.
+func NewRecipeDriftAttributes(resourceType, recipeName, driftState string) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0)
+
+	if resourceType != "" {
+		attrs = append(attrs, resourceTypeAttrKey.String(strings.ToLower(resourceType)))
+	}
+
+	if recipeName != "" {
+		attrs = append(attrs, recipeNameAttrKey.String(strings.ToLower(recipeName)))
+	}
+
+	if driftState != "" {
+		attrs = append(attrs, driftStateAttrKey.String(strings.ToLower(driftState)))
+	}
+
+	return attrs
+}
+
+// NewTerraformCacheAttributes generates attributes for lookups in the Terraform provider plugin and module cache.
+func NewTerraformCacheAttributes(cacheType, cacheResult string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		terraformCacheTypeAttrKey.String(cacheType),
+		terraformCacheResultAttrKey.String(cacheResult),
+	}
+}
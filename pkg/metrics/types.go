@@ -42,6 +42,15 @@ const (
 	// recipeTemplatePathAttrKey is the attribute name for the recipe template path.
 	recipeTemplatePathAttrKey = attribute.Key("recipe_template_path")
 
+	// driftStateAttrKey is the attribute name for the drift state of the resources deployed by a recipe.
+	driftStateAttrKey = attribute.Key("drift_state")
+
+	// terraformCacheTypeAttrKey is the attribute name for the type of the entries in the Terraform cache.
+	terraformCacheTypeAttrKey = attribute.Key("cache_type")
+
+	// terraformCacheResultAttrKey is the attribute name for the result of a lookup in the Terraform cache.
+	terraformCacheResultAttrKey = attribute.Key("cache_result")
+
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
@@ -50,4 +59,16 @@ const (
 
 	// FailedOperationState is the value for a failed operation state.
 	FailedOperationState = "failed"
+
+	// TerraformCacheTypeProvider is the value of the cache type for Terraform provider plugins.
+	TerraformCacheTypeProvider = "provider"
+
+	// TerraformCacheTypeModule is the value of the cache type for Terraform modules.
+	TerraformCacheTypeModule = "module"
+
+	// TerraformCacheHit is the value of the cache result when the entry is found in the cache.
+	TerraformCacheHit = "hit"
+
+	// TerraformCacheMiss is the value of the cache result when the entry is not found in the cache.
+	TerraformCacheMiss = "miss"
 )
@@ -88,7 +88,7 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 
 	// Now we're ready to process recipes (if needed).
 	recipeDataModel := any(data).(datamodel.RecipeDataModel)
-	recipeOutput, err := c.executeRecipeIfNeeded(ctx, data, previousOutputResources, config.Simulated)
+	recipeOutput, err := c.executeRecipeIfNeeded(ctx, req, data, previousOutputResources, config.Simulated)
 	if err != nil {
 		if recipeError, ok := err.(*recipes.RecipeError); ok {
 			logger.Error(err, fmt.Sprintf("failed to execute recipe. Encountered error while processing %s ", recipeError.ErrorDetails.Target))
@@ -122,6 +122,8 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 
 	if recipeDataModel.Recipe() != nil {
 		recipeDataModel.Recipe().DeploymentStatus = util.Success
+		// The recipe resources were deployed again, so the result of the last drift check no longer applies.
+		data.ResourceMetadata().Status.DriftStatus = nil
 	}
 	update := &store.Object{
 		Metadata: store.Metadata{
@@ -145,7 +147,7 @@ func (c *CreateOrUpdateResource[P, T]) copyOutputResources(data P) []string {
 	return previousOutputResources
 }
 
-func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context, data P, prevState []string, simulated bool) (*recipes.RecipeOutput, error) {
+func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context, req *ctrl.Request, data P, prevState []string, simulated bool) (*recipes.RecipeOutput, error) {
 	// 'any' is required here to convert to an interface type, only then can we use a type assertion.
 	recipeDataModel, supportsRecipes := any(data).(datamodel.RecipeDataModel)
 	if !supportsRecipes {
@@ -164,6 +166,12 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 		ResourceID:    data.GetBaseResource().ID,
 	}
 
+	ctx, finish, err := startRecipeLog(ctx, c.StorageClient(), data.GetBaseResource().ID, req.OperationID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
 	return c.engine.Execute(ctx, engine.ExecuteOptions{
 		BaseOptions: engine.BaseOptions{
 			Recipe: request,
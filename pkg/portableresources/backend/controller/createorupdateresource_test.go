@@ -136,6 +136,10 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 		eng := engine.NewMockEngine(mctrl)
 		cfg := configloader.NewMockConfigurationLoader(mctrl)
 		client := processors.NewMockResourceClient(mctrl)
+
+		// The execution log of the recipe is saved with the storage client of the resource.
+		msc.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ObjectQueryResult{}, nil).AnyTimes()
+		msc.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		return msc, eng, client, cfg
 	}
 
@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -87,12 +88,18 @@ func (c *DeleteResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (
 			ResourceID:    id.String(),
 		}
 
-		err = c.engine.Delete(ctx, engine.DeleteOptions{
+		recipeCtx, finish, err := startRecipeLog(ctx, c.StorageClient(), id.String(), request.OperationID.String())
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		err = c.engine.Delete(recipeCtx, engine.DeleteOptions{
 			BaseOptions: engine.BaseOptions{
 				Recipe: recipeData,
 			},
 			OutputResources: data.OutputResources(),
 		})
+		finish()
 		if err != nil {
 			if recipeError, ok := err.(*recipes.RecipeError); ok {
 				return ctrl.NewFailedResult(recipeError.ErrorDetails), nil
@@ -114,6 +121,11 @@ func (c *DeleteResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (
 		return ctrl.Result{}, err
 	}
 
+	err = oplog.NewClient(c.StorageClient()).Delete(ctx, id)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	err = c.StorageClient().Delete(ctx, request.ResourceID)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -50,6 +50,10 @@ func TestDeleteResourceRun_20231001Preview(t *testing.T) {
 		eng := engine.NewMockEngine(mctrl)
 		cfg := configloader.NewMockConfigurationLoader(mctrl)
 
+		// The execution log of the recipe is saved with the storage client of the resource.
+		msc.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ObjectQueryResult{}, nil).AnyTimes()
+		msc.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
 		req := &ctrl.Request{
 			OperationID:      uuid.New(),
 			OperationType:    "APPLICATIONS.DATASTORES/MONGODATABASES|DELETE",
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// PlanRecipe is the async operation controller to plan the changes that the recipe of a portable resource would make
+// without deploying the recipe.
+type PlanRecipe[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	ctrl.BaseController
+	engine engine.Engine
+}
+
+// NewPlanRecipe creates a new controller for planning the recipe of a resource with the given engine and options.
+func NewPlanRecipe[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options, eng engine.Engine) (ctrl.Controller, error) {
+	return &PlanRecipe[P, T]{
+		ctrl.NewBaseAsyncController(opts),
+		eng,
+	}, nil
+}
+
+// Run plans the recipe of the resource in the input of the request, or of the stored resource if the request has no
+// input, and returns the planned changes as the result of the operation. The output resources of the stored resource
+// are used as the previous state of the recipe so that resources which are no longer created by the recipe are planned
+// for deletion. The stored resource is not changed.
+func (c *PlanRecipe[P, T]) Run(ctx context.Context, req *ctrl.Request) (ctrl.Result, error) {
+	var stored P
+	obj, err := c.StorageClient().Get(ctx, req.ResourceID)
+	if err == nil {
+		stored = P(new(T))
+		if err = obj.As(stored); err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if !errors.Is(&store.ErrNotFound{ID: req.ResourceID}, err) {
+		return ctrl.Result{}, err
+	}
+
+	data := stored
+	if len(req.Input) > 0 {
+		data = P(new(T))
+		if err := json.Unmarshal(req.Input, data); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if data == nil {
+		return ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeNotFound,
+			Message: "The resource was deleted before its recipe was planned.",
+			Target:  req.ResourceID,
+		}), nil
+	}
+
+	recipeDataModel, supportsRecipes := any(data).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.Recipe() == nil {
+		return ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: "The resource does not use a recipe.",
+			Target:  req.ResourceID,
+		}), nil
+	}
+
+	prevState := []string{}
+	if stored != nil {
+		for _, outputResource := range stored.OutputResources() {
+			prevState = append(prevState, outputResource.ID.String())
+		}
+	}
+
+	input := recipeDataModel.Recipe()
+	plan, err := c.engine.Plan(ctx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          input.Name,
+				Parameters:    input.Parameters,
+				EnvironmentID: data.ResourceMetadata().Environment,
+				ApplicationID: data.ResourceMetadata().Application,
+				ResourceID:    req.ResourceID,
+			},
+		},
+		PreviousState: prevState,
+	})
+	var recipeError *recipes.RecipeError
+	if errors.As(err, &recipeError) {
+		return ctrl.NewFailedResult(recipeError.ErrorDetails), nil
+	} else if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{Properties: plan}, nil
+}
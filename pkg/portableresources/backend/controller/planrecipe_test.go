@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func TestPlanRecipe_Run(t *testing.T) {
+	plan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{{ID: newOutputResourceResourceID, Type: "testResources", ChangeType: recipes.ChangeTypeCreate}},
+	}
+
+	newStoredResource := func() *TestResource {
+		return &TestResource{
+			BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: TestResourceID}},
+			Properties: TestResourceProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Environment: TestEnvironmentID,
+					Application: TestApplicationID,
+					Status: rpv1.ResourceStatus{
+						OutputResources: []rpv1.OutputResource{newOutputResource},
+					},
+				},
+				Recipe: portableresources.ResourceRecipe{Name: "default"},
+			},
+		}
+	}
+
+	cases := []struct {
+		description    string
+		stored         *TestResource
+		input          *TestResource
+		planErr        error
+		expectedRecipe string
+		expectedPrev   []string
+		expectedError  string
+	}{
+		{
+			description:    "plan-stored-resource",
+			stored:         newStoredResource(),
+			expectedRecipe: "default",
+			expectedPrev:   []string{newOutputResourceResourceID},
+		},
+		{
+			description: "plan-input-resource",
+			stored:      newStoredResource(),
+			input: &TestResource{
+				BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: TestResourceID}},
+				Properties: TestResourceProperties{
+					BasicResourceProperties: rpv1.BasicResourceProperties{Environment: TestEnvironmentID},
+					Recipe:                  portableresources.ResourceRecipe{Name: "custom"},
+				},
+			},
+			expectedRecipe: "custom",
+			expectedPrev:   []string{newOutputResourceResourceID},
+		},
+		{
+			description: "plan-new-resource",
+			input: &TestResource{
+				BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: TestResourceID}},
+				Properties: TestResourceProperties{
+					BasicResourceProperties: rpv1.BasicResourceProperties{Environment: TestEnvironmentID},
+					Recipe:                  portableresources.ResourceRecipe{Name: "custom"},
+				},
+			},
+			expectedRecipe: "custom",
+			expectedPrev:   []string{},
+		},
+		{
+			description:   "resource-not-found",
+			expectedError: v1.CodeNotFound,
+		},
+		{
+			description:    "plan-failed",
+			stored:         newStoredResource(),
+			planErr:        recipes.NewRecipeError(recipes.RecipePlanFailed, "plan failed", "", nil),
+			expectedRecipe: "default",
+			expectedPrev:   []string{newOutputResourceResourceID},
+			expectedError:  recipes.RecipePlanFailed,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			msc := store.NewMockStorageClient(mctrl)
+			eng := engine.NewMockEngine(mctrl)
+
+			if tt.stored != nil {
+				msc.EXPECT().
+					Get(gomock.Any(), TestResourceID).
+					Return(&store.Object{Metadata: store.Metadata{ID: TestResourceID}, Data: tt.stored}, nil)
+			} else {
+				msc.EXPECT().
+					Get(gomock.Any(), TestResourceID).
+					Return(nil, &store.ErrNotFound{ID: TestResourceID})
+			}
+
+			if tt.expectedRecipe != "" {
+				eng.EXPECT().
+					Plan(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, opts engine.ExecuteOptions) (*recipes.RecipePlan, error) {
+						require.Equal(t, tt.expectedRecipe, opts.Recipe.Name)
+						require.Equal(t, TestEnvironmentID, opts.Recipe.EnvironmentID)
+						require.Equal(t, TestResourceID, opts.Recipe.ResourceID)
+						require.Equal(t, tt.expectedPrev, opts.PreviousState)
+						if tt.planErr != nil {
+							return nil, tt.planErr
+						}
+						return plan, nil
+					})
+			}
+
+			req := &ctrl.Request{
+				OperationID:      uuid.New(),
+				OperationType:    "APPLICATIONS.TEST/TESTRESOURCES|PLANRECIPE",
+				ResourceID:       TestResourceID,
+				CorrelationID:    uuid.NewString(),
+				OperationTimeout: &ctrl.DefaultAsyncOperationTimeout,
+				ReadOnly:         true,
+			}
+			if tt.input != nil {
+				b, err := json.Marshal(tt.input)
+				require.NoError(t, err)
+				req.Input = b
+			}
+
+			ctl, err := NewPlanRecipe[*TestResource, TestResource](ctrl.Options{StorageClient: msc}, eng)
+			require.NoError(t, err)
+
+			res, err := ctl.Run(context.Background(), req)
+			require.NoError(t, err)
+
+			if tt.expectedError != "" {
+				require.Equal(t, v1.ProvisioningStateFailed, res.ProvisioningState())
+				require.Equal(t, tt.expectedError, res.Error.Code)
+				require.Nil(t, res.Properties)
+				return
+			}
+
+			require.Equal(t, v1.ProvisioningStateSucceeded, res.ProvisioningState())
+			require.Equal(t, plan, res.Properties)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// startRecipeLog starts recording the execution log of the recipe of the resource for the operation, which can be
+// retrieved from the operation status. The returned function must be called when the recipe execution completes.
+func startRecipeLog(ctx context.Context, storageClient store.StorageClient, resourceID string, operationID string) (context.Context, func(), error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, finish := oplog.NewClient(storageClient).Start(ctx, id, operationID)
+	return ctx, finish, nil
+}
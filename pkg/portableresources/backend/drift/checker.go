@@ -0,0 +1,234 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drift implements the background drift checker for portable resources. The checker periodically plans
+// the recipe of each portable resource deployed by a recipe and records whether the deployed resources were
+// changed outside of Radius on the resource status.
+package drift
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	dapr_dm "github.com/radius-project/radius/pkg/daprrp/datamodel"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultInterval is the default interval at which drift is checked.
+	DefaultInterval = time.Hour
+)
+
+// Options represents the options of Checker.
+type Options struct {
+	// StorageProvider is the provider of storage client.
+	StorageProvider dataprovider.DataStorageProvider
+
+	// Engine is the recipe engine used to plan the recipes.
+	Engine engine.Engine
+
+	// Interval is the interval at which drift is checked. DefaultInterval is used if it is zero.
+	Interval time.Duration
+}
+
+// Checker periodically checks the resources deployed by the recipes of portable resources for drift.
+type Checker struct {
+	options Options
+}
+
+// New creates a new Checker.
+func New(options Options) *Checker {
+	if options.Interval == 0 {
+		options.Interval = DefaultInterval
+	}
+	return &Checker{options: options}
+}
+
+// Run checks the portable resources for drift every interval until the context is canceled. Run must be called by
+// a single replica at a time, otherwise every replica plans every resource.
+func (c *Checker) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ticker := time.NewTicker(c.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.check(ctx, time.Now()); err != nil {
+			logger.Error(err, "failed to check resources for drift")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// resourceTypes are the portable resource types which are checked for drift.
+var resourceTypes = []struct {
+	Name string
+	New  func() rpv1.RadiusResourceModel
+}{
+	{ds_ctrl.MongoDatabasesResourceType, func() rpv1.RadiusResourceModel { return &ds_dm.MongoDatabase{} }},
+	{ds_ctrl.RedisCachesResourceType, func() rpv1.RadiusResourceModel { return &ds_dm.RedisCache{} }},
+	{ds_ctrl.SqlDatabasesResourceType, func() rpv1.RadiusResourceModel { return &ds_dm.SqlDatabase{} }},
+	{msg_ctrl.RabbitMQQueuesResourceType, func() rpv1.RadiusResourceModel { return &msg_dm.RabbitMQQueue{} }},
+	{dapr_ctrl.DaprPubSubBrokersResourceType, func() rpv1.RadiusResourceModel { return &dapr_dm.DaprPubSubBroker{} }},
+	{dapr_ctrl.DaprSecretStoresResourceType, func() rpv1.RadiusResourceModel { return &dapr_dm.DaprSecretStore{} }},
+	{dapr_ctrl.DaprStateStoresResourceType, func() rpv1.RadiusResourceModel { return &dapr_dm.DaprStateStore{} }},
+}
+
+// check checks every portable resource deployed by a recipe for drift and saves the result on its status.
+// A resource whose status cannot be saved is skipped and checked again in the next check.
+func (c *Checker) check(ctx context.Context, now time.Time) error {
+	var errs []error
+	for _, resourceType := range resourceTypes {
+		client, err := c.options.StorageProvider.GetStorageClient(ctx, resourceType.Name)
+		if err != nil {
+			return err
+		}
+
+		objs, err := queryAll(ctx, client, store.Query{RootScope: resources.SegmentSeparator + resources.PlanesSegment, ScopeRecursive: true, ResourceType: resourceType.Name})
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range objs {
+			data := resourceType.New()
+			if err := obj.As(data); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			if err := c.checkResource(ctx, client, obj, data, now); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkResource plans the recipe of the resource and saves the drift status on the resource. Resources which are not
+// deployed by a recipe or which are being provisioned are skipped.
+func (c *Checker) checkResource(ctx context.Context, client store.StorageClient, obj store.Object, data rpv1.RadiusResourceModel, now time.Time) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	recipeDataModel, ok := data.(datamodel.RecipeDataModel)
+	if !ok || recipeDataModel.Recipe() == nil || data.ProvisioningState() != v1.ProvisioningStateSucceeded {
+		return nil
+	}
+
+	recipe := recipeDataModel.Recipe()
+	prevState := []string{}
+	for _, outputResource := range data.OutputResources() {
+		prevState = append(prevState, outputResource.ID.String())
+	}
+
+	plan, err := c.options.Engine.Plan(ctx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          recipe.Name,
+				Parameters:    recipe.Parameters,
+				EnvironmentID: data.ResourceMetadata().Environment,
+				ApplicationID: data.ResourceMetadata().Application,
+				ResourceID:    obj.ID,
+			},
+		},
+		PreviousState: prevState,
+	})
+
+	status := newDriftStatus(plan, err, now)
+	if err != nil {
+		logger.Error(err, "failed to plan recipe to check drift", "resourceId", obj.ID)
+	} else if status.State == rpv1.DriftStateDrifted {
+		logger.Info("Detected drift of recipe resources", "resourceId", obj.ID, "changes", len(status.Changes))
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDriftCheck(ctx,
+		metrics.NewRecipeDriftAttributes(data.GetBaseResource().Type, recipe.Name, string(status.State)))
+
+	data.ResourceMetadata().Status.DriftStatus = status
+	err = client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: obj.ID}, Data: data}, store.WithETag(obj.ETag))
+	if errors.Is(&store.ErrConcurrency{}, err) {
+		// The resource was updated during the check. It will be checked again in the next check.
+		return nil
+	}
+	return err
+}
+
+// newDriftStatus creates the drift status from the plan of the recipe. The resources are drifted if deploying the
+// recipe would change any of them.
+func newDriftStatus(plan *recipes.RecipePlan, planErr error, now time.Time) *rpv1.DriftStatus {
+	status := &rpv1.DriftStatus{
+		State:           rpv1.DriftStateInSync,
+		LastCheckedTime: now.UTC(),
+	}
+
+	if planErr != nil {
+		status.State = rpv1.DriftStateUnknown
+		status.Message = planErr.Error()
+		return status
+	}
+
+	for _, change := range plan.Changes {
+		if change.ChangeType == recipes.ChangeTypeNoChange || change.ChangeType == recipes.ChangeTypeUnsupported {
+			continue
+		}
+
+		status.State = rpv1.DriftStateDrifted
+		status.Changes = append(status.Changes, rpv1.DriftChange{
+			ID:         change.ID,
+			Type:       change.Type,
+			ChangeType: string(change.ChangeType),
+		})
+	}
+
+	return status
+}
+
+func queryAll(ctx context.Context, client store.StorageClient, query store.Query) ([]store.Object, error) {
+	objs := []store.Object{}
+	token := ""
+	for {
+		result, err := client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		objs = append(objs, result.Items...)
+		if result.PaginationToken == "" {
+			return objs, nil
+		}
+		token = result.PaginationToken
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ds_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	testRedisID   = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/test-redis"
+	testServiceID = "/planes/kubernetes/local/namespaces/default/providers/core/Service/test-redis"
+	testEnvID     = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+)
+
+func newRedisCache(provisioning portableresources.ResourceProvisioning, state v1.ProvisioningState) store.Object {
+	r := &ds_dm.RedisCache{}
+	r.ID = testRedisID
+	r.Type = ds_ctrl.RedisCachesResourceType
+	r.InternalMetadata.AsyncProvisioningState = state
+	r.Properties.Environment = testEnvID
+	r.Properties.ResourceProvisioning = provisioning
+	r.Properties.Recipe = portableresources.ResourceRecipe{Name: "default"}
+	r.Properties.Status.OutputResources = []rpv1.OutputResource{{ID: resources.MustParse(testServiceID)}}
+	return store.Object{Metadata: store.Metadata{ID: testRedisID, ETag: "redis-etag"}, Data: r}
+}
+
+func setup(t *testing.T, objs []store.Object) (*store.MockStorageClient, *engine.MockEngine, *Checker) {
+	mctrl := gomock.NewController(t)
+	sc := store.NewMockStorageClient(mctrl)
+	sp := dataprovider.NewMockDataStorageProvider(mctrl)
+	eng := engine.NewMockEngine(mctrl)
+
+	sp.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(sc, nil).AnyTimes()
+	sc.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			if query.ResourceType == ds_ctrl.RedisCachesResourceType {
+				return &store.ObjectQueryResult{Items: objs}, nil
+			}
+			return &store.ObjectQueryResult{}, nil
+		}).
+		AnyTimes()
+
+	return sc, eng, New(Options{StorageProvider: sp, Engine: eng})
+}
+
+func expectSave(t *testing.T, sc *store.MockStorageClient) *rpv1.DriftStatus {
+	saved := &rpv1.DriftStatus{}
+	sc.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			require.Equal(t, testRedisID, obj.ID)
+			require.Equal(t, "redis-etag", store.NewSaveConfig(options...).ETag)
+			*saved = *obj.Data.(*ds_dm.RedisCache).Properties.Status.DriftStatus
+			return nil
+		}).
+		Times(1)
+	return saved
+}
+
+func Test_Check(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("drifted resource", func(t *testing.T) {
+		sc, eng, c := setup(t, []store.Object{newRedisCache(portableresources.ResourceProvisioningRecipe, v1.ProvisioningStateSucceeded)})
+
+		eng.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts engine.ExecuteOptions) (*recipes.RecipePlan, error) {
+				require.Equal(t, testRedisID, opts.Recipe.ResourceID)
+				require.Equal(t, testEnvID, opts.Recipe.EnvironmentID)
+				require.Equal(t, "default", opts.Recipe.Name)
+				require.Equal(t, []string{testServiceID}, opts.PreviousState)
+				return &recipes.RecipePlan{Changes: []recipes.ResourceChange{
+					{ID: testServiceID, Type: "core/Service", ChangeType: recipes.ChangeTypeModify},
+					{ID: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/test-redis", Type: "apps/Deployment", ChangeType: recipes.ChangeTypeNoChange},
+				}}, nil
+			}).
+			Times(1)
+		saved := expectSave(t, sc)
+
+		require.NoError(t, c.check(context.Background(), now))
+		require.Equal(t, rpv1.DriftStatus{
+			State:           rpv1.DriftStateDrifted,
+			Changes:         []rpv1.DriftChange{{ID: testServiceID, Type: "core/Service", ChangeType: "Modify"}},
+			LastCheckedTime: now,
+		}, *saved)
+	})
+
+	t.Run("resource in sync", func(t *testing.T) {
+		sc, eng, c := setup(t, []store.Object{newRedisCache(portableresources.ResourceProvisioningRecipe, v1.ProvisioningStateSucceeded)})
+
+		eng.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			Return(&recipes.RecipePlan{Changes: []recipes.ResourceChange{{ID: testServiceID, ChangeType: recipes.ChangeTypeNoChange}}}, nil).
+			Times(1)
+		saved := expectSave(t, sc)
+
+		require.NoError(t, c.check(context.Background(), now))
+		require.Equal(t, rpv1.DriftStatus{State: rpv1.DriftStateInSync, LastCheckedTime: now}, *saved)
+	})
+
+	t.Run("plan failure sets unknown state", func(t *testing.T) {
+		sc, eng, c := setup(t, []store.Object{newRedisCache(portableresources.ResourceProvisioningRecipe, v1.ProvisioningStateSucceeded)})
+
+		eng.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to plan")).Times(1)
+		saved := expectSave(t, sc)
+
+		require.NoError(t, c.check(context.Background(), now))
+		require.Equal(t, rpv1.DriftStatus{State: rpv1.DriftStateUnknown, Message: "failed to plan", LastCheckedTime: now}, *saved)
+	})
+
+	t.Run("concurrent update is skipped", func(t *testing.T) {
+		sc, eng, c := setup(t, []store.Object{newRedisCache(portableresources.ResourceProvisioningRecipe, v1.ProvisioningStateSucceeded)})
+
+		eng.EXPECT().Plan(gomock.Any(), gomock.Any()).Return(&recipes.RecipePlan{}, nil).Times(1)
+		sc.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ErrConcurrency{}).Times(1)
+
+		require.NoError(t, c.check(context.Background(), now))
+	})
+
+	t.Run("manually provisioned resource is skipped", func(t *testing.T) {
+		_, _, c := setup(t, []store.Object{newRedisCache(portableresources.ResourceProvisioningManual, v1.ProvisioningStateSucceeded)})
+		require.NoError(t, c.check(context.Background(), now))
+	})
+
+	t.Run("resource being provisioned is skipped", func(t *testing.T) {
+		_, _, c := setup(t, []store.Object{newRedisCache(portableresources.ResourceProvisioningRecipe, v1.ProvisioningStateUpdating)})
+		require.NoError(t, c.check(context.Background(), now))
+	})
+}
+
+func Test_New_Defaults(t *testing.T) {
+	c := New(Options{})
+	require.Equal(t, DefaultInterval, c.options.Interval)
+}
@@ -89,6 +89,7 @@ func (s *Service) Run(ctx context.Context) error {
 		TypeName               string
 		CreatePutController    func(options ctrl.Options) (ctrl.Controller, error)
 		CreateDeleteController func(options ctrl.Options) (ctrl.Controller, error)
+		CreatePlanController   func(options ctrl.Options) (ctrl.Controller, error)
 	}{
 		{
 			msg_ctrl.RabbitMQQueuesResourceType,
@@ -100,6 +101,9 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &rabbitmqqueues.Processor{}
 				return backend_ctrl.NewDeleteResource[*msg_dm.RabbitMQQueue, msg_dm.RabbitMQQueue](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewPlanRecipe[*msg_dm.RabbitMQQueue, msg_dm.RabbitMQQueue](options, engine)
+			},
 		},
 		{
 			dapr_ctrl.DaprStateStoresResourceType,
@@ -111,6 +115,9 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &statestores.Processor{Client: k8s.RuntimeClient}
 				return backend_ctrl.NewDeleteResource[*dapr_dm.DaprStateStore, dapr_dm.DaprStateStore](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewPlanRecipe[*dapr_dm.DaprStateStore, dapr_dm.DaprStateStore](options, engine)
+			},
 		},
 		{
 			dapr_ctrl.DaprSecretStoresResourceType,
@@ -122,6 +129,9 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &secretstores.Processor{Client: k8s.RuntimeClient}
 				return backend_ctrl.NewDeleteResource[*dapr_dm.DaprSecretStore, dapr_dm.DaprSecretStore](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewPlanRecipe[*dapr_dm.DaprSecretStore, dapr_dm.DaprSecretStore](options, engine)
+			},
 		},
 		{
 			dapr_ctrl.DaprPubSubBrokersResourceType,
@@ -133,6 +143,9 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &pubsubbrokers.Processor{Client: k8s.RuntimeClient}
 				return backend_ctrl.NewDeleteResource[*dapr_dm.DaprPubSubBroker, dapr_dm.DaprPubSubBroker](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewPlanRecipe[*dapr_dm.DaprPubSubBroker, dapr_dm.DaprPubSubBroker](options, engine)
+			},
 		},
 		{
 			ds_ctrl.MongoDatabasesResourceType,
@@ -144,6 +157,9 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &mongo_prc.Processor{}
 				return backend_ctrl.NewDeleteResource[*ds_dm.MongoDatabase, ds_dm.MongoDatabase](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewPlanRecipe[*ds_dm.MongoDatabase, ds_dm.MongoDatabase](options, engine)
+			},
 		},
 		{
 			ds_ctrl.RedisCachesResourceType,
@@ -155,6 +171,9 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &redis_prc.Processor{}
 				return backend_ctrl.NewDeleteResource[*ds_dm.RedisCache, ds_dm.RedisCache](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewPlanRecipe[*ds_dm.RedisCache, ds_dm.RedisCache](options, engine)
+			},
 		},
 		{
 			ds_ctrl.SqlDatabasesResourceType,
@@ -166,6 +185,9 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &sql_prc.Processor{}
 				return backend_ctrl.NewDeleteResource[*ds_dm.SqlDatabase, ds_dm.SqlDatabase](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewPlanRecipe[*ds_dm.SqlDatabase, ds_dm.SqlDatabase](options, engine)
+			},
 		},
 	}
 
@@ -184,6 +206,10 @@ func (s *Service) Run(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		err = s.Controllers.Register(ctx, rt.TypeName, v1.OperationPlanRecipe, rt.CreatePlanController, opts)
+		if err != nil {
+			return err
+		}
 	}
 	workerOpts := worker.Options{}
 	if s.Options.Config.WorkerServer != nil {
@@ -19,6 +19,7 @@ package handler
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -26,6 +27,8 @@ import (
 	frontend_ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 	"github.com/radius-project/radius/pkg/validator"
 	"github.com/radius-project/radius/swagger"
@@ -66,7 +69,7 @@ const (
 )
 
 // AddRoutes configures routes and handlers for Datastores, Messaging, Dapr Resource Providers.
-func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts frontend_ctrl.Options) error {
+func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts frontend_ctrl.Options, eng engine.Engine) error {
 	rootScopePath := ctrlOpts.PathBase
 	rootScopePath += getRootScopePath(isARM)
 
@@ -77,17 +80,17 @@ func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts fron
 		rootScopePath,
 	}
 
-	err := AddMessagingRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts)
+	err := AddMessagingRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts, eng)
 	if err != nil {
 		return err
 	}
 
-	err = AddDaprRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts)
+	err = AddDaprRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts, eng)
 	if err != nil {
 		return err
 	}
 
-	err = AddDatastoresRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts)
+	err = AddDatastoresRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts, eng)
 	if err != nil {
 		return err
 	}
@@ -97,7 +100,7 @@ func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts fron
 
 // AddMessagingRoutes configures the default ARM handlers and registers handlers for the RabbitMQQueue resource type for
 // the List, Get, Put, Patch and Delete operations.
-func AddMessagingRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options) error {
+func AddMessagingRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options, eng engine.Engine) error {
 	// Configure the default ARM handlers.
 	err := server.ConfigureDefaultHandlers(ctx, r, rootScopePath, isARM, MessagingProviderNamespace, NewGetOperations, ctrlOpts)
 	if err != nil {
@@ -175,6 +178,7 @@ func AddMessagingRoutes(ctx context.Context, r chi.Router, rootScopePath string,
 						ResponseConverter: msg_conv.RabbitMQQueueDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[msg_dm.RabbitMQQueue]{
 							rp_frontend.PrepareRadiusResource[*msg_dm.RabbitMQQueue],
+							rp_frontend.ValidateRecipeParameters[*msg_dm.RabbitMQQueue](eng),
 						},
 						AsyncOperationTimeout:    msg_ctrl.AsyncCreateOrUpdateRabbitMQTimeout,
 						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -187,12 +191,13 @@ func AddMessagingRoutes(ctx context.Context, r chi.Router, rootScopePath string,
 			ResourceType: msg_ctrl.RabbitMQQueuesResourceType,
 			Method:       v1.OperationPatch,
 			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut(opt,
+				return defaultoperation.NewDefaultAsyncPatch(opt,
 					frontend_ctrl.ResourceOptions[msg_dm.RabbitMQQueue]{
 						RequestConverter:  msg_conv.RabbitMQQueueDataModelFromVersioned,
 						ResponseConverter: msg_conv.RabbitMQQueueDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[msg_dm.RabbitMQQueue]{
 							rp_frontend.PrepareRadiusResource[*msg_dm.RabbitMQQueue],
+							rp_frontend.ValidateRecipeParameters[*msg_dm.RabbitMQQueue](eng),
 						},
 						AsyncOperationTimeout:    msg_ctrl.AsyncCreateOrUpdateRabbitMQTimeout,
 						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -200,6 +205,29 @@ func AddMessagingRoutes(ctx context.Context, r chi.Router, rootScopePath string,
 				)
 			},
 		},
+		{
+			ParentRouter:      rmqResourceRouter,
+			Path:              "/operations",
+			ResourceType:      msg_ctrl.RabbitMQQueuesResourceType,
+			Method:            v1.OperationHistory,
+			ControllerFactory: defaultoperation.NewListOperationHistory,
+		},
+		{
+			ParentRouter: rmqResourceRouter,
+			Path:         "/" + strings.ToLower(recipes.PlanRecipeAction),
+			ResourceType: msg_ctrl.RabbitMQQueuesResourceType,
+			Method:       v1.OperationPlanRecipe,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return rp_frontend.NewPlanRecipe(opt,
+					frontend_ctrl.ResourceOptions[msg_dm.RabbitMQQueue]{
+						RequestConverter:         msg_conv.RabbitMQQueueDataModelFromVersioned,
+						ResponseConverter:        msg_conv.RabbitMQQueueDataModelToVersioned,
+						AsyncOperationTimeout:    msg_ctrl.AsyncCreateOrUpdateRabbitMQTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
 		{
 			ParentRouter: rmqResourceRouter,
 			ResourceType: msg_ctrl.RabbitMQQueuesResourceType,
@@ -235,7 +263,7 @@ func AddMessagingRoutes(ctx context.Context, r chi.Router, rootScopePath string,
 
 // AddDaprRoutes configures the default ARM handlers and adds handlers for Dapr resources such as Dapr PubSubBroker,
 // SecretStore and StateStore. It registers handlers for various operations on these resources.
-func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options) error {
+func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options, eng engine.Engine) error {
 
 	// Dapr - Configure the default ARM handlers.
 	err := server.ConfigureDefaultHandlers(ctx, r, rootScopePath, isARM, DaprProviderNamespace, NewGetOperations, ctrlOpts)
@@ -312,6 +340,7 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 						ResponseConverter: dapr_conv.PubSubBrokerDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[dapr_dm.DaprPubSubBroker]{
 							rp_frontend.PrepareRadiusResource[*dapr_dm.DaprPubSubBroker],
+							rp_frontend.ValidateRecipeParameters[*dapr_dm.DaprPubSubBroker](eng),
 							rp_frontend.PrepareDaprResource[*dapr_dm.DaprPubSubBroker],
 						},
 						AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout,
@@ -325,12 +354,13 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 			ResourceType: dapr_ctrl.DaprPubSubBrokersResourceType,
 			Method:       v1.OperationPatch,
 			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut(opt,
+				return defaultoperation.NewDefaultAsyncPatch(opt,
 					frontend_ctrl.ResourceOptions[dapr_dm.DaprPubSubBroker]{
 						RequestConverter:  dapr_conv.PubSubBrokerDataModelFromVersioned,
 						ResponseConverter: dapr_conv.PubSubBrokerDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[dapr_dm.DaprPubSubBroker]{
 							rp_frontend.PrepareRadiusResource[*dapr_dm.DaprPubSubBroker],
+							rp_frontend.ValidateRecipeParameters[*dapr_dm.DaprPubSubBroker](eng),
 							rp_frontend.PrepareDaprResource[*dapr_dm.DaprPubSubBroker],
 						},
 						AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout,
@@ -339,6 +369,29 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 				)
 			},
 		},
+		{
+			ParentRouter:      pubsubResourceRouter,
+			Path:              "/operations",
+			ResourceType:      dapr_ctrl.DaprPubSubBrokersResourceType,
+			Method:            v1.OperationHistory,
+			ControllerFactory: defaultoperation.NewListOperationHistory,
+		},
+		{
+			ParentRouter: pubsubResourceRouter,
+			Path:         "/" + strings.ToLower(recipes.PlanRecipeAction),
+			ResourceType: dapr_ctrl.DaprPubSubBrokersResourceType,
+			Method:       v1.OperationPlanRecipe,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return rp_frontend.NewPlanRecipe(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprPubSubBroker]{
+						RequestConverter:         dapr_conv.PubSubBrokerDataModelFromVersioned,
+						ResponseConverter:        dapr_conv.PubSubBrokerDataModelToVersioned,
+						AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
 		{
 			ParentRouter: pubsubResourceRouter,
 			ResourceType: dapr_ctrl.DaprPubSubBrokersResourceType,
@@ -409,6 +462,7 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 						ResponseConverter: dapr_conv.SecretStoreDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[dapr_dm.DaprSecretStore]{
 							rp_frontend.PrepareRadiusResource[*dapr_dm.DaprSecretStore],
+							rp_frontend.ValidateRecipeParameters[*dapr_dm.DaprSecretStore](eng),
 							rp_frontend.PrepareDaprResource[*dapr_dm.DaprSecretStore],
 						},
 						AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout,
@@ -422,12 +476,13 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 			ResourceType: dapr_ctrl.DaprSecretStoresResourceType,
 			Method:       v1.OperationPatch,
 			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut(opt,
+				return defaultoperation.NewDefaultAsyncPatch(opt,
 					frontend_ctrl.ResourceOptions[dapr_dm.DaprSecretStore]{
 						RequestConverter:  dapr_conv.SecretStoreDataModelFromVersioned,
 						ResponseConverter: dapr_conv.SecretStoreDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[dapr_dm.DaprSecretStore]{
 							rp_frontend.PrepareRadiusResource[*dapr_dm.DaprSecretStore],
+							rp_frontend.ValidateRecipeParameters[*dapr_dm.DaprSecretStore](eng),
 							rp_frontend.PrepareDaprResource[*dapr_dm.DaprSecretStore],
 						},
 						AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout,
@@ -436,6 +491,29 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 				)
 			},
 		},
+		{
+			ParentRouter:      secretStoreResourceRouter,
+			Path:              "/operations",
+			ResourceType:      dapr_ctrl.DaprSecretStoresResourceType,
+			Method:            v1.OperationHistory,
+			ControllerFactory: defaultoperation.NewListOperationHistory,
+		},
+		{
+			ParentRouter: secretStoreResourceRouter,
+			Path:         "/" + strings.ToLower(recipes.PlanRecipeAction),
+			ResourceType: dapr_ctrl.DaprSecretStoresResourceType,
+			Method:       v1.OperationPlanRecipe,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return rp_frontend.NewPlanRecipe(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprSecretStore]{
+						RequestConverter:         dapr_conv.SecretStoreDataModelFromVersioned,
+						ResponseConverter:        dapr_conv.SecretStoreDataModelToVersioned,
+						AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
 		{
 			ParentRouter: secretStoreResourceRouter,
 			ResourceType: dapr_ctrl.DaprSecretStoresResourceType,
@@ -506,6 +584,7 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 						ResponseConverter: dapr_conv.StateStoreDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[dapr_dm.DaprStateStore]{
 							rp_frontend.PrepareRadiusResource[*dapr_dm.DaprStateStore],
+							rp_frontend.ValidateRecipeParameters[*dapr_dm.DaprStateStore](eng),
 							rp_frontend.PrepareDaprResource[*dapr_dm.DaprStateStore],
 						},
 						AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout,
@@ -519,12 +598,13 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 			ResourceType: dapr_ctrl.DaprStateStoresResourceType,
 			Method:       v1.OperationPatch,
 			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut(opt,
+				return defaultoperation.NewDefaultAsyncPatch(opt,
 					frontend_ctrl.ResourceOptions[dapr_dm.DaprStateStore]{
 						RequestConverter:  dapr_conv.StateStoreDataModelFromVersioned,
 						ResponseConverter: dapr_conv.StateStoreDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[dapr_dm.DaprStateStore]{
 							rp_frontend.PrepareRadiusResource[*dapr_dm.DaprStateStore],
+							rp_frontend.ValidateRecipeParameters[*dapr_dm.DaprStateStore](eng),
 							rp_frontend.PrepareDaprResource[*dapr_dm.DaprStateStore],
 						},
 						AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout,
@@ -533,6 +613,29 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 				)
 			},
 		},
+		{
+			ParentRouter:      stateStoreResourceRouter,
+			Path:              "/operations",
+			ResourceType:      dapr_ctrl.DaprStateStoresResourceType,
+			Method:            v1.OperationHistory,
+			ControllerFactory: defaultoperation.NewListOperationHistory,
+		},
+		{
+			ParentRouter: stateStoreResourceRouter,
+			Path:         "/" + strings.ToLower(recipes.PlanRecipeAction),
+			ResourceType: dapr_ctrl.DaprStateStoresResourceType,
+			Method:       v1.OperationPlanRecipe,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return rp_frontend.NewPlanRecipe(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprStateStore]{
+						RequestConverter:         dapr_conv.StateStoreDataModelFromVersioned,
+						ResponseConverter:        dapr_conv.StateStoreDataModelToVersioned,
+						AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
 		{
 			ParentRouter: stateStoreResourceRouter,
 			ResourceType: dapr_ctrl.DaprStateStoresResourceType,
@@ -561,7 +664,7 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 
 // AddDatastoresRoutes configures the routes and handlers for  Datastores Resource Provider. It registers handlers for List, Get, Put,
 // Patch, and Delete operations for MongoDatabase, RedisCache, and SqlDatabase resources.
-func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options) error {
+func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options, eng engine.Engine) error {
 	// Datastores - Configure the default ARM handlers.
 	err := server.ConfigureDefaultHandlers(ctx, r, rootScopePath, isARM, DatastoresProviderNamespace, NewGetOperations, ctrlOpts)
 	if err != nil {
@@ -638,6 +741,7 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 						ResponseConverter: ds_conv.MongoDatabaseDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[ds_dm.MongoDatabase]{
 							rp_frontend.PrepareRadiusResource[*ds_dm.MongoDatabase],
+							rp_frontend.ValidateRecipeParameters[*ds_dm.MongoDatabase](eng),
 						},
 						AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateMongoDatabaseTimeout,
 						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -650,12 +754,13 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 			ResourceType: ds_ctrl.MongoDatabasesResourceType,
 			Method:       v1.OperationPatch,
 			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut(opt,
+				return defaultoperation.NewDefaultAsyncPatch(opt,
 					frontend_ctrl.ResourceOptions[ds_dm.MongoDatabase]{
 						RequestConverter:  ds_conv.MongoDatabaseDataModelFromVersioned,
 						ResponseConverter: ds_conv.MongoDatabaseDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[ds_dm.MongoDatabase]{
 							rp_frontend.PrepareRadiusResource[*ds_dm.MongoDatabase],
+							rp_frontend.ValidateRecipeParameters[*ds_dm.MongoDatabase](eng),
 						},
 						AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateMongoDatabaseTimeout,
 						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -663,6 +768,29 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 				)
 			},
 		},
+		{
+			ParentRouter:      mongoResourceRouter,
+			Path:              "/operations",
+			ResourceType:      ds_ctrl.MongoDatabasesResourceType,
+			Method:            v1.OperationHistory,
+			ControllerFactory: defaultoperation.NewListOperationHistory,
+		},
+		{
+			ParentRouter: mongoResourceRouter,
+			Path:         "/" + strings.ToLower(recipes.PlanRecipeAction),
+			ResourceType: ds_ctrl.MongoDatabasesResourceType,
+			Method:       v1.OperationPlanRecipe,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return rp_frontend.NewPlanRecipe(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.MongoDatabase]{
+						RequestConverter:         ds_conv.MongoDatabaseDataModelFromVersioned,
+						ResponseConverter:        ds_conv.MongoDatabaseDataModelToVersioned,
+						AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateMongoDatabaseTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
 		{
 			ParentRouter: mongoResourceRouter,
 			ResourceType: ds_ctrl.MongoDatabasesResourceType,
@@ -740,6 +868,7 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 						ResponseConverter: ds_conv.RedisCacheDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[ds_dm.RedisCache]{
 							rp_frontend.PrepareRadiusResource[*ds_dm.RedisCache],
+							rp_frontend.ValidateRecipeParameters[*ds_dm.RedisCache](eng),
 						},
 						AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateRedisCacheTimeout,
 						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -752,12 +881,13 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 			ResourceType: ds_ctrl.RedisCachesResourceType,
 			Method:       v1.OperationPatch,
 			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut(opt,
+				return defaultoperation.NewDefaultAsyncPatch(opt,
 					frontend_ctrl.ResourceOptions[ds_dm.RedisCache]{
 						RequestConverter:  ds_conv.RedisCacheDataModelFromVersioned,
 						ResponseConverter: ds_conv.RedisCacheDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[ds_dm.RedisCache]{
 							rp_frontend.PrepareRadiusResource[*ds_dm.RedisCache],
+							rp_frontend.ValidateRecipeParameters[*ds_dm.RedisCache](eng),
 						},
 						AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateRedisCacheTimeout,
 						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -765,6 +895,29 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 				)
 			},
 		},
+		{
+			ParentRouter:      redisResourceRouter,
+			Path:              "/operations",
+			ResourceType:      ds_ctrl.RedisCachesResourceType,
+			Method:            v1.OperationHistory,
+			ControllerFactory: defaultoperation.NewListOperationHistory,
+		},
+		{
+			ParentRouter: redisResourceRouter,
+			Path:         "/" + strings.ToLower(recipes.PlanRecipeAction),
+			ResourceType: ds_ctrl.RedisCachesResourceType,
+			Method:       v1.OperationPlanRecipe,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return rp_frontend.NewPlanRecipe(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.RedisCache]{
+						RequestConverter:         ds_conv.RedisCacheDataModelFromVersioned,
+						ResponseConverter:        ds_conv.RedisCacheDataModelToVersioned,
+						AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateRedisCacheTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
 		{
 			ParentRouter: redisResourceRouter,
 			ResourceType: ds_ctrl.RedisCachesResourceType,
@@ -842,6 +995,7 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 						ResponseConverter: ds_conv.SqlDatabaseDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[ds_dm.SqlDatabase]{
 							rp_frontend.PrepareRadiusResource[*ds_dm.SqlDatabase],
+							rp_frontend.ValidateRecipeParameters[*ds_dm.SqlDatabase](eng),
 						},
 						AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateSqlDatabaseTimeout,
 						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -854,12 +1008,13 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 			ResourceType: ds_ctrl.SqlDatabasesResourceType,
 			Method:       v1.OperationPatch,
 			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut(opt,
+				return defaultoperation.NewDefaultAsyncPatch(opt,
 					frontend_ctrl.ResourceOptions[ds_dm.SqlDatabase]{
 						RequestConverter:  ds_conv.SqlDatabaseDataModelFromVersioned,
 						ResponseConverter: ds_conv.SqlDatabaseDataModelToVersioned,
 						UpdateFilters: []frontend_ctrl.UpdateFilter[ds_dm.SqlDatabase]{
 							rp_frontend.PrepareRadiusResource[*ds_dm.SqlDatabase],
+							rp_frontend.ValidateRecipeParameters[*ds_dm.SqlDatabase](eng),
 						},
 						AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateSqlDatabaseTimeout,
 						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -867,6 +1022,29 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 				)
 			},
 		},
+		{
+			ParentRouter:      sqlResourceRouter,
+			Path:              "/operations",
+			ResourceType:      ds_ctrl.SqlDatabasesResourceType,
+			Method:            v1.OperationHistory,
+			ControllerFactory: defaultoperation.NewListOperationHistory,
+		},
+		{
+			ParentRouter: sqlResourceRouter,
+			Path:         "/" + strings.ToLower(recipes.PlanRecipeAction),
+			ResourceType: ds_ctrl.SqlDatabasesResourceType,
+			Method:       v1.OperationPlanRecipe,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return rp_frontend.NewPlanRecipe(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.SqlDatabase]{
+						RequestConverter:         ds_conv.SqlDatabaseDataModelFromVersioned,
+						ResponseConverter:        ds_conv.SqlDatabaseDataModelToVersioned,
+						AsyncOperationTimeout:    ds_ctrl.AsyncCreateOrUpdateSqlDatabaseTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
 		{
 			ParentRouter: sqlResourceRouter,
 			ResourceType: ds_ctrl.SqlDatabasesResourceType,
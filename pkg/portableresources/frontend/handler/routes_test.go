@@ -58,6 +58,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: v1.OperationHistory},
+		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq/operations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: v1.OperationPlanRecipe},
+		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq/planrecipe",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: msg_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq/listsecrets",
@@ -86,6 +94,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/daprpubsub",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: v1.OperationHistory},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/daprpubsub/operations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: v1.OperationPlanRecipe},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/daprpubsub/planrecipe",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: v1.OperationList},
 		Path:          "/providers/applications.dapr/secretstores",
@@ -110,6 +126,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/daprsecretstore",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: v1.OperationHistory},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/daprsecretstore/operations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: v1.OperationPlanRecipe},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/daprsecretstore/planrecipe",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: v1.OperationList},
 		Path:          "/providers/applications.dapr/statestores",
@@ -134,6 +158,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/daprstatestore",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: v1.OperationHistory},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/daprstatestore/operations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: v1.OperationPlanRecipe},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/daprstatestore/planrecipe",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: v1.OperationList},
 		Path:          "/providers/applications.datastores/mongodatabases",
@@ -158,6 +190,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: v1.OperationHistory},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo/operations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: v1.OperationPlanRecipe},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo/planrecipe",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: ds_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo/listsecrets",
@@ -186,6 +226,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: v1.OperationHistory},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis/operations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: v1.OperationPlanRecipe},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis/planrecipe",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: ds_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis/listsecrets",
@@ -214,6 +262,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: v1.OperationHistory},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql/operations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: v1.OperationPlanRecipe},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql/planrecipe",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: ds_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql/listsecrets",
@@ -222,6 +278,18 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Messaging/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.messaging/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Messaging/operationStatuses", Method: v1.OperationCancel},
+		Path:          "/providers/applications.messaging/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000/cancel",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Messaging/deadLetteredOperations", Method: v1.OperationListDeadLetter},
+		Path:          "/providers/applications.messaging/locations/global/deadletteredoperations",
+		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Messaging/deadLetteredOperations", Method: v1.OperationReplayDeadLetter},
+		Path:          "/providers/applications.messaging/locations/global/deadletteredoperations/message/replay",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: "Applications.Messaging/operationResults", Method: v1.OperationGet},
 		Path:          "/providers/applications.messaging/locations/global/operationresults/00000000-0000-0000-0000-000000000000",
@@ -253,7 +321,7 @@ func TestHandlers(t *testing.T) {
 		// Test handlers for UCP resources.
 		rpctest.AssertRouters(t, handlerTests, "/api.ucp.dev", "/planes/radius/local", func(ctx context.Context) (chi.Router, error) {
 			r := chi.NewRouter()
-			return r, AddRoutes(ctx, r, false, ctrl.Options{PathBase: "/api.ucp.dev", DataProvider: mockSP})
+			return r, AddRoutes(ctx, r, false, ctrl.Options{PathBase: "/api.ucp.dev", DataProvider: mockSP}, nil)
 		})
 	})
 
@@ -286,7 +354,7 @@ func TestHandlers(t *testing.T) {
 		// Test handlers for Azure resources
 		rpctest.AssertRouters(t, azureHandlerTests, "", "/subscriptions/00000000-0000-0000-0000-000000000000", func(ctx context.Context) (chi.Router, error) {
 			r := chi.NewRouter()
-			return r, AddRoutes(ctx, r, true, ctrl.Options{PathBase: "", DataProvider: mockSP})
+			return r, AddRoutes(ctx, r, true, ctrl.Options{PathBase: "", DataProvider: mockSP}, nil)
 		})
 	})
 }
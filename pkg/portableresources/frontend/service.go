@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/portableresources/frontend/handler"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 )
 
 type Service struct {
@@ -52,15 +53,21 @@ func (s *Service) Run(ctx context.Context) error {
 		return err
 	}
 
+	recipeControllerConfig, err := controllerconfig.New(s.Options)
+	if err != nil {
+		return err
+	}
+
 	opts := ctrl.Options{
 		Address:       fmt.Sprintf("%s:%d", s.Options.Config.Server.Host, s.Options.Config.Server.Port),
 		PathBase:      s.Options.Config.Server.PathBase,
 		DataProvider:  s.StorageProvider,
 		KubeClient:    s.KubeClient,
 		StatusManager: s.OperationStatusManager,
+		QueueClient:   s.QueueClient,
 	}
 
-	err := s.Start(ctx, server.Options{
+	err = s.Start(ctx, server.Options{
 		Address:     opts.Address,
 		ServiceName: s.ProviderName,
 		Location:    s.Options.Config.Env.RoleLocation,
@@ -69,7 +76,7 @@ func (s *Service) Run(ctx context.Context) error {
 		ArmCertMgr:    s.ARMCertManager,
 		EnableArmAuth: s.Options.Config.Server.EnableArmAuth, // when enabled the client cert validation will be done
 		Configure: func(router chi.Router) error {
-			err := handler.AddRoutes(ctx, router, !hostoptions.IsSelfHosted(), opts)
+			err := handler.AddRoutes(ctx, router, !hostoptions.IsSelfHosted(), opts, recipeControllerConfig.Engine)
 			if err != nil {
 				return err
 			}
@@ -115,9 +115,118 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 		config.Simulated = true
 	}
 
+	recipeConfig := environment.Properties.RecipeConfig
+	if recipeConfig != nil && recipeConfig.Terraform != nil {
+		if recipeConfig.Terraform.Backend != nil {
+			config.RecipeConfig.Terraform.Backend = getTerraformBackend(recipeConfig.Terraform.Backend)
+		}
+		if recipeConfig.Terraform.Providers != nil {
+			config.RecipeConfig.Terraform.Providers = getTerraformProviders(recipeConfig.Terraform.Providers)
+		}
+		if recipeConfig.Terraform.Authentication != nil {
+			config.RecipeConfig.Terraform.Authentication = getTerraformAuthentication(recipeConfig.Terraform.Authentication)
+		}
+	}
+
 	return &config, nil
 }
 
+func getTerraformProviders(providers map[string][]*v20231001preview.ProviderConfigProperties) map[string][]datamodel.ProviderConfigProperties {
+	result := map[string][]datamodel.ProviderConfigProperties{}
+	for name, configs := range providers {
+		result[name] = []datamodel.ProviderConfigProperties{}
+		for _, config := range configs {
+			if config == nil {
+				continue
+			}
+
+			providerConfig := datamodel.ProviderConfigProperties{
+				AdditionalProperties: config.AdditionalProperties,
+			}
+			if config.Secrets != nil {
+				providerConfig.Secrets = map[string]datamodel.SecretReference{}
+				for property, secret := range config.Secrets {
+					if secret == nil {
+						continue
+					}
+					providerConfig.Secrets[property] = getSecretReference(secret)
+				}
+			}
+			result[name] = append(result[name], providerConfig)
+		}
+	}
+
+	return result
+}
+
+func getTerraformAuthentication(authentication *v20231001preview.AuthConfig) datamodel.AuthConfig {
+	result := datamodel.AuthConfig{
+		Registries: getSecretConfigs(authentication.Registries),
+	}
+	if authentication.Git != nil {
+		result.Git.PAT = getSecretConfigs(authentication.Git.Pat)
+		result.Git.SSH = getSecretConfigs(authentication.Git.SSH)
+	}
+
+	return result
+}
+
+func getSecretConfigs(configs map[string]*v20231001preview.SecretConfig) map[string]datamodel.SecretConfig {
+	if configs == nil {
+		return nil
+	}
+
+	result := map[string]datamodel.SecretConfig{}
+	for host, config := range configs {
+		if config != nil {
+			result[host] = datamodel.SecretConfig{Secret: to.String(config.Secret)}
+		}
+	}
+
+	return result
+}
+
+func getSecretReference(secret *v20231001preview.SecretReference) datamodel.SecretReference {
+	return datamodel.SecretReference{
+		Source: to.String(secret.Source),
+		Key:    to.String(secret.Key),
+	}
+}
+
+func getTerraformBackend(b *v20231001preview.TerraformBackendProperties) *datamodel.TerraformBackend {
+	backend := &datamodel.TerraformBackend{
+		Kind: to.String((*string)(b.Kind)),
+	}
+	if b.S3 != nil {
+		backend.S3 = &datamodel.TerraformS3Backend{
+			Bucket:       to.String(b.S3.Bucket),
+			Region:       to.String(b.S3.Region),
+			Endpoint:     to.String(b.S3.Endpoint),
+			UsePathStyle: to.Bool(b.S3.UsePathStyle),
+		}
+	}
+	if b.Pg != nil && b.Pg.ConnectionString != nil {
+		backend.Postgres = &datamodel.TerraformPostgresBackend{
+			ConnectionString: getSecretReference(b.Pg.ConnectionString),
+		}
+	}
+	if b.Azurerm != nil {
+		backend.AzureRM = &datamodel.TerraformAzureRMBackend{
+			SubscriptionID:     to.String(b.Azurerm.SubscriptionID),
+			ResourceGroupName:  to.String(b.Azurerm.ResourceGroupName),
+			StorageAccountName: to.String(b.Azurerm.StorageAccountName),
+			ContainerName:      to.String(b.Azurerm.ContainerName),
+		}
+	}
+	if b.Local != nil {
+		backend.Local = &datamodel.TerraformLocalBackend{
+			Path: to.String(b.Local.Path),
+		}
+	}
+
+	return backend
+}
+
 // LoadRecipe fetches the recipe information from the environment. It returns an error if the environment cannot be fetched.
 func (e *environmentLoader) LoadRecipe(ctx context.Context, recipe *recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, error) {
 	environment, err := util.FetchEnvironment(ctx, recipe.EnvironmentID, e.ArmClientOptions)
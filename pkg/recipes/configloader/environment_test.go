@@ -140,6 +140,93 @@ func TestGetConfiguration(t *testing.T) {
 				Providers: createAWSProvider(),
 			},
 		},
+		{
+			name: "terraform config with env resource",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					RecipeConfig: &model.RecipeConfigProperties{
+						Terraform: &model.TerraformConfigProperties{
+							Backend: &model.TerraformBackendProperties{
+								Kind: to.Ptr(model.TerraformBackendKindPg),
+								Pg: &model.TerraformPostgresBackendProperties{
+									ConnectionString: &model.SecretReference{
+										Source: to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/tfstate"),
+										Key:    to.Ptr("connectionString"),
+									},
+								},
+							},
+							Providers: map[string][]*model.ProviderConfigProperties{
+								"postgresql": {
+									{
+										AdditionalProperties: map[string]any{"host": "localhost"},
+										Secrets: map[string]*model.SecretReference{
+											"password": {
+												Source: to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/pg"),
+												Key:    to.Ptr("password"),
+											},
+										},
+									},
+								},
+							},
+							Authentication: &model.AuthConfig{
+								Git: &model.GitAuthConfig{
+									Pat: map[string]*model.SecretConfig{
+										"github.com": {Secret: to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/github")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Backend: &datamodel.TerraformBackend{
+							Kind: datamodel.TerraformBackendKindPostgres,
+							Postgres: &datamodel.TerraformPostgresBackend{
+								ConnectionString: datamodel.SecretReference{
+									Source: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/tfstate",
+									Key:    "connectionString",
+								},
+							},
+						},
+						Providers: map[string][]datamodel.ProviderConfigProperties{
+							"postgresql": {
+								{
+									AdditionalProperties: map[string]any{"host": "localhost"},
+									Secrets: map[string]datamodel.SecretReference{
+										"password": {
+											Source: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/pg",
+											Key:    "password",
+										},
+									},
+								},
+							},
+						},
+						Authentication: datamodel.AuthConfig{
+							Git: datamodel.GitAuthConfig{
+								PAT: map[string]datamodel.SecretConfig{
+									"github.com": {Secret: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/github"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "invalid app resource",
 			envResource: &model.EnvironmentResource{
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configloader
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+//go:generate mockgen -destination=./mock_secrets_loader.go -package=configloader -self_package github.com/radius-project/radius/pkg/recipes/configloader github.com/radius-project/radius/pkg/recipes/configloader SecretsLoader
+
+var _ SecretsLoader = (*secretsLoader)(nil)
+
+// NewSecretStoreLoader creates a new SecretsLoader instance with the given ARM Client Options.
+func NewSecretStoreLoader(armOptions *arm.ClientOptions) SecretsLoader {
+	return &secretsLoader{ArmClientOptions: armOptions}
+}
+
+// secretsLoader struct provides functionality to get secret information from Applications.Core/secretStores resources.
+type secretsLoader struct {
+	// ArmClientOptions represents the client options for ARM clients.
+	ArmClientOptions *arm.ClientOptions
+}
+
+// LoadSecrets fetches the secrets of the given Applications.Core/secretStores resource. It returns the secret values
+// keyed by the name of the secret.
+func (e *secretsLoader) LoadSecrets(ctx context.Context, secretStoreID string) (map[string]string, error) {
+	secretStore, err := resources.ParseResource(secretStoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := v20231001preview.NewSecretStoresClient(secretStore.RootScope(), &tokencredentials.AnonymousCredential{}, e.ArmClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.ListSecrets(ctx, secretStore.Name(), map[string]any{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the secrets of secret store %q: %w", secretStoreID, err)
+	}
+
+	secrets := map[string]string{}
+	for key, value := range response.Data {
+		if value != nil {
+			secrets[key] = to.String(value.Value)
+		}
+	}
+
+	return secrets, nil
+}
@@ -28,3 +28,9 @@ type ConfigurationLoader interface {
 	// LoadRecipe fetches the recipe information from the environment.
 	LoadRecipe(ctx context.Context, recipe *recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, error)
 }
+
+// SecretsLoader is an interface for fetching the secrets of Applications.Core/secretStores resources.
+type SecretsLoader interface {
+	// LoadSecrets fetches the secrets of the secret store, keyed by the name of the secret.
+	LoadSecrets(ctx context.Context, secretStoreID string) (map[string]string, error)
+}
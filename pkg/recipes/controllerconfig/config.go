@@ -96,8 +96,12 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 			),
 			recipes.TemplateKindTerraform: driver.NewTerraformDriver(options.UCPConnection, provider.NewSecretProvider(options.Config.SecretProvider),
 				driver.TerraformOptions{
-					Path: options.Config.Terraform.Path,
-				}, cfg.K8sClients.ClientSet),
+					Path:           options.Config.Terraform.Path,
+					CacheEnabled:   options.Config.Terraform.Cache.Enabled,
+					CacheMaxSizeMB: options.Config.Terraform.Cache.MaxSizeMB,
+				}, cfg.K8sClients.ClientSet, configloader.NewSecretStoreLoader(clientOptions)),
+			recipes.TemplateKindHelm:       driver.NewHelmDriver(options.K8sConfig, cfg.K8sClients.RuntimeClient),
+			recipes.TemplateKindKubernetes: driver.NewKubernetesDriver(cfg.K8sClients.RuntimeClient, cfg.ResourceClient),
 		},
 	})
 
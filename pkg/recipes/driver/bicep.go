@@ -38,6 +38,7 @@ import (
 	"github.com/radius-project/radius/pkg/rp/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	clients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -83,43 +84,9 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	logger := logr.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
 
-	recipeData := make(map[string]any)
-	downloadStartTime := time.Now()
-	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData)
-	if err != nil {
-		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
-	}
-	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
-
-	// create the context object to be passed to the recipe deployment
-	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	deployment, deploymentID, err := d.prepareDeployment(ctx, opts)
 	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
-	}
-
-	// get the parameters after resolving the conflict between developer and operator parameters
-	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
-	isContextParameterDefined := hasContextParameter(recipeData)
-	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
-
-	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
-	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
-	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
-	}
-
-	// Provider config will specify the Azure and AWS scopes (if provided).
-	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
-
-	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
-	if providerConfig.AWS != nil {
-		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
-	}
-	if providerConfig.Az != nil {
-		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
+		return nil, err
 	}
 
 	if opts.Configuration.Simulated {
@@ -127,16 +94,12 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 		return nil, nil
 	}
 
+	recorder := oplog.FromContext(ctx)
+	recorder.Info(recipes.TemplateKindBicep, fmt.Sprintf("Starting deployment %q.", deploymentID.Name()))
+
 	poller, err := d.DeploymentClient.CreateOrUpdate(
 		ctx,
-		clients.Deployment{
-			Properties: &clients.DeploymentProperties{
-				Mode:           armresources.DeploymentModeIncremental,
-				ProviderConfig: &providerConfig,
-				Parameters:     parameters,
-				Template:       recipeData,
-			},
-		},
+		deployment,
 		deploymentID.String(),
 		clients.DeploymentsClientAPIVersion,
 	)
@@ -148,23 +111,31 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
 	}
+	recorder.Info(recipes.TemplateKindBicep, fmt.Sprintf("Deployment %q succeeded.", deploymentID.Name()))
 
 	recipeResponse, err := d.prepareRecipeResponse(resp.Properties.Outputs, resp.Properties.OutputResources)
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
 	}
 
+	for _, id := range recipeResponse.Resources {
+		recorder.Info(recipes.TemplateKindBicep, fmt.Sprintf("Deployed resource %q.", id))
+	}
+
 	// When a Radius portable resource consuming a recipe is redeployed, Garbage collection of the recipe resources that aren't included
 	// in the currently deployed resources compared to the list of resources from the previous deployment needs to be deleted
 	// as bicep does not take care of automatically deleting the unused resources.
 	// Identify the output resources that are no longer relevant to the recipe.
 	garbageCollectionStartTime := time.Now()
-	diff, err := d.getGCOutputResources(recipeResponse.Resources, opts.PrevState)
+	diff, err := getGCOutputResources(recipeResponse.Resources, opts.PrevState)
 	if err != nil {
 		return nil, err
 	}
 
 	// Deleting obsolete output resources.
+	if len(diff) > 0 {
+		recorder.Info(recipes.TemplateKindBicep, fmt.Sprintf("Deleting %d resources which are no longer deployed by the recipe.", len(diff)))
+	}
 	err = d.Delete(ctx, DeleteOptions{
 		OutputResources: diff,
 	})
@@ -178,6 +149,66 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	return recipeResponse, nil
 }
 
+// Plan returns a RecipePlanNotSupported error. The deployment engine does not run a what-if of a deployment, so the
+// changes of a bicep recipe cannot be determined without deploying it.
+func (d *bicepDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	return nil, recipes.NewRecipeError(recipes.RecipePlanNotSupported, fmt.Sprintf("plan is not supported for bicep recipes: %q", opts.Definition.Name), recipes_util.RecipeSetupError, nil)
+}
+
+// prepareDeployment fetches recipe contents from container registry and creates the deployment of the bicep template for
+// the recipe along with its deployment ID.
+func (d *bicepDriver) prepareDeployment(ctx context.Context, opts ExecuteOptions) (clients.Deployment, resources.ID, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	recipeData := make(map[string]any)
+	downloadStartTime := time.Now()
+	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return clients.Deployment{}, resources.ID{}, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	// create the context object to be passed to the recipe deployment
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return clients.Deployment{}, resources.ID{}, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	// get the parameters after resolving the conflict between developer and operator parameters
+	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
+	isContextParameterDefined := hasContextParameter(recipeData)
+	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
+
+	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	if err != nil {
+		return clients.Deployment{}, resources.ID{}, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	// Provider config will specify the Azure and AWS scopes (if provided).
+	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
+
+	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
+	if providerConfig.AWS != nil {
+		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
+	}
+	if providerConfig.Az != nil {
+		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
+	}
+
+	return clients.Deployment{
+		Properties: &clients.DeploymentProperties{
+			Mode:           armresources.DeploymentModeIncremental,
+			ProviderConfig: &providerConfig,
+			Parameters:     parameters,
+			Template:       recipeData,
+		},
+	}, deploymentID, nil
+}
+
 // Delete deletes all of the output resources that are marked as managed by Radius.
 // It will create a goroutine for each resource to be deleted and wait for them to finish,
 // retrying if necessary.
@@ -213,6 +244,7 @@ func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 				if err != nil {
 					if attempt <= d.options.DeleteRetryCount {
 						logger.V(ucplog.LevelInfo).Error(err, "attempt failed", "delay", d.options.DeleteRetryDelaySeconds)
+						oplog.FromContext(ctx).Info(recipes.TemplateKindBicep, fmt.Sprintf("Deleting resource %q failed, retrying in %d seconds: %s", id, d.options.DeleteRetryDelaySeconds, err.Error()))
 						time.Sleep(time.Duration(d.options.DeleteRetryDelaySeconds) * time.Second)
 						continue
 					}
@@ -222,6 +254,7 @@ func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 
 				// If the err is nil, then the resource is deleted successfully
 				logger.V(ucplog.LevelInfo).Info(fmt.Sprintf("Deleted output resource: %q", id))
+				oplog.FromContext(ctx).Info(recipes.TemplateKindBicep, fmt.Sprintf("Deleted resource %q.", id))
 				return nil
 			}
 
@@ -371,7 +404,7 @@ func (d *bicepDriver) prepareRecipeResponse(outputs any, resources []*armresourc
 
 // getGCOutputResources [GC stands for Garbage Collection] compares two slices of resource ids and
 // returns a slice of OutputResources that contains the elements that are in the "previous" slice but not in the "current".
-func (d *bicepDriver) getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
+func getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
 	// We can easily determine which resources have changed via a brute-force search comparing IDs.
 	// The lists of resources we work with are small, so this is fine.
 	diff := []rpv1.OutputResource{}
@@ -399,3 +432,27 @@ func (d *bicepDriver) getGCOutputResources(current []string, previous []string)
 
 	return diff, nil
 }
+
+// appendGCChanges adds a delete change to the plan for each resource of the previous deployment that is no longer
+// deployed by the recipe. These resources are garbage collected when the recipe is executed.
+func appendGCChanges(plan *recipes.RecipePlan, prevState []string) error {
+	current := []string{}
+	for _, change := range plan.Changes {
+		current = append(current, change.ID)
+	}
+
+	diff, err := getGCOutputResources(current, prevState)
+	if err != nil {
+		return err
+	}
+
+	for _, outputResource := range diff {
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{
+			ID:         outputResource.ID.String(),
+			Type:       outputResource.ID.Type(),
+			ChangeType: recipes.ChangeTypeDelete,
+		})
+	}
+
+	return nil
+}
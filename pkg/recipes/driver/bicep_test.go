@@ -519,7 +519,6 @@ func Test_Bicep_GetRecipeMetadata_Error(t *testing.T) {
 }
 
 func Test_GetGCOutputResources(t *testing.T) {
-	d := &bicepDriver{}
 	before := []string{
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
@@ -538,13 +537,12 @@ func Test_GetGCOutputResources(t *testing.T) {
 			RadiusManaged: to.Ptr(true),
 		},
 	}
-	res, err := d.getGCOutputResources(after, before)
+	res, err := getGCOutputResources(after, before)
 	require.NoError(t, err)
 	require.Equal(t, exp, res)
 }
 
 func Test_GetGCOutputResources_NoDiff(t *testing.T) {
-	d := &bicepDriver{}
 	before := []string{
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
@@ -554,7 +552,7 @@ func Test_GetGCOutputResources_NoDiff(t *testing.T) {
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
 	}
 	exp := []rpv1.OutputResource{}
-	res, err := d.getGCOutputResources(after, before)
+	res, err := getGCOutputResources(after, before)
 	require.NoError(t, err)
 	require.Equal(t, exp, res)
 }
@@ -590,3 +588,57 @@ func Test_Bicep_Delete_Success_AfterRetry(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func Test_Bicep_Plan_NotSupported(t *testing.T) {
+	ctx := testcontext.New(t)
+	driver := &bicepDriver{}
+
+	_, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Definition: recipes.EnvironmentDefinition{
+				Name:         "mongodb",
+				Driver:       recipes.TemplateKindBicep,
+				TemplatePath: "radiusdev.azurecr.io/recipes/mongodb:1.0",
+			},
+		},
+	})
+
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipePlanNotSupported, recipeError.ErrorDetails.Code)
+}
+
+func Test_AppendGCChanges(t *testing.T) {
+	plan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				ID:         "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
+				Type:       "System.Test/testResources",
+				ChangeType: recipes.ChangeTypeNoChange,
+			},
+		},
+	}
+	prevState := []string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
+	}
+
+	err := appendGCChanges(plan, prevState)
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				ID:         "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
+				Type:       "System.Test/testResources",
+				ChangeType: recipes.ChangeTypeNoChange,
+			},
+			{
+				ID:         "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
+				Type:       "System.Test/testResources",
+				ChangeType: recipes.ChangeTypeDelete,
+			},
+		},
+	}
+	require.Equal(t, expected, plan)
+}
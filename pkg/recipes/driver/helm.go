@@ -0,0 +1,270 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	"github.com/radius-project/radius/pkg/recipes/manifest"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/exp/slices"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ Driver = (*helmDriver)(nil)
+
+// NewHelmDriver creates a new instance of driver to execute a Helm recipe.
+func NewHelmDriver(restConfig *rest.Config, k8sClient runtimeclient.Client) Driver {
+	return &helmDriver{
+		helmExecutor: helm.NewExecutor(restConfig),
+		k8sClient:    k8sClient,
+	}
+}
+
+// helmDriver represents a driver to interact with Helm Recipe - deploy recipe, delete resources, etc.
+type helmDriver struct {
+	// helmExecutor is used to install, upgrade and uninstall Helm releases.
+	helmExecutor helm.HelmExecutor
+
+	// k8sClient is used to read the recipe output from the Secrets and ConfigMaps deployed by the chart.
+	k8sClient runtimeclient.Client
+}
+
+// Execute installs or upgrades the Helm release for the recipe and returns the resources deployed by the chart along with
+// the values and secrets of the recipe output. The recipe output is read from the chart NOTES when they contain a JSON
+// object with a "result" property, and from the Secrets and ConfigMaps deployed by the chart that have the
+// "radapp.io/recipe-output" label set to "true".
+func (d *helmDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping deployment")
+		return nil, nil
+	}
+
+	rel, err := d.helmExecutor.Deploy(ctx, helm.Options{
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	recipeOutputs, err := d.prepareRecipeResponse(ctx, rel)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output: %s", err.Error()), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return recipeOutputs, nil
+}
+
+// Delete uninstalls the Helm release for the recipe.
+func (d *helmDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	err := d.helmExecutor.Delete(ctx, helm.Options{
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+	})
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetRecipeErrorDetails(err))
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata returns the top-level default values of the Helm chart as the recipe parameters.
+func (d *helmDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	recipeData, err := d.helmExecutor.GetRecipeMetadata(ctx, helm.Options{
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetRecipeErrorDetails(err))
+	}
+
+	return recipeData, nil
+}
+
+// Plan runs a dry run of the install or upgrade of the Helm release for the recipe and compares the objects rendered for
+// the planned release with the live objects in the cluster, so that objects changed or deleted outside of Helm show up as
+// changes. Objects of the currently deployed release that are not rendered for the planned release are deleted by the upgrade.
+func (d *helmDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	current, planned, err := d.helmExecutor.Plan(ctx, helm.Options{
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+	if planned == nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, "helm release is empty", recipes_util.ExecutionError, nil)
+	}
+
+	currentObjects, err := d.releaseObjects(current)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+	plannedObjects, err := d.releaseObjects(planned)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	plan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	rendered := map[string]bool{}
+	for _, obj := range plannedObjects {
+		rendered[strings.ToLower(kubernetesObjectID(obj))] = true
+
+		changeType, err := planKubernetesObject(ctx, d.k8sClient, obj)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+		}
+		plan.Changes = append(plan.Changes, kubernetesObjectChange(obj, changeType))
+	}
+
+	for _, obj := range currentObjects {
+		if !rendered[strings.ToLower(kubernetesObjectID(obj))] {
+			plan.Changes = append(plan.Changes, kubernetesObjectChange(obj, recipes.ChangeTypeDelete))
+		}
+	}
+
+	return plan, nil
+}
+
+// prepareRecipeResponse populates the recipe response from the chart NOTES, the labeled output Secrets and ConfigMaps
+// and the resources in the release manifest.
+func (d *helmDriver) prepareRecipeResponse(ctx context.Context, rel *release.Release) (*recipes.RecipeOutput, error) {
+	if rel == nil {
+		return nil, errors.New("helm release is empty")
+	}
+
+	recipeResponse := &recipes.RecipeOutput{
+		Resources: []string{},
+		Secrets:   map[string]any{},
+		Values:    map[string]any{},
+	}
+
+	// NOTES are free-form text for most charts, so they are only used when they are a JSON object with a "result" property.
+	if rel.Info != nil {
+		notes := map[string]any{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(rel.Info.Notes)), &notes); err == nil {
+			if result, ok := notes[recipes.ResultPropertyName].(map[string]any); ok {
+				if err := recipeResponse.PrepareRecipeResponse(result); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	objects, err := d.releaseObjects(rel)
+	if err != nil {
+		return nil, err
+	}
+
+	uniqueResourceIDs := []string{}
+	for _, val := range recipeResponse.Resources {
+		uniqueResourceIDs = append(uniqueResourceIDs, strings.ToLower(val))
+	}
+
+	for _, obj := range objects {
+		gvk := obj.GroupVersionKind()
+		id := kubernetesObjectID(obj)
+		if !slices.Contains(uniqueResourceIDs, strings.ToLower(id)) {
+			uniqueResourceIDs = append(uniqueResourceIDs, strings.ToLower(id))
+			recipeResponse.Resources = append(recipeResponse.Resources, id)
+		}
+
+		if gvk.Group != "" || obj.GetLabels()[helm.OutputLabel] != "true" {
+			continue
+		}
+
+		key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		switch gvk.Kind {
+		case "ConfigMap":
+			configMap := &corev1.ConfigMap{}
+			if err := d.k8sClient.Get(ctx, key, configMap); err != nil {
+				return nil, fmt.Errorf("failed to get the recipe output config map %q: %w", key.String(), err)
+			}
+			for k, v := range configMap.Data {
+				recipeResponse.Values[k] = outputValue(v)
+			}
+		case "Secret":
+			secret := &corev1.Secret{}
+			if err := d.k8sClient.Get(ctx, key, secret); err != nil {
+				return nil, fmt.Errorf("failed to get the recipe output secret %q: %w", key.String(), err)
+			}
+			for k, v := range secret.Data {
+				recipeResponse.Secrets[k] = string(v)
+			}
+		}
+	}
+
+	return recipeResponse, nil
+}
+
+// releaseObjects parses the objects in the manifest of the Helm release. Namespaced objects without a namespace are deployed
+// into the release namespace by Helm, so their namespace is set to the release namespace.
+func (d *helmDriver) releaseObjects(rel *release.Release) ([]*unstructured.Unstructured, error) {
+	if rel == nil {
+		return []*unstructured.Unstructured{}, nil
+	}
+
+	objects, err := manifest.Parse([]byte(rel.Manifest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the Helm release manifest: %w", err)
+	}
+
+	for _, obj := range objects {
+		if obj.GetNamespace() == "" {
+			namespaced, err := d.k8sClient.IsObjectNamespaced(obj)
+			if err != nil || namespaced {
+				obj.SetNamespace(rel.Namespace)
+			}
+		}
+	}
+
+	return objects, nil
+}
+
+// outputValue converts a config map value to a recipe output value. Config map values are always strings, so numbers
+// and booleans are converted to their typed values (for example, a port).
+func outputValue(value string) any {
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return float64(i)
+	}
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return value
+}
@@ -0,0 +1,437 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const testHelmManifest = `
+---
+# Source: redis/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+spec:
+  ports:
+    - port: 6379
+---
+# Source: redis/templates/output.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis-output
+  labels:
+    radapp.io/recipe-output: "true"
+data:
+  host: redis.default.svc.cluster.local
+---
+# Source: redis/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: redis-secret
+  labels:
+    radapp.io/recipe-output: "true"
+---
+# Source: redis/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: redis
+  namespace: other
+`
+
+func setupHelm(t *testing.T) (*helm.MockHelmExecutor, *helmDriver) {
+	ctrl := gomock.NewController(t)
+	executor := helm.NewMockHelmExecutor(ctrl)
+
+	outputLabels := map[string]string{helm.OutputLabel: "true"}
+	builder := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "redis-output", Namespace: "default", Labels: outputLabels},
+		Data: map[string]string{
+			"host": "redis.default.svc.cluster.local",
+			"port": "6379",
+			"tls":  "false",
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "redis-secret", Namespace: "default", Labels: outputLabels},
+		Data: map[string][]byte{
+			"password": []byte("p@ssw0rd"),
+		},
+	}, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "default"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Port: 6379}},
+		},
+	}).WithInterceptorFuncs(interceptor.Funcs{
+		// The fake client does not support server-side apply, so a dry-run apply merges the object into the live object.
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			u := obj.(*unstructured.Unstructured)
+			live := &unstructured.Unstructured{}
+			live.SetGroupVersionKind(u.GroupVersionKind())
+			if err := c.Get(ctx, client.ObjectKeyFromObject(u), live); err != nil {
+				return err
+			}
+			u.Object = mergeObject(live.Object, u.Object)
+			return nil
+		},
+	})
+
+	return executor, &helmDriver{helmExecutor: executor, k8sClient: builder.Build()}
+}
+
+func Test_Helm_Execute_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	executor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(&release.Release{
+		Name:      "test-redis-recipe-12345678",
+		Namespace: "default",
+		Manifest:  testHelmManifest,
+		Info: &release.Info{
+			Notes: `{"result": {"values": {"database": "0"}, "resources": ["/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"]}}`,
+		},
+	}, nil)
+
+	result, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipeOutput{
+		Resources: []string{
+			"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis",
+			"/planes/kubernetes/local/namespaces/default/providers/core/ConfigMap/redis-output",
+			"/planes/kubernetes/local/namespaces/default/providers/core/Secret/redis-secret",
+			"/planes/kubernetes/local/namespaces/other/providers/apps/Deployment/redis",
+		},
+		Values: map[string]any{
+			"database": "0",
+			"host":     "redis.default.svc.cluster.local",
+			"port":     float64(6379),
+			"tls":      false,
+		},
+		Secrets: map[string]any{
+			"password": "p@ssw0rd",
+		},
+	}
+	require.Equal(t, expected, result)
+}
+
+func Test_Helm_Execute_TextNotes(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	executor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(&release.Release{
+		Namespace: "default",
+		Info:      &release.Info{Notes: "Redis can be accessed on port 6379."},
+	}, nil)
+
+	result, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipeOutput{Resources: []string{}, Values: map[string]any{}, Secrets: map[string]any{}}, result)
+}
+
+func Test_Helm_Execute_MissingOutput(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	executor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(&release.Release{
+		Namespace: "test-ns",
+		Manifest:  testHelmManifest,
+	}, nil)
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.InvalidRecipeOutputs, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
+
+func Test_Helm_Execute_DeploymentFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	executor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(nil, errors.New("failed to install chart"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Equal(t, &recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDeploymentFailed,
+			Message: "failed to install chart",
+		},
+		DeploymentStatus: "executionError",
+	}, err)
+}
+
+func Test_Helm_Execute_SimulatedEnvironment(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	envConfig.Simulated = true
+
+	result, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func Test_Helm_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	t.Run("success", func(t *testing.T) {
+		executor, driver := setupHelm(t)
+		executor.EXPECT().Delete(ctx, gomock.Any()).Times(1).Return(nil)
+
+		err := driver.Delete(ctx, DeleteOptions{
+			BaseOptions: BaseOptions{
+				Configuration: envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    envRecipe,
+			},
+			OutputResources: []rpv1.OutputResource{},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		executor, driver := setupHelm(t)
+		executor.EXPECT().Delete(ctx, gomock.Any()).Times(1).Return(errors.New("failed to uninstall release"))
+
+		err := driver.Delete(ctx, DeleteOptions{
+			BaseOptions: BaseOptions{
+				Configuration: envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    envRecipe,
+			},
+		})
+		require.Equal(t, &recipes.RecipeError{
+			ErrorDetails: v1.ErrorDetails{
+				Code:    recipes.RecipeDeletionFailed,
+				Message: "failed to uninstall release",
+			},
+		}, err)
+	})
+}
+
+func Test_Helm_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+	_, recipeMetadata, envRecipe := buildTestInputs()
+
+	expected := map[string]any{
+		"parameters": map[string]any{
+			"replicas": map[string]any{"type": "number", "defaultValue": float64(1)},
+		},
+	}
+	executor.EXPECT().GetRecipeMetadata(ctx, gomock.Any()).Times(1).Return(expected, nil)
+
+	result, err := driver.GetRecipeMetadata(ctx, BaseOptions{
+		Recipe:     recipeMetadata,
+		Definition: envRecipe,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func Test_Helm_Plan(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	plannedManifest := `
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+spec:
+  ports:
+    - port: 6380
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis-output
+  labels:
+    radapp.io/recipe-output: "true"
+data:
+  host: redis.default.svc.cluster.local
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: redis
+  namespace: other
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: redis
+`
+
+	executor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(
+		&release.Release{Namespace: "default", Manifest: testHelmManifest},
+		&release.Release{Namespace: "default", Manifest: plannedManifest},
+		nil)
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis", Type: "core/Service", ChangeType: recipes.ChangeTypeModify},
+			{ID: "/planes/kubernetes/local/namespaces/default/providers/core/ConfigMap/redis-output", Type: "core/ConfigMap", ChangeType: recipes.ChangeTypeNoChange},
+			// The Deployment is in the deployed release, but it was deleted from the cluster outside of Helm.
+			{ID: "/planes/kubernetes/local/namespaces/other/providers/apps/Deployment/redis", Type: "apps/Deployment", ChangeType: recipes.ChangeTypeCreate},
+			{ID: "/planes/kubernetes/local/namespaces/default/providers/core/ServiceAccount/redis", Type: "core/ServiceAccount", ChangeType: recipes.ChangeTypeCreate},
+			{ID: "/planes/kubernetes/local/namespaces/default/providers/core/Secret/redis-secret", Type: "core/Secret", ChangeType: recipes.ChangeTypeDelete},
+		},
+	}
+	require.Equal(t, expected, plan)
+}
+
+func Test_Helm_Plan_NewRelease(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	executor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(nil, &release.Release{Namespace: "default", Manifest: testHelmManifest}, nil)
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis", Type: "core/Service", ChangeType: recipes.ChangeTypeNoChange},
+			{ID: "/planes/kubernetes/local/namespaces/default/providers/core/ConfigMap/redis-output", Type: "core/ConfigMap", ChangeType: recipes.ChangeTypeNoChange},
+			{ID: "/planes/kubernetes/local/namespaces/default/providers/core/Secret/redis-secret", Type: "core/Secret", ChangeType: recipes.ChangeTypeNoChange},
+			{ID: "/planes/kubernetes/local/namespaces/other/providers/apps/Deployment/redis", Type: "apps/Deployment", ChangeType: recipes.ChangeTypeCreate},
+		},
+	}
+	require.Equal(t, expected, plan)
+}
+
+func Test_Helm_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	executor, driver := setupHelm(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	executor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(nil, nil, errors.New("failed to render chart"))
+
+	_, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipePlanFailed, recipes.GetRecipeErrorDetails(err).Code)
+}
+
+// mergeObject merges the fields of the patch into the live object like a server-side apply which only sets fields.
+// Lists of the same length are merged item by item.
+func mergeObject(live, patch map[string]any) map[string]any {
+	merged := map[string]any{}
+	for k, v := range live {
+		merged[k] = v
+	}
+	for k, v := range patch {
+		merged[k] = mergeValue(merged[k], v)
+	}
+	return merged
+}
+
+func mergeValue(live, patch any) any {
+	switch patchValue := patch.(type) {
+	case map[string]any:
+		if liveValue, ok := live.(map[string]any); ok {
+			return mergeObject(liveValue, patchValue)
+		}
+	case []any:
+		if liveValue, ok := live.([]any); ok && len(liveValue) == len(patchValue) {
+			merged := make([]any, len(patchValue))
+			for i := range patchValue {
+				merged[i] = mergeValue(liveValue[i], patchValue[i])
+			}
+			return merged
+		}
+	}
+	return patch
+}
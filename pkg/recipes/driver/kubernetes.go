@@ -0,0 +1,377 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/manifest"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ Driver = (*kubernetesDriver)(nil)
+
+// NewKubernetesDriver creates a new instance of driver to execute a Kubernetes manifest recipe.
+func NewKubernetesDriver(k8sClient runtimeclient.Client, resourceClient processors.ResourceClient) Driver {
+	return &kubernetesDriver{
+		k8sClient:      k8sClient,
+		resourceClient: resourceClient,
+		fetch:          manifest.Fetch,
+	}
+}
+
+// kubernetesDriver represents a driver to interact with Kubernetes manifest recipes - deploy recipe, delete resources, etc.
+type kubernetesDriver struct {
+	// k8sClient is used to apply the recipe manifests and read the recipe output Secret.
+	k8sClient runtimeclient.Client
+
+	// resourceClient is used to delete the resources deployed by the recipe.
+	resourceClient processors.ResourceClient
+
+	// fetch downloads the recipe manifests.
+	fetch manifest.FetchFunc
+}
+
+// Execute fetches and renders the recipe manifests and server-side applies the rendered objects into the namespace of
+// the resource. The recipe output values and secrets are read from the deployed Secret with the "radapp.io/recipe-output"
+// annotation set to "true". Objects that were deployed previously and are no longer rendered by the recipe are deleted.
+func (d *kubernetesDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping deployment")
+		return nil, nil
+	}
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	objects, err := d.render(ctx, opts, recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	recipeResponse, err := d.apply(ctx, objects, recipeContext)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	if err := d.readOutput(ctx, objects, recipeResponse); err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output: %s", err.Error()), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	// Objects removed from the recipe since the previous deployment are not deleted by server-side apply, so they are
+	// garbage collected by comparing the deployed resources with the resources of the previous deployment.
+	diff, err := getGCOutputResources(recipeResponse.Resources, opts.PrevState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Delete(ctx, DeleteOptions{OutputResources: diff}); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), recipes_util.ExecutionError, nil)
+	}
+
+	return recipeResponse, nil
+}
+
+// Plan renders the recipe manifests and compares the rendered objects with the objects deployed in the cluster. Objects
+// that were deployed previously and are no longer rendered by the recipe are planned for deletion.
+func (d *kubernetesDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	objects, err := d.render(ctx, opts, recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	for _, obj := range objects {
+		if err := d.prepareObject(obj, recipeContext); err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+		}
+
+		changeType, err := planKubernetesObject(ctx, d.k8sClient, obj)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+		}
+
+		plan.Changes = append(plan.Changes, kubernetesObjectChange(obj, changeType))
+	}
+
+	if err := appendGCChanges(plan, opts.PrevState); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// Delete deletes the output resources of the recipe that are managed by Radius.
+func (d *kubernetesDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for _, outputResource := range opts.OutputResources {
+		id := outputResource.ID.String()
+		if outputResource.RadiusManaged == nil || !*outputResource.RadiusManaged {
+			logger.Info(fmt.Sprintf("Skipping deletion of output resource: %q, not managed by Radius", id))
+			continue
+		}
+
+		if err := d.resourceClient.Delete(ctx, id); err != nil {
+			return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetRecipeErrorDetails(err))
+		}
+		logger.Info(fmt.Sprintf("Deleted output resource: %q", id))
+		oplog.FromContext(ctx).Info(recipes.TemplateKindKubernetes, fmt.Sprintf("Deleted resource %q.", id))
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata returns the default values declared in the values.yaml file of the recipe as the recipe parameters.
+func (d *kubernetesDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	dir, err := os.MkdirTemp("", "kubernetes-recipe-*")
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetRecipeErrorDetails(err))
+	}
+	defer os.RemoveAll(dir)
+
+	recipeDir, err := d.fetch(ctx, opts.Definition.TemplatePath, opts.Definition.TemplateVersion, dir)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetRecipeErrorDetails(err))
+	}
+
+	defaults, err := manifest.LoadDefaults(recipeDir)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetRecipeErrorDetails(err))
+	}
+
+	return map[string]any{
+		"parameters": recipes_util.ParametersFromDefaults(defaults),
+	}, nil
+}
+
+// render fetches the recipe manifests and renders them with the recipe parameters and the recipe context.
+func (d *kubernetesDriver) render(ctx context.Context, opts ExecuteOptions, recipeContext *recipecontext.Context) ([]*unstructured.Unstructured, error) {
+	dir, err := os.MkdirTemp("", "kubernetes-recipe-*")
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+	defer os.RemoveAll(dir)
+
+	recipeDir, err := d.fetch(ctx, opts.Definition.TemplatePath, opts.Definition.TemplateVersion, dir)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	parameters, err := manifest.LoadDefaults(recipeDir)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+	for k, v := range opts.Definition.Parameters {
+		parameters[k] = v
+	}
+	for k, v := range opts.Recipe.Parameters {
+		parameters[k] = v
+	}
+
+	// Templates address the context by its JSON names, so the context is converted to a plain map.
+	b, err := json.Marshal(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+	contextValue := map[string]any{}
+	if err := json.Unmarshal(b, &contextValue); err != nil {
+		return nil, err
+	}
+
+	objects, err := manifest.Render(recipeDir, map[string]any{
+		manifest.ParametersKey:              parameters,
+		recipecontext.RecipeContextParamKey: contextValue,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+	oplog.FromContext(ctx).Info(recipes.TemplateKindKubernetes, fmt.Sprintf("Rendered %d objects from the recipe manifests.", len(objects)))
+
+	return objects, nil
+}
+
+// apply server-side applies the objects into the namespace of the resource with the descriptive labels of the resource
+// and returns the IDs of the applied objects as the recipe resources.
+func (d *kubernetesDriver) apply(ctx context.Context, objects []*unstructured.Unstructured, recipeContext *recipecontext.Context) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	recorder := oplog.FromContext(ctx)
+
+	recipeResponse := &recipes.RecipeOutput{
+		Resources: []string{},
+		Secrets:   map[string]any{},
+		Values:    map[string]any{},
+	}
+
+	for _, obj := range objects {
+		if err := d.prepareObject(obj, recipeContext); err != nil {
+			return nil, err
+		}
+
+		logger.Info(fmt.Sprintf("Applying %s %q", obj.GetKind(), obj.GetName()), "namespace", obj.GetNamespace())
+		err := d.k8sClient.Patch(ctx, obj, runtimeclient.Apply, runtimeclient.FieldOwner(kubernetes.FieldManager), runtimeclient.ForceOwnership)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		recorder.Info(recipes.TemplateKindKubernetes, fmt.Sprintf("Applied %s %q.", obj.GetKind(), runtimeclient.ObjectKeyFromObject(obj).String()))
+
+		id := kubernetesObjectID(obj)
+		if !slices.ContainsFunc(recipeResponse.Resources, func(r string) bool { return strings.EqualFold(r, id) }) {
+			recipeResponse.Resources = append(recipeResponse.Resources, id)
+		}
+	}
+
+	return recipeResponse, nil
+}
+
+// prepareObject sets the namespace of the resource on namespaced objects and adds the descriptive labels of the resource.
+func (d *kubernetesDriver) prepareObject(obj *unstructured.Unstructured, recipeContext *recipecontext.Context) error {
+	namespaced, err := d.k8sClient.IsObjectNamespaced(obj)
+	if err != nil {
+		return fmt.Errorf("failed to get the scope of %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	if namespaced {
+		namespace := ""
+		if recipeContext.Runtime.Kubernetes != nil {
+			namespace = recipeContext.Runtime.Kubernetes.Namespace
+		}
+		obj.SetNamespace(namespace)
+	}
+
+	objLabels := obj.GetLabels()
+	if objLabels == nil {
+		objLabels = map[string]string{}
+	}
+	for k, v := range kubernetes.MakeDescriptiveLabels(recipeContext.Application.Name, recipeContext.Resource.Name, recipeContext.Resource.Type) {
+		objLabels[k] = v
+	}
+	obj.SetLabels(objLabels)
+
+	return nil
+}
+
+// planKubernetesObject compares the object with the live object in the cluster. An existing object is compared with the
+// result of a server-side dry-run apply of the object so that defaulted fields do not show up as changes.
+func planKubernetesObject(ctx context.Context, k8sClient runtimeclient.Client, obj *unstructured.Unstructured) (recipes.ChangeType, error) {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	err := k8sClient.Get(ctx, runtimeclient.ObjectKeyFromObject(obj), existing)
+	if apierrors.IsNotFound(err) {
+		return recipes.ChangeTypeCreate, nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+
+	planned := obj.DeepCopy()
+	err = k8sClient.Patch(ctx, planned, runtimeclient.Apply, runtimeclient.FieldOwner(kubernetes.FieldManager), runtimeclient.ForceOwnership, runtimeclient.DryRunAll)
+	if err != nil {
+		return "", fmt.Errorf("failed to dry run the apply of %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+
+	for _, o := range []*unstructured.Unstructured{existing, planned} {
+		unstructured.RemoveNestedField(o.Object, "metadata", "managedFields")
+		unstructured.RemoveNestedField(o.Object, "metadata", "resourceVersion")
+		unstructured.RemoveNestedField(o.Object, "metadata", "generation")
+		unstructured.RemoveNestedField(o.Object, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(o.Object, "metadata", "uid")
+		unstructured.RemoveNestedField(o.Object, "status")
+	}
+
+	if equality.Semantic.DeepEqual(existing.Object, planned.Object) {
+		return recipes.ChangeTypeNoChange, nil
+	}
+
+	return recipes.ChangeTypeModify, nil
+}
+
+// readOutput reads the recipe values and secrets from the applied Secret with the recipe output annotation. The keys
+// listed in the "radapp.io/recipe-output-values" annotation are returned as values and all other keys as secrets.
+func (d *kubernetesDriver) readOutput(ctx context.Context, objects []*unstructured.Unstructured, recipeResponse *recipes.RecipeOutput) error {
+	for _, obj := range objects {
+		gvk := obj.GroupVersionKind()
+		if gvk.Group != "" || gvk.Kind != "Secret" || obj.GetAnnotations()[manifest.OutputAnnotation] != "true" {
+			continue
+		}
+
+		key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		secret := &corev1.Secret{}
+		if err := d.k8sClient.Get(ctx, key, secret); err != nil {
+			return fmt.Errorf("failed to get the recipe output secret %q: %w", key.String(), err)
+		}
+
+		valueKeys := []string{}
+		for _, k := range strings.Split(secret.Annotations[manifest.OutputValuesAnnotation], ",") {
+			if k = strings.TrimSpace(k); k != "" {
+				valueKeys = append(valueKeys, k)
+			}
+		}
+
+		for k, v := range secret.Data {
+			if slices.Contains(valueKeys, k) {
+				recipeResponse.Values[k] = outputValue(string(v))
+			} else {
+				recipeResponse.Secrets[k] = string(v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// kubernetesObjectID returns the resource ID of the Kubernetes object.
+func kubernetesObjectID(obj *unstructured.Unstructured) string {
+	return kubernetesObjectResourceID(obj).String()
+}
+
+// kubernetesObjectChange returns the planned change of the Kubernetes object.
+func kubernetesObjectChange(obj *unstructured.Unstructured, changeType recipes.ChangeType) recipes.ResourceChange {
+	id := kubernetesObjectResourceID(obj)
+	return recipes.ResourceChange{ID: id.String(), Type: id.Type(), ChangeType: changeType}
+}
+
+func kubernetesObjectResourceID(obj *unstructured.Unstructured) resources.ID {
+	gvk := obj.GroupVersionKind()
+	return resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName())
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/manifest"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const testKubernetesManifest = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .context.resource.name }}
+spec:
+  ports:
+    - port: {{ .parameters.port }}
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: redis-output
+  annotations:
+    radapp.io/recipe-output: "true"
+    radapp.io/recipe-output-values: host, port
+stringData:
+  host: {{ .context.resource.name }}.{{ .context.runtime.kubernetes.namespace }}.svc.cluster.local
+  port: "{{ .parameters.port }}"
+`
+
+func setupKubernetes(t *testing.T) (*processors.MockResourceClient, *kubernetesDriver, *[]*unstructured.Unstructured) {
+	ctrl := gomock.NewController(t)
+	resourceClient := processors.NewMockResourceClient(ctrl)
+
+	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("Service"), meta.RESTScopeNamespace)
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+
+	applied := []*unstructured.Unstructured{}
+	k8sClient := fake.NewClientBuilder().
+		WithRESTMapper(restMapper).
+		WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "redis-output",
+				Namespace:   "app-ns",
+				Annotations: map[string]string{manifest.OutputValuesAnnotation: "host, port"},
+			},
+			Data: map[string][]byte{
+				"host":     []byte("test-redis-recipe.app-ns.svc.cluster.local"),
+				"port":     []byte("6380"),
+				"password": []byte("p@ssw0rd"),
+			},
+		}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			// The fake client does not support server-side apply, so applied objects are recorded instead.
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				applied = append(applied, obj.(*unstructured.Unstructured))
+				return nil
+			},
+		}).
+		Build()
+
+	fetch := func(ctx context.Context, templatePath string, templateVersion string, dir string) (string, error) {
+		files := map[string]string{
+			"redis.yaml":            testKubernetesManifest,
+			manifest.ValuesFileName: "port: 6379\n",
+		}
+		for name, content := range files {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+				return "", err
+			}
+		}
+		return dir, nil
+	}
+
+	return resourceClient, &kubernetesDriver{k8sClient: k8sClient, resourceClient: resourceClient, fetch: fetch}, &applied
+}
+
+func buildKubernetesTestInputs() (recipes.Configuration, recipes.ResourceMetadata, recipes.EnvironmentDefinition) {
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	envConfig.Providers = datamodel.Providers{}
+	envConfig.Runtime.Kubernetes = &recipes.KubernetesRuntime{Namespace: "app-ns", EnvironmentNamespace: "env-ns"}
+	recipeMetadata.Parameters = map[string]any{"port": 6380}
+	envRecipe.Driver = recipes.TemplateKindKubernetes
+	envRecipe.TemplatePath = "git::https://github.com/sampleorg/recipes.git//redis"
+	return envConfig, recipeMetadata, envRecipe
+}
+
+func Test_Kubernetes_Execute_Success(t *testing.T) {
+	recorder := oplog.NewRecorder(0)
+	ctx := oplog.WithRecorder(testcontext.New(t), recorder)
+	resourceClient, driver, applied := setupKubernetes(t)
+	envConfig, recipeMetadata, envRecipe := buildKubernetesTestInputs()
+
+	previous := "/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/redis"
+	resourceClient.EXPECT().Delete(gomock.Any(), previous).Times(1).Return(nil)
+
+	result, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		PrevState: []string{
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/test-redis-recipe",
+			previous,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipeOutput{
+		Resources: []string{
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/test-redis-recipe",
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Secret/redis-output",
+		},
+		Values: map[string]any{
+			"host": "test-redis-recipe.app-ns.svc.cluster.local",
+			"port": float64(6380),
+		},
+		Secrets: map[string]any{
+			"password": "p@ssw0rd",
+		},
+	}
+	require.Equal(t, expected, result)
+
+	require.Len(t, *applied, 2)
+	service := (*applied)[0]
+	require.Equal(t, "app-ns", service.GetNamespace())
+	require.Equal(t, "app1", service.GetLabels()[kubernetes.LabelRadiusApplication])
+	require.Equal(t, "test-redis-recipe", service.GetLabels()[kubernetes.LabelRadiusResource])
+	ports, _, err := unstructured.NestedSlice(service.Object, "spec", "ports")
+	require.NoError(t, err)
+	require.Equal(t, int64(6380), ports[0].(map[string]any)["port"])
+
+	messages := []string{}
+	for _, entry := range recorder.Entries() {
+		require.Equal(t, recipes.TemplateKindKubernetes, entry.Source)
+		messages = append(messages, entry.Message)
+	}
+	expectedMessages := []string{
+		"Rendered 2 objects from the recipe manifests.",
+		"Applied Service \"app-ns/test-redis-recipe\".",
+		"Applied Secret \"app-ns/redis-output\".",
+		"Deleted resource \"" + previous + "\".",
+	}
+	require.Equal(t, expectedMessages, messages)
+}
+
+func Test_Kubernetes_Execute_Simulated(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver, applied := setupKubernetes(t)
+	envConfig, recipeMetadata, envRecipe := buildKubernetesTestInputs()
+	envConfig.Simulated = true
+
+	result, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, result)
+	require.Empty(t, *applied)
+}
+
+func Test_Kubernetes_Execute_DownloadFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver, _ := setupKubernetes(t)
+	envConfig, recipeMetadata, envRecipe := buildKubernetesTestInputs()
+	driver.fetch = func(ctx context.Context, templatePath string, templateVersion string, dir string) (string, error) {
+		return "", errors.New("repository not found")
+	}
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeDownloadFailed, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
+
+func Test_Kubernetes_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	resourceClient, driver, _ := setupKubernetes(t)
+
+	managed := "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis"
+	resourceClient.EXPECT().Delete(gomock.Any(), managed).Times(1).Return(errors.New("service is protected"))
+
+	err := driver.Delete(ctx, DeleteOptions{
+		OutputResources: []rpv1.OutputResource{
+			{ID: resources.MustParse("/planes/kubernetes/local/namespaces/app-ns/providers/core/ConfigMap/unmanaged"), RadiusManaged: to.Ptr(false)},
+			{ID: resources.MustParse(managed), RadiusManaged: to.Ptr(true)},
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipeDeletionFailed, err.(*recipes.RecipeError).ErrorDetails.Code)
+}
+
+func Test_Kubernetes_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver, _ := setupKubernetes(t)
+	_, _, envRecipe := buildKubernetesTestInputs()
+
+	result, err := driver.GetRecipeMetadata(ctx, BaseOptions{Definition: envRecipe})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"port": map[string]any{"type": "number", "defaultValue": int64(6379)},
+		},
+	}, result)
+}
+
+func Test_Kubernetes_Plan(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver, applied := setupKubernetes(t)
+	envConfig, recipeMetadata, envRecipe := buildKubernetesTestInputs()
+
+	previous := "/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/redis"
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+		PrevState: []string{previous},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/test-redis-recipe", Type: "core/Service", ChangeType: recipes.ChangeTypeCreate},
+			{ID: "/planes/kubernetes/local/namespaces/app-ns/providers/core/Secret/redis-output", Type: "core/Secret", ChangeType: recipes.ChangeTypeModify},
+			{ID: previous, Type: "apps/Deployment", ChangeType: recipes.ChangeTypeDelete},
+		},
+	}
+	require.Equal(t, expected, plan)
+
+	// Only the existing Secret is applied, as a dry run, to compare it with the deployed Secret.
+	require.Len(t, *applied, 1)
+	require.Equal(t, "redis-output", (*applied)[0].GetName())
+}
@@ -78,3 +78,18 @@ func (mr *MockDriverMockRecorder) GetRecipeMetadata(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockDriver)(nil).GetRecipeMetadata), arg0, arg1)
 }
+
+// Plan mocks base method.
+func (m *MockDriver) Plan(arg0 context.Context, arg1 ExecuteOptions) (*recipes.RecipePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1)
+	ret0, _ := ret[0].(*recipes.RecipePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockDriverMockRecorder) Plan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockDriver)(nil).Plan), arg0, arg1)
+}
@@ -30,8 +30,10 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
 
 	"github.com/radius-project/radius/pkg/recipes/terraform"
+	"github.com/radius-project/radius/pkg/recipes/terraform/cache"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/sdk"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
@@ -44,12 +46,17 @@ import (
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
+const (
+	// terraformCacheDirName is the name of the directory in the Terraform path containing the cache of provider plugins and modules.
+	terraformCacheDirName = ".cache"
+)
+
 var _ Driver = (*terraformDriver)(nil)
 
 // NewTerraformDriver creates a new instance of driver to execute a Terraform recipe.
-func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, options TerraformOptions, k8sClientSet kubernetes.Interface) Driver {
+func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, options TerraformOptions, k8sClientSet kubernetes.Interface, secretsLoader configloader.SecretsLoader) Driver {
 	return &terraformDriver{
-		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet),
+		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet, secretsLoader, newTerraformCache(options)),
 		options:           options,
 	}
 }
@@ -58,6 +65,23 @@ func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.Sec
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string
+
+	// CacheEnabled enables the cache of Terraform provider plugins and modules shared by the recipe executions.
+	// The cache is stored in a subdirectory of Path.
+	CacheEnabled bool
+
+	// CacheMaxSizeMB is the maximum size of the cache in megabytes. The cache is unbounded if it is zero.
+	CacheMaxSizeMB int64
+}
+
+// newTerraformCache returns the cache of Terraform provider plugins and modules, which is shared by the Terraform drivers
+// of the process. It returns nil if the cache is disabled.
+func newTerraformCache(options TerraformOptions) *cache.Cache {
+	if !options.CacheEnabled {
+		return nil
+	}
+
+	return cache.Shared(filepath.Join(options.Path, terraformCacheDirName), options.CacheMaxSizeMB*1024*1024)
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -107,6 +131,34 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 	return recipeOutputs, nil
 }
 
+// Plan creates a unique directory for each execution of terraform and plans the recipe using the Terraform CLI through
+// terraform-exec. It returns the changes to the resources managed by the Terraform module or an error if the plan fails.
+func (d *terraformDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return preparePlanResponse(tfPlan), nil
+}
+
 // Delete returns an error if called as it is not yet implemented.
 func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -331,3 +383,40 @@ func (d *terraformDriver) getDeployedOutputResources(ctx context.Context, module
 
 	return recipeResources, nil
 }
+
+// preparePlanResponse converts the resource changes of the Terraform plan to the changes of the recipe plan. Managed
+// resources are identified by their Terraform address since their IDs are not known before they are created.
+func preparePlanResponse(tfPlan *tfjson.Plan) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	if tfPlan == nil {
+		return plan
+	}
+
+	for _, change := range tfPlan.ResourceChanges {
+		if change == nil || change.Change == nil || change.Mode != tfjson.ManagedResourceMode {
+			continue
+		}
+
+		changeType := recipes.ChangeTypeUnsupported
+		switch {
+		case change.Change.Actions.NoOp():
+			changeType = recipes.ChangeTypeNoChange
+		case change.Change.Actions.Create():
+			changeType = recipes.ChangeTypeCreate
+		case change.Change.Actions.Update():
+			changeType = recipes.ChangeTypeModify
+		case change.Change.Actions.Replace():
+			changeType = recipes.ChangeTypeReplace
+		case change.Change.Actions.Delete():
+			changeType = recipes.ChangeTypeDelete
+		}
+
+		plan.Changes = append(plan.Changes, recipes.ResourceChange{
+			ID:         change.Address,
+			Type:       change.Type,
+			ChangeType: changeType,
+		})
+	}
+
+	return plan
+}
@@ -749,3 +749,102 @@ func Test_Terraform_PrepareRecipeResponse(t *testing.T) {
 		})
 	}
 }
+
+func Test_Terraform_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfPlan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "module.default.azurerm_redis_cache.redis",
+				Type:    "azurerm_redis_cache",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.default.kubernetes_deployment.redis",
+				Type:    "kubernetes_deployment",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+			},
+			{
+				Address: "module.default.kubernetes_service.redis",
+				Type:    "kubernetes_service",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.default.kubernetes_secret.redis",
+				Type:    "kubernetes_secret",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+			},
+			{
+				Address: "module.default.kubernetes_namespace.redis",
+				Type:    "kubernetes_namespace",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}},
+			},
+			{
+				Address: "module.default.data.azurerm_client_config.current",
+				Type:    "azurerm_client_config",
+				Mode:    tfjson.DataResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionRead}},
+			},
+		},
+	}
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(tfPlan, nil)
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{ID: "module.default.azurerm_redis_cache.redis", Type: "azurerm_redis_cache", ChangeType: recipes.ChangeTypeCreate},
+			{ID: "module.default.kubernetes_deployment.redis", Type: "kubernetes_deployment", ChangeType: recipes.ChangeTypeModify},
+			{ID: "module.default.kubernetes_service.redis", Type: "kubernetes_service", ChangeType: recipes.ChangeTypeReplace},
+			{ID: "module.default.kubernetes_secret.redis", Type: "kubernetes_secret", ChangeType: recipes.ChangeTypeDelete},
+			{ID: "module.default.kubernetes_namespace.redis", Type: "kubernetes_namespace", ChangeType: recipes.ChangeTypeNoChange},
+		},
+	}
+	require.Equal(t, expected, plan)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(nil, errors.New("Failed to plan terraform module"))
+
+	_, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, recipes.RecipePlanFailed, recipes.GetRecipeErrorDetails(err).Code)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
@@ -34,6 +34,9 @@ type Driver interface {
 	// Execute fetches the recipe contents and deploys the recipe and returns deployed resources, secrets and values.
 	Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error)
 
+	// Plan fetches the recipe contents and returns the changes that deploying the recipe would make, without making them.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
+
 	// Delete handles deletion of output resources for the recipe deployment.
 	Delete(ctx context.Context, opts DeleteOptions) error
 
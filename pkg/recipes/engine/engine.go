@@ -21,12 +21,19 @@ import (
 	"fmt"
 	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	recipedriver "github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+)
+
+const (
+	// logSource is the source of the entries written by the engine to the operation log.
+	logSource = "recipe-engine"
 )
 
 // NewEngine creates a new Engine to deploy recipe.
@@ -59,6 +66,9 @@ func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.Rec
 		if recipes.GetRecipeErrorDetails(err) != nil {
 			result = recipes.GetRecipeErrorDetails(err).Code
 		}
+		oplog.FromContext(ctx).Error(logSource, fmt.Sprintf("Recipe %q failed: %s", opts.Recipe.Name, err.Error()))
+	} else if recipeOutput != nil {
+		oplog.FromContext(ctx).Info(logSource, fmt.Sprintf("Recipe %q completed with %d output resources.", opts.Recipe.Name, len(recipeOutput.Resources)))
 	}
 
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, executionStart,
@@ -76,6 +86,8 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		return nil, nil, err
 	}
 
+	oplog.FromContext(ctx).Info(logSource, fmt.Sprintf("Executing recipe %q using the %s template %q.", recipe.Name, definition.Driver, definition.TemplatePath))
+
 	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
 	if err != nil {
 		return nil, definition, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
@@ -96,6 +108,55 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 	return res, definition, nil
 }
 
+// Plan loads the recipe definition and configuration in the same way as Execute and calls the Plan method of the driver
+// to compute the changes the recipe would make. It returns a RecipePlan and an error if one occurs.
+func (e *engine) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	planStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	plan, definition, err := e.planCore(ctx, opts.Recipe, opts.PreviousState)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetRecipeErrorDetails(err) != nil {
+			result = recipes.GetRecipeErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, planStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationPlan, opts.Recipe.Name,
+			definition, result))
+
+	return plan, err
+}
+
+// planCore function is the core logic of the Plan function.
+// Any changes to the core logic of the Plan function should be made here.
+func (e *engine) planCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipePlan, *recipes.EnvironmentDefinition, error) {
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, definition, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	plan, err := driver.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        recipe,
+			Definition:    *definition,
+		},
+		PrevState: prevState,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return plan, definition, nil
+}
+
 // Delete calls the Delete method of the driver specified in the recipe definition to delete the output resources.
 func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 	deletionStart := time.Now()
@@ -107,6 +168,9 @@ func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 		if recipes.GetRecipeErrorDetails(err) != nil {
 			result = recipes.GetRecipeErrorDetails(err).Code
 		}
+		oplog.FromContext(ctx).Error(logSource, fmt.Sprintf("Deleting the resources of recipe %q failed: %s", opts.Recipe.Name, err.Error()))
+	} else {
+		oplog.FromContext(ctx).Info(logSource, fmt.Sprintf("Deleted the resources of recipe %q.", opts.Recipe.Name))
 	}
 
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, deletionStart,
@@ -124,6 +188,8 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 		return nil, err
 	}
 
+	oplog.FromContext(ctx).Info(logSource, fmt.Sprintf("Deleting %d output resources of recipe %q using the %s template %q.", len(outputResources), recipe.Name, definition.Driver, definition.TemplatePath))
+
 	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
 	if err != nil {
 		return definition, err
@@ -169,6 +235,44 @@ func (e *engine) getRecipeMetadataCore(ctx context.Context, recipeDefinition rec
 	})
 }
 
+// ValidateParameters validates the parameters of the recipe definition in opts, or the parameters of the recipe if the
+// definition is not set, against the parameters declared by the recipe template. The parameters required by the recipe
+// template must be set by either the recipe or the recipe definition of the environment. Parameters are only validated
+// for the recipe drivers which declare the types of the parameters.
+func (e *engine) ValidateParameters(ctx context.Context, opts ValidateOptions) ([]v1.ErrorDetails, error) {
+	definition := opts.Definition
+	if definition == nil {
+		var err error
+		definition, err = e.options.ConfigurationLoader.LoadRecipe(ctx, &opts.Recipe)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if definition.Driver != recipes.TemplateKindBicep && definition.Driver != recipes.TemplateKindTerraform {
+		return nil, nil
+	}
+
+	metadata, err := e.getRecipeMetadataCore(ctx, *definition)
+	if err != nil {
+		return nil, err
+	}
+
+	// Terraform converts the values of the primitive types to the types of the variables.
+	convertPrimitives := definition.Driver == recipes.TemplateKindTerraform
+
+	if opts.Definition != nil {
+		target := fmt.Sprintf("$.properties.recipes['%s']['%s'].parameters", definition.ResourceType, definition.Name)
+		return util.ValidateParameters(metadata, definition.Parameters, target, convertPrimitives), nil
+	}
+
+	target := "$.properties.recipe.parameters"
+	errs := util.ValidateParameters(metadata, opts.Recipe.Parameters, target, convertPrimitives)
+	errs = append(errs, util.ValidateRequiredParameters(metadata, target, definition.Parameters, opts.Recipe.Parameters)...)
+
+	return errs, nil
+}
+
 func (e *engine) getDriver(ctx context.Context, recipeMetadata recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, recipedriver.Driver, error) {
 	// Load Recipe Definition from the environment.
 	definition, err := e.options.ConfigurationLoader.LoadRecipe(ctx, &recipeMetadata)
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
@@ -363,6 +364,70 @@ func Test_Engine_Load_Error(t *testing.T) {
 	require.Error(t, err)
 }
 
+func Test_Engine_Plan_Success(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	prevState := []string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	recipePlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{
+				ID:         "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+				Type:       "System.Test/testResources",
+				ChangeType: recipes.ChangeTypeModify,
+			},
+		},
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    *recipeDefinition,
+			},
+			PrevState: prevState,
+		}).
+		Times(1).
+		Return(recipePlan, nil)
+
+	result, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: prevState,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipePlan, result)
+}
+
 func Test_Engine_Delete_Success(t *testing.T) {
 	recipeMetadata, recipeDefinition, outputResources := getRecipeInputs()
 
@@ -548,6 +613,74 @@ func Test_GetRecipeMetadata_Driver_InvalidDriver(t *testing.T) {
 	require.Contains(t, err.Error(), "could not find driver invalid")
 }
 
+func Test_Engine_ValidateParameters_Definition(t *testing.T) {
+	_, recipeDefinition, _ := getRecipeInputs()
+	recipeDefinition.Name = "mongo-azure"
+	recipeDefinition.Parameters = map[string]any{"throughput": "high"}
+
+	ctx := testcontext.New(t)
+	engine, _, driver := setup(t)
+
+	driver.EXPECT().GetRecipeMetadata(ctx, recipedriver.BaseOptions{
+		Recipe:     recipes.ResourceMetadata{},
+		Definition: recipeDefinition,
+	}).Times(1).Return(map[string]any{
+		"parameters": map[string]any{"throughput": map[string]any{"type": "int", "defaultValue": float64(400)}},
+	}, nil)
+
+	errs, err := engine.ValidateParameters(ctx, ValidateOptions{Definition: &recipeDefinition})
+	require.NoError(t, err)
+	require.Equal(t, []v1.ErrorDetails{
+		{
+			Code:    v1.CodeInvalidRequestContent,
+			Message: `parameter "throughput" must be of type "int", but the value is of type "string"`,
+			Target:  "$.properties.recipes['Applications.Datastores/mongoDatabases']['mongo-azure'].parameters.throughput",
+		},
+	}, errs)
+}
+
+func Test_Engine_ValidateParameters_Recipe(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	recipeDefinition.Parameters = map[string]any{"location": "westus"}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().LoadRecipe(ctx, &recipeMetadata).Times(1).Return(&recipeDefinition, nil)
+	driver.EXPECT().GetRecipeMetadata(ctx, recipedriver.BaseOptions{
+		Recipe:     recipes.ResourceMetadata{},
+		Definition: recipeDefinition,
+	}).Times(1).Return(map[string]any{
+		"parameters": map[string]any{
+			"resourceName": map[string]any{"type": "string"},
+			"location":     map[string]any{"type": "string"},
+			"sku":          map[string]any{"type": "string"},
+		},
+	}, nil)
+
+	errs, err := engine.ValidateParameters(ctx, ValidateOptions{BaseOptions: BaseOptions{Recipe: recipeMetadata}})
+	require.NoError(t, err)
+	require.Equal(t, []v1.ErrorDetails{
+		{
+			Code:    v1.CodeInvalidRequestContent,
+			Message: `parameter "sku" is required by the recipe`,
+			Target:  "$.properties.recipe.parameters",
+		},
+	}, errs)
+}
+
+func Test_Engine_ValidateParameters_Load_Error(t *testing.T) {
+	recipeMetadata, _, _ := getRecipeInputs()
+
+	ctx := testcontext.New(t)
+	engine, configLoader, _ := setup(t)
+
+	configLoader.EXPECT().LoadRecipe(ctx, &recipeMetadata).Times(1).Return(nil, errors.New("recipe not found"))
+
+	_, err := engine.ValidateParameters(ctx, ValidateOptions{BaseOptions: BaseOptions{Recipe: recipeMetadata}})
+	require.Error(t, err)
+}
+
 func getRecipeInputs() (recipes.ResourceMetadata, recipes.EnvironmentDefinition, []rpv1.OutputResource) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	recipes "github.com/radius-project/radius/pkg/recipes"
 )
 
@@ -78,3 +79,33 @@ func (mr *MockEngineMockRecorder) GetRecipeMetadata(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockEngine)(nil).GetRecipeMetadata), arg0, arg1)
 }
+
+// Plan mocks base method.
+func (m *MockEngine) Plan(arg0 context.Context, arg1 ExecuteOptions) (*recipes.RecipePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1)
+	ret0, _ := ret[0].(*recipes.RecipePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockEngineMockRecorder) Plan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockEngine)(nil).Plan), arg0, arg1)
+}
+
+// ValidateParameters mocks base method.
+func (m *MockEngine) ValidateParameters(arg0 context.Context, arg1 ValidateOptions) ([]v1.ErrorDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateParameters", arg0, arg1)
+	ret0, _ := ret[0].([]v1.ErrorDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateParameters indicates an expected call of ValidateParameters.
+func (mr *MockEngineMockRecorder) ValidateParameters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateParameters", reflect.TypeOf((*MockEngine)(nil).ValidateParameters), arg0, arg1)
+}
@@ -19,6 +19,8 @@ package engine
 import (
 	"context"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
 	"github.com/radius-project/radius/pkg/recipes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
@@ -30,11 +32,18 @@ type Engine interface {
 	// prevState is added to the driver execute options, which is used to get the obsolete resources for cleanup. It consists list of recipe output resource IDs that were created in the previous deployment.
 	Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error)
 
+	// Plan gathers environment configuration, recipe definition and calls the driver to compute the changes the recipe
+	// would make without deploying it. PreviousState is used to plan the deletion of obsolete resources.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
+
 	// Delete handles deletion of output resources for the recipe deployment.
 	Delete(ctx context.Context, opts DeleteOptions) error
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error)
+	// ValidateParameters validates the recipe parameters against the parameters declared by the recipe template and
+	// returns the problems found, or an error if the recipe template can't be loaded.
+	ValidateParameters(ctx context.Context, opts ValidateOptions) ([]v1.ErrorDetails, error)
 }
 
 // BaseOptions is the base options for the engine operations.
@@ -52,6 +61,16 @@ type ExecuteOptions struct {
 	Simulated bool
 }
 
+// ValidateOptions is the options for the ValidateParameters method.
+type ValidateOptions struct {
+	BaseOptions
+
+	// Definition is the recipe definition whose parameters are validated, for example when the environment is updated.
+	// If it is nil, the recipe definition is loaded from the environment of the recipe, and the parameters of the recipe
+	// are validated instead.
+	Definition *recipes.EnvironmentDefinition
+}
+
 // DeleteOptions is the options for the Delete method.
 type DeleteOptions struct {
 	BaseOptions
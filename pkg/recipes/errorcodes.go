@@ -20,6 +20,12 @@ const (
 	// Used for recipe deployment failures.
 	RecipeDeploymentFailed = "RecipeDeploymentFailed"
 
+	// Used for errors encountered while planning the changes of a recipe deployment.
+	RecipePlanFailed = "RecipePlanFailed"
+
+	// Used when the driver of the recipe cannot plan the changes of a recipe deployment.
+	RecipePlanNotSupported = "RecipePlanNotSupported"
+
 	// Used for recipe validation failures.
 	RecipeValidationFailed = "RecipeValidationFailed"
 
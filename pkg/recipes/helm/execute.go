@@ -0,0 +1,299 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// helmDriverSecret makes Helm store release information in Kubernetes secrets.
+	helmDriverSecret = "secret"
+
+	installTimeout   = 10 * time.Minute
+	uninstallTimeout = 5 * time.Minute
+)
+
+var _ HelmExecutor = (*executor)(nil)
+
+// NewExecutor creates a new Executor that deploys Helm recipes to the cluster described by the given rest config.
+func NewExecutor(restConfig *rest.Config) *executor {
+	return &executor{restConfig: restConfig}
+}
+
+type executor struct {
+	// restConfig is the configuration used to connect to the Kubernetes cluster.
+	restConfig *rest.Config
+}
+
+// Deploy installs the Helm chart referenced by the recipe into the Kubernetes runtime namespace of the recipe, or upgrades
+// the release if it is already installed. The recipe parameters and the recipe context are passed to the chart as values.
+func (e *executor) Deploy(ctx context.Context, options Options) (*release.Release, error) {
+	_, rel, err := e.installOrUpgrade(ctx, options, false)
+	return rel, err
+}
+
+// Plan runs a dry run of the install or upgrade of the Helm release for the recipe and returns the currently deployed
+// release, which is nil if the release is not installed, and the release that deploying the recipe would create.
+func (e *executor) Plan(ctx context.Context, options Options) (*release.Release, *release.Release, error) {
+	return e.installOrUpgrade(ctx, options, true)
+}
+
+// installOrUpgrade installs the Helm release for the recipe, or upgrades the release if it is already installed, and
+// returns the previously deployed release along with the new release. Nothing is changed in the cluster for a dry run.
+func (e *executor) installOrUpgrade(ctx context.Context, options Options, dryRun bool) (*release.Release, *release.Release, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	namespace, releaseName, err := releaseIdentity(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values, err := newValues(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg, err := e.actionConfig(ctx, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings, cleanup, err := newSettings(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cleanup()
+
+	chartName, repoURL := chartReference(options.EnvRecipe.TemplatePath)
+
+	history := action.NewHistory(cfg)
+	history.Max = 1
+	releases, err := history.Run(releaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		install := action.NewInstall(cfg)
+		install.ReleaseName = releaseName
+		install.Namespace = namespace
+		install.CreateNamespace = true
+		install.Wait = !dryRun
+		install.DryRun = dryRun
+		install.Timeout = installTimeout
+		install.Version = options.EnvRecipe.TemplateVersion
+		install.RepoURL = repoURL
+
+		helmChart, err := loadChart(&install.ChartPathOptions, chartName, settings)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		logger.Info(fmt.Sprintf("Installing Helm release %q in namespace %q from chart %q", releaseName, namespace, options.EnvRecipe.TemplatePath), "dryRun", dryRun)
+		if !dryRun {
+			oplog.FromContext(ctx).Info(recipes.TemplateKindHelm, fmt.Sprintf("Installing release %q in namespace %q.", releaseName, namespace))
+		}
+		rel, err := install.RunWithContext(ctx, helmChart, values)
+		recordRelease(ctx, rel, err, dryRun)
+		return nil, rel, err
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the history of Helm release %q: %w", releaseName, err)
+	}
+
+	var current *release.Release
+	if len(releases) > 0 {
+		current = releases[len(releases)-1]
+	}
+
+	upgrade := action.NewUpgrade(cfg)
+	upgrade.Namespace = namespace
+	upgrade.Wait = !dryRun
+	upgrade.DryRun = dryRun
+	upgrade.Timeout = installTimeout
+	upgrade.Version = options.EnvRecipe.TemplateVersion
+	upgrade.RepoURL = repoURL
+
+	helmChart, err := loadChart(&upgrade.ChartPathOptions, chartName, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger.Info(fmt.Sprintf("Upgrading Helm release %q in namespace %q from chart %q", releaseName, namespace, options.EnvRecipe.TemplatePath), "dryRun", dryRun)
+	if !dryRun {
+		oplog.FromContext(ctx).Info(recipes.TemplateKindHelm, fmt.Sprintf("Upgrading release %q in namespace %q.", releaseName, namespace))
+	}
+	rel, err := upgrade.RunWithContext(ctx, releaseName, helmChart, values)
+	recordRelease(ctx, rel, err, dryRun)
+	return current, rel, err
+}
+
+// recordRelease records the status of an installed or upgraded Helm release in the log of the operation. Failures are
+// recorded by the recipe engine.
+func recordRelease(ctx context.Context, rel *release.Release, err error, dryRun bool) {
+	if dryRun || err != nil || rel == nil || rel.Info == nil {
+		return
+	}
+
+	oplog.FromContext(ctx).Info(recipes.TemplateKindHelm, fmt.Sprintf("Release %q revision %d is %s.", rel.Name, rel.Version, rel.Info.Status))
+}
+
+// Delete uninstalls the Helm release created for the recipe. It does not return an error if the release does not exist.
+func (e *executor) Delete(ctx context.Context, options Options) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	namespace, releaseName, err := releaseIdentity(options)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := e.actionConfig(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	uninstall := action.NewUninstall(cfg)
+	uninstall.Wait = true
+	uninstall.Timeout = uninstallTimeout
+
+	recorder := oplog.FromContext(ctx)
+
+	logger.Info(fmt.Sprintf("Uninstalling Helm release %q in namespace %q", releaseName, namespace))
+	recorder.Info(recipes.TemplateKindHelm, fmt.Sprintf("Uninstalling release %q in namespace %q.", releaseName, namespace))
+	_, err = uninstall.Run(releaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		logger.Info(fmt.Sprintf("Helm release %q does not exist", releaseName))
+		recorder.Info(recipes.TemplateKindHelm, fmt.Sprintf("Release %q does not exist.", releaseName))
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to uninstall Helm release %q: %w", releaseName, err)
+	}
+
+	recorder.Info(recipes.TemplateKindHelm, fmt.Sprintf("Uninstalled release %q.", releaseName))
+	return nil
+}
+
+// GetRecipeMetadata downloads the Helm chart referenced by the recipe and returns its top-level default values as the recipe parameters.
+func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
+	cfg := &action.Configuration{}
+	settings, cleanup, err := newSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	chartName, repoURL := chartReference(options.EnvRecipe.TemplatePath)
+	install := action.NewInstall(cfg)
+	install.Version = options.EnvRecipe.TemplateVersion
+	install.RepoURL = repoURL
+
+	helmChart, err := loadChart(&install.ChartPathOptions, chartName, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"parameters": recipes_util.ParametersFromDefaults(helmChart.Values),
+	}, nil
+}
+
+// actionConfig creates the Helm action configuration for releases in the given namespace. The progress reported by
+// Helm, such as the resources it creates and waits for, is also recorded in the log of the operation.
+func (e *executor) actionConfig(ctx context.Context, namespace string) (*action.Configuration, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	recorder := oplog.FromContext(ctx)
+
+	cfg := &action.Configuration{}
+	getter := &restClientGetter{config: e.restConfig, namespace: namespace}
+	err := cfg.Init(getter, namespace, helmDriverSecret, func(format string, v ...any) {
+		msg := fmt.Sprintf(format, v...)
+		logger.V(ucplog.LevelDebug).Info(msg)
+		recorder.Info(recipes.TemplateKindHelm, msg)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Helm: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// newSettings creates Helm settings that keep downloaded charts and registry configuration in a temporary directory,
+// and sets a registry client on the action configuration so that charts can be pulled from OCI registries.
+// The returned function removes the temporary directory.
+func newSettings(cfg *action.Configuration) (*cli.EnvSettings, func(), error) {
+	dir, err := os.MkdirTemp("", "helm-recipe-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create Helm working directory: %w", err)
+	}
+	cleanup := func() { _ = os.RemoveAll(dir) }
+
+	settings := cli.New()
+	settings.RepositoryCache = filepath.Join(dir, "repository")
+	settings.RepositoryConfig = filepath.Join(dir, "repositories.yaml")
+	settings.RegistryConfig = filepath.Join(dir, "registry", "config.json")
+
+	cfg.RegistryClient, err = registry.NewClient(registry.ClientOptCredentialsFile(settings.RegistryConfig))
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("failed to create Helm registry client: %w", err)
+	}
+
+	return settings, cleanup, nil
+}
+
+// loadChart downloads the chart if required and loads it.
+func loadChart(pathOptions *action.ChartPathOptions, chartName string, settings *cli.EnvSettings) (*chart.Chart, error) {
+	chartPath, err := pathOptions.LocateChart(chartName, settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate Helm chart %q: %w", chartName, err)
+	}
+
+	helmChart, err := loader.Load(chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Helm chart %q: %w", chartName, err)
+	}
+
+	return helmChart, nil
+}
+
+// releaseIdentity returns the namespace and name of the Helm release for the recipe.
+func releaseIdentity(options Options) (namespace string, releaseName string, err error) {
+	if options.EnvConfig == nil || options.EnvConfig.Runtime.Kubernetes == nil || options.EnvConfig.Runtime.Kubernetes.Namespace == "" {
+		return "", "", errors.New("a Kubernetes runtime namespace is required to deploy a Helm recipe")
+	}
+
+	releaseName, err = ReleaseName(options.ResourceRecipe.ResourceID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return options.EnvConfig.Runtime.Kubernetes.Namespace, releaseName, nil
+}
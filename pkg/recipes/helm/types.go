@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+//go:generate mockgen -destination=./mock_executor.go -package=helm -self_package github.com/radius-project/radius/pkg/recipes/helm github.com/radius-project/radius/pkg/recipes/helm HelmExecutor
+
+type HelmExecutor interface {
+	// Deploy installs the Helm chart referenced by the recipe, or upgrades the release if it is already installed,
+	// and returns the deployed release.
+	Deploy(ctx context.Context, options Options) (*release.Release, error)
+
+	// Plan runs a dry run of the install or upgrade of the Helm release for the recipe and returns the currently deployed
+	// release, which is nil if the release is not installed, and the release that deploying the recipe would create.
+	Plan(ctx context.Context, options Options) (*release.Release, *release.Release, error)
+
+	// Delete uninstalls the Helm release created for the recipe.
+	Delete(ctx context.Context, options Options) error
+
+	// GetRecipeMetadata downloads the Helm chart referenced by the recipe and returns its top-level values as the recipe parameters.
+	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
+}
+
+// Options represents the options required to build inputs to interact with Helm.
+type Options struct {
+	// EnvConfig is the kubernetes runtime and cloud provider configuration for the Radius Environment in which the application consuming the Helm recipe will be deployed.
+	EnvConfig *recipes.Configuration
+
+	// EnvRecipe is the recipe metadata associated with the Radius Environment in which the application consuming the Helm recipe will be deployed.
+	EnvRecipe *recipes.EnvironmentDefinition
+
+	// ResourceRecipe is recipe metadata associated with the Radius resource deploying the Helm recipe.
+	ResourceRecipe *recipes.ResourceMetadata
+}
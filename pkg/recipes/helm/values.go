@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// OutputLabel is the label that marks a Secret or ConfigMap deployed by a Helm recipe as the recipe output.
+	// Data of a labeled ConfigMap is returned as the recipe values and data of a labeled Secret as the recipe secrets.
+	OutputLabel = "radapp.io/recipe-output"
+
+	// releaseNameMaxLength is the maximum length of a Helm release name.
+	releaseNameMaxLength = 53
+
+	// releaseNameHashLength is the length of the resource ID hash appended to the release name.
+	releaseNameHashLength = 8
+)
+
+// ReleaseName returns the name of the Helm release for the resource deploying the recipe. The name is the resource name
+// suffixed with a hash of the resource ID so that resources with the same name in different scopes do not collide.
+func ReleaseName(resourceID string) (string, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum([]byte(strings.ToLower(id.String())))
+	hash := hex.EncodeToString(sum[:])[:releaseNameHashLength]
+
+	name := kubernetes.NormalizeResourceName(id.Name())
+	if maxLength := releaseNameMaxLength - releaseNameHashLength - 1; len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-")
+	}
+
+	return name + "-" + hash, nil
+}
+
+// chartReference splits the recipe template path into the chart name and chart repository URL. Template paths that
+// point to a chart in an HTTP chart repository are of the form https://<repository-url>/<chart-name>. OCI references,
+// chart archive URLs and local paths are returned as the chart name with no repository URL.
+func chartReference(templatePath string) (name string, repoURL string) {
+	if !strings.HasPrefix(templatePath, "http://") && !strings.HasPrefix(templatePath, "https://") {
+		return templatePath, ""
+	}
+
+	u, err := url.Parse(templatePath)
+	if err != nil || strings.HasSuffix(u.Path, ".tgz") {
+		return templatePath, ""
+	}
+
+	name = path.Base(u.Path)
+	u.Path = path.Dir(u.Path)
+	return name, strings.TrimSuffix(u.String(), "/")
+}
+
+// newValues creates the values passed to the Helm chart. Parameters set by the environment operator are overridden by
+// parameters set by the developer, and the recipe context is passed as the "context" value.
+func newValues(options Options) (map[string]any, error) {
+	values := map[string]any{}
+	for k, v := range options.EnvRecipe.Parameters {
+		values[k] = v
+	}
+	for k, v := range options.ResourceRecipe.Parameters {
+		values[k] = v
+	}
+
+	recipeContext, err := recipecontext.New(options.ResourceRecipe, options.EnvConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Chart templates address values by their JSON names, so the context is converted to a plain map.
+	b, err := json.Marshal(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+	contextValue := map[string]any{}
+	if err := json.Unmarshal(b, &contextValue); err != nil {
+		return nil, err
+	}
+	values[recipecontext.RecipeContextParamKey] = contextValue
+
+	return values, nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReleaseName(t *testing.T) {
+	name, err := ReleaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/Redis")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(name, "redis-"))
+	require.Len(t, name, len("redis-")+releaseNameHashLength)
+
+	// Resource IDs are case-insensitive.
+	other, err := ReleaseName("/planes/radius/local/resourcegroups/test-rg/providers/applications.datastores/rediscaches/redis")
+	require.NoError(t, err)
+	require.Equal(t, name, other)
+
+	// Resources with the same name in different scopes get different releases.
+	other, err = ReleaseName("/planes/radius/local/resourceGroups/other-rg/providers/Applications.Datastores/redisCaches/redis")
+	require.NoError(t, err)
+	require.NotEqual(t, name, other)
+
+	long, err := ReleaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/" + strings.Repeat("a", 60))
+	require.NoError(t, err)
+	require.Len(t, long, releaseNameMaxLength)
+
+	_, err = ReleaseName("invalid")
+	require.Error(t, err)
+}
+
+func Test_ChartReference(t *testing.T) {
+	tests := []struct {
+		templatePath string
+		name         string
+		repoURL      string
+	}{
+		{"oci://ghcr.io/radius-project/charts/redis", "oci://ghcr.io/radius-project/charts/redis", ""},
+		{"https://charts.bitnami.com/bitnami/redis", "redis", "https://charts.bitnami.com/bitnami"},
+		{"https://example.com/charts/redis-18.1.0.tgz", "https://example.com/charts/redis-18.1.0.tgz", ""},
+		{"./charts/redis", "./charts/redis", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.templatePath, func(t *testing.T) {
+			name, repoURL := chartReference(tc.templatePath)
+			require.Equal(t, tc.name, name)
+			require.Equal(t, tc.repoURL, repoURL)
+		})
+	}
+}
+
+func Test_NewValues(t *testing.T) {
+	values, err := newValues(Options{
+		EnvConfig: &recipes.Configuration{
+			Runtime: recipes.RuntimeConfiguration{
+				Kubernetes: &recipes.KubernetesRuntime{
+					Namespace:            "app-ns",
+					EnvironmentNamespace: "env-ns",
+				},
+			},
+		},
+		EnvRecipe: &recipes.EnvironmentDefinition{
+			Parameters: map[string]any{
+				"replicas": 1,
+				"image":    "redis:7",
+			},
+		},
+		ResourceRecipe: &recipes.ResourceMetadata{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+			ApplicationID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+			EnvironmentID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env",
+			Parameters: map[string]any{
+				"replicas": 3,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 3, values["replicas"])
+	require.Equal(t, "redis:7", values["image"])
+
+	recipeContext, ok := values["context"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "redis", recipeContext["resource"].(map[string]any)["name"])
+	require.Equal(t, "app-ns", recipeContext["runtime"].(map[string]any)["kubernetes"].(map[string]any)["namespace"])
+}
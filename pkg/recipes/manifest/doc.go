@@ -0,0 +1,23 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manifest fetches and renders the Kubernetes manifests of recipes with the "kubernetes" template kind.
+//
+// A recipe is a directory of YAML or JSON manifests, optionally with a kustomization file, that is published as an
+// OCI artifact or stored in a git repository. Manifests are Go templates that are rendered with the recipe parameters
+// as ".parameters" and the recipe context as ".context". An optional values.yaml file at the root of the recipe
+// declares the default parameter values.
+package manifest
@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+)
+
+const (
+	// gitSourcePrefix is the prefix of template paths that reference a git repository, for example
+	// git::https://github.com/org/repo.git//path/to/recipe?ref=v1.0.0.
+	gitSourcePrefix = "git::"
+
+	// ociSourcePrefix is the optional prefix of template paths that reference an OCI artifact.
+	ociSourcePrefix = "oci://"
+
+	// gitRefQueryParam is the query parameter of a git template path that selects the branch or tag to fetch.
+	gitRefQueryParam = "ref"
+)
+
+// FetchFunc downloads the recipe referenced by templatePath into dir and returns the directory that contains the manifests.
+type FetchFunc func(ctx context.Context, templatePath string, templateVersion string, dir string) (string, error)
+
+var _ FetchFunc = Fetch
+
+// Fetch downloads the recipe referenced by templatePath into dir and returns the directory that contains the manifests.
+// Template paths starting with "git::" are cloned from git, and all other template paths are pulled as OCI artifacts.
+// templateVersion is the git branch or tag, or the artifact tag, used when templatePath does not specify one.
+func Fetch(ctx context.Context, templatePath string, templateVersion string, dir string) (string, error) {
+	if strings.HasPrefix(templatePath, gitSourcePrefix) {
+		return fetchGit(ctx, templatePath, templateVersion, dir)
+	}
+
+	return fetchOCI(ctx, templatePath, templateVersion, dir)
+}
+
+// fetchGit clones the git repository referenced by the template path and returns the recipe directory within it.
+func fetchGit(ctx context.Context, templatePath string, templateVersion string, dir string) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	repoURL, subDir, ref, err := parseGitSource(templatePath)
+	if err != nil {
+		return "", err
+	}
+	if ref == "" {
+		ref = templateVersion
+	}
+
+	args := []string{"clone", "--depth", "1"}
+	if ref != "" {
+		args = append(args, "--branch", ref)
+	}
+	args = append(args, repoURL, dir)
+
+	logger.Info(fmt.Sprintf("Cloning recipe repository %q at %q", repoURL, ref))
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("failed to clone git repository %q: %w: %s", repoURL, err, strings.TrimSpace(string(output)))
+	}
+
+	recipeDir := filepath.Join(dir, subDir)
+	if rel, err := filepath.Rel(dir, recipeDir); err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("recipe path %q is outside of the git repository", subDir)
+	}
+
+	return recipeDir, nil
+}
+
+// parseGitSource parses a git template path of the form git::<repository-url>[//<sub-directory>][?ref=<ref>].
+func parseGitSource(templatePath string) (repoURL string, subDir string, ref string, err error) {
+	u, err := url.Parse(strings.TrimPrefix(templatePath, gitSourcePrefix))
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid git template path %q: %w", templatePath, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", "", "", fmt.Errorf("invalid git template path %q: repository URL must be absolute", templatePath)
+	}
+
+	query := u.Query()
+	ref = query.Get(gitRefQueryParam)
+	query.Del(gitRefQueryParam)
+	u.RawQuery = query.Encode()
+
+	if repoPath, dir, found := strings.Cut(u.Path, "//"); found {
+		u.Path = repoPath
+		subDir = dir
+	}
+
+	return u.String(), subDir, ref, nil
+}
+
+// fetchOCI pulls the OCI artifact referenced by the template path into dir.
+func fetchOCI(ctx context.Context, templatePath string, templateVersion string, dir string) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	reference, err := registry.ParseReference(strings.TrimPrefix(templatePath, ociSourcePrefix))
+	if err != nil {
+		return "", fmt.Errorf("invalid OCI template path %q: %w", templatePath, err)
+	}
+
+	tag := reference.Reference
+	if tag == "" {
+		tag = templateVersion
+	}
+	if tag == "" {
+		return "", errors.New("a tag or template version is required to fetch an OCI recipe")
+	}
+
+	repo, err := remote.NewRepository(reference.Registry + "/" + reference.Repository)
+	if err != nil {
+		return "", fmt.Errorf("failed to create client to registry: %w", err)
+	}
+
+	store, err := file.New(dir)
+	if err != nil {
+		return "", err
+	}
+	defer store.Close()
+
+	logger.Info(fmt.Sprintf("Pulling recipe artifact %q with tag %q", reference.Registry+"/"+reference.Repository, tag))
+	if _, err := oras.Copy(ctx, repo, tag, store, tag, oras.DefaultCopyOptions); err != nil {
+		return "", fmt.Errorf("failed to pull OCI artifact %q: %w", templatePath, err)
+	}
+
+	return dir, nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseGitSource(t *testing.T) {
+	tests := []struct {
+		name         string
+		templatePath string
+		repoURL      string
+		subDir       string
+		ref          string
+		err          string
+	}{
+		{
+			name:         "repository",
+			templatePath: "git::https://github.com/sampleorg/recipes.git",
+			repoURL:      "https://github.com/sampleorg/recipes.git",
+		},
+		{
+			name:         "sub-directory",
+			templatePath: "git::https://github.com/sampleorg/recipes.git//redis/dev",
+			repoURL:      "https://github.com/sampleorg/recipes.git",
+			subDir:       "redis/dev",
+		},
+		{
+			name:         "sub-directory and ref",
+			templatePath: "git::https://github.com/sampleorg/recipes.git//redis?ref=v1.0.0",
+			repoURL:      "https://github.com/sampleorg/recipes.git",
+			subDir:       "redis",
+			ref:          "v1.0.0",
+		},
+		{
+			name:         "relative URL",
+			templatePath: "git::recipes.git//redis",
+			err:          "invalid git template path \"git::recipes.git//redis\": repository URL must be absolute",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repoURL, subDir, ref, err := parseGitSource(tc.templatePath)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.repoURL, repoURL)
+			require.Equal(t, tc.subDir, subDir)
+			require.Equal(t, tc.ref, ref)
+		})
+	}
+}
+
+func Test_Fetch_InvalidOCIReference(t *testing.T) {
+	_, err := Fetch(context.Background(), "oci://ghcr.io/Sample/Recipes", "", t.TempDir())
+	require.ErrorContains(t, err, "invalid OCI template path")
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+const (
+	// ValuesFileName is the name of the file at the root of a recipe that declares the default parameter values.
+	ValuesFileName = "values.yaml"
+
+	// OutputAnnotation is the annotation that marks a Secret deployed by a recipe as the recipe output.
+	OutputAnnotation = "radapp.io/recipe-output"
+
+	// OutputValuesAnnotation is the annotation of the recipe output Secret that lists the comma-separated keys that are
+	// returned as recipe values. All other keys of the Secret are returned as recipe secrets.
+	OutputValuesAnnotation = "radapp.io/recipe-output-values"
+
+	// ParametersKey is the key of the recipe parameters in the data used to render manifests.
+	ParametersKey = "parameters"
+
+	// rootDir is the directory that rendered manifests are written to in the in-memory file system.
+	rootDir = "/"
+)
+
+// LoadDefaults reads the default parameter values of the recipe in dir. A recipe without a values file has no defaults.
+func LoadDefaults(dir string) (map[string]any, error) {
+	b, err := os.ReadFile(filepath.Join(dir, ValuesFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]any{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	defaults := map[string]any{}
+	if err := yaml.Unmarshal(b, &defaults); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", ValuesFileName, err)
+	}
+
+	return defaults, nil
+}
+
+// Render renders the manifests of the recipe in dir with data and returns the objects to deploy. If the recipe contains
+// a kustomization file the rendered manifests are built with Kustomize, otherwise all manifests are deployed.
+func Render(dir string, data map[string]any) ([]*unstructured.Unstructured, error) {
+	memFS := filesys.MakeFsInMemory()
+	manifests := []string{}
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if rel == ValuesFileName || !entry.Type().IsRegular() {
+			return nil
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if isManifest(rel) {
+			b, err = renderTemplate(rel, b, data)
+			if err != nil {
+				return err
+			}
+			manifests = append(manifests, rel)
+		}
+
+		return memFS.WriteFile(filepath.Join(rootDir, rel), b)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if hasKustomization(memFS) {
+		k := krusty.MakeKustomizer(krusty.MakeDefaultOptions())
+		resMap, err := k.Run(memFS, rootDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build kustomization: %w", err)
+		}
+
+		b, err := resMap.AsYaml()
+		if err != nil {
+			return nil, err
+		}
+
+		return Parse(b)
+	}
+
+	objects := []*unstructured.Unstructured{}
+	for _, manifest := range manifests {
+		b, err := memFS.ReadFile(filepath.Join(rootDir, manifest))
+		if err != nil {
+			return nil, err
+		}
+
+		parsed, err := Parse(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", manifest, err)
+		}
+		objects = append(objects, parsed...)
+	}
+
+	return objects, nil
+}
+
+// Parse parses the objects in a multi-document YAML or JSON manifest.
+func Parse(manifest []byte) ([]*unstructured.Unstructured, error) {
+	objects := []*unstructured.Unstructured{}
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for {
+		raw := json.RawMessage{}
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		// Documents that only contain comments decode to an empty object.
+		if trimmed := bytes.TrimSpace(raw); len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) || bytes.Equal(trimmed, []byte("{}")) {
+			continue
+		}
+
+		// Unstructured objects are decoded with their own unmarshaler so that integers are int64 values.
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(raw); err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+}
+
+// renderTemplate renders a manifest as a Go template with the Sprig function library.
+func renderTemplate(name string, content []byte, data map[string]any) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(sprig.TxtFuncMap()).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render template %s: %w", name, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// isManifest returns true if the file is a YAML or JSON manifest.
+func isManifest(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// hasKustomization returns true if the root of the file system contains a kustomization file.
+func hasKustomization(fSys filesys.FileSystem) bool {
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		if fSys.Exists(filepath.Join(rootDir, name)) {
+			return true
+		}
+	}
+	return false
+}
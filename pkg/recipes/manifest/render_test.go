@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var testData = map[string]any{
+	ParametersKey: map[string]any{
+		"replicas": 3,
+		"image":    "redis:7.2",
+	},
+	"context": map[string]any{
+		"resource": map[string]any{
+			"name": "cache",
+		},
+	},
+}
+
+func Test_LoadDefaults(t *testing.T) {
+	defaults, err := LoadDefaults("testdata/plain")
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"replicas": int64(1), "image": "redis:7"}, defaults)
+
+	defaults, err = LoadDefaults("testdata/kustomize")
+	require.NoError(t, err)
+	require.Empty(t, defaults)
+}
+
+func Test_Render_Manifests(t *testing.T) {
+	objects, err := Render("testdata/plain", testData)
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+
+	require.Equal(t, "Service", objects[0].GetKind())
+	require.Equal(t, "cache", objects[0].GetName())
+
+	require.Equal(t, "Deployment", objects[1].GetKind())
+	require.Equal(t, "cache", objects[1].GetName())
+	replicas, _, err := unstructured.NestedInt64(objects[1].Object, "spec", "replicas")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), replicas)
+	containers, _, err := unstructured.NestedSlice(objects[1].Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	require.Equal(t, "redis:7.2", containers[0].(map[string]any)["image"])
+}
+
+func Test_Render_Kustomization(t *testing.T) {
+	objects, err := Render("testdata/kustomize", testData)
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+
+	require.Equal(t, "ConfigMap", objects[0].GetKind())
+	require.Equal(t, "cache-settings", objects[0].GetName())
+	require.Equal(t, map[string]string{"tier": "cache"}, objects[0].GetLabels())
+	size, _, err := unstructured.NestedString(objects[0].Object, "data", "size")
+	require.NoError(t, err)
+	require.Equal(t, "small", size)
+}
+
+func Test_Render_InvalidTemplate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "service.yaml"), []byte("name: {{ .parameters.name"), 0644))
+
+	_, err := Render(dir, testData)
+	require.ErrorContains(t, err, "failed to parse template service.yaml")
+}
+
+func Test_Parse(t *testing.T) {
+	objects, err := Parse([]byte("# comment only\n---\napiVersion: v1\nkind: Secret\nmetadata:\n  name: output\n---\n{\"apiVersion\": \"v1\", \"kind\": \"ConfigMap\", \"metadata\": {\"name\": \"settings\"}}\n"))
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, "Secret", objects[0].GetKind())
+	require.Equal(t, "ConfigMap", objects[1].GetKind())
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	// authDirName is the name of the directory in the Terraform root directory which contains the credential files.
+	authDirName = ".auth"
+	// cliConfigFileName is the name of the Terraform CLI configuration file.
+	cliConfigFileName = ".terraformrc"
+	// sshConfigFileName is the name of the SSH configuration file used by git.
+	sshConfigFileName = "ssh_config"
+	// knownHostsFileName is the name of the SSH known hosts file used by git.
+	knownHostsFileName = "known_hosts"
+
+	authFileMode fs.FileMode = 0600
+	authDirMode  fs.FileMode = 0700
+
+	// gitPATSecretKey is the key of the personal access token in the secret store.
+	gitPATSecretKey = "pat"
+	// gitUsernameSecretKey is the key of the optional git username in the secret store.
+	gitUsernameSecretKey = "username"
+	// defaultGitUsername is the username used with the personal access token if the secret store doesn't contain one.
+	defaultGitUsername = "git"
+	// sshPrivateKeySecretKey is the key of the SSH private key in the secret store.
+	sshPrivateKeySecretKey = "privateKey"
+	// sshKnownHostsSecretKey is the key of the optional SSH known hosts entries in the secret store.
+	sshKnownHostsSecretKey = "knownHosts"
+	// registryTokenSecretKey is the key of the module registry token in the secret store.
+	registryTokenSecretKey = "token"
+)
+
+// configureAuth fetches the credentials of the private module sources configured for the environment, writes
+// the files required by git and Terraform to the auth directory in rootDir and adds the environment variables
+// referencing them to tfEnv. It is a no-op if the environment doesn't configure any authentication.
+func (e *executor) configureAuth(ctx context.Context, tfEnv *terraformEnv, rootDir string, envConfig *recipes.Configuration) error {
+	if envConfig == nil {
+		return nil
+	}
+
+	authConfig := envConfig.RecipeConfig.Terraform.Authentication
+	if len(authConfig.Git.PAT) == 0 && len(authConfig.Git.SSH) == 0 && len(authConfig.Registries) == 0 {
+		return nil
+	}
+
+	secrets, err := e.loadAuthSecrets(ctx, authConfig)
+	if err != nil {
+		return err
+	}
+
+	authDir := filepath.Join(rootDir, authDirName)
+	if err := os.MkdirAll(authDir, authDirMode); err != nil {
+		return fmt.Errorf("failed to create directory for Terraform credentials: %w", err)
+	}
+
+	if err := addGitPATs(tfEnv, authConfig.Git.PAT, secrets); err != nil {
+		return err
+	}
+	if err := addGitSSHKeys(tfEnv, authDir, authConfig.Git.SSH, secrets); err != nil {
+		return err
+	}
+	if err := addRegistryTokens(tfEnv, authDir, authConfig.Registries, secrets); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// loadAuthSecrets fetches the secrets of the secret stores referenced by the authentication configuration, keyed by the secret store ID.
+func (e *executor) loadAuthSecrets(ctx context.Context, authConfig datamodel.AuthConfig) (map[string]map[string]string, error) {
+	if e.secretsLoader == nil {
+		return nil, errors.New("secrets loader is not configured to fetch the credentials of the Terraform module sources")
+	}
+
+	secrets := map[string]map[string]string{}
+	for _, configs := range []map[string]datamodel.SecretConfig{authConfig.Git.PAT, authConfig.Git.SSH, authConfig.Registries} {
+		for _, config := range configs {
+			if _, ok := secrets[config.Secret]; ok {
+				continue
+			}
+
+			data, err := e.secretsLoader.LoadSecrets(ctx, config.Secret)
+			if err != nil {
+				return nil, err
+			}
+			secrets[config.Secret] = data
+		}
+	}
+
+	return secrets, nil
+}
+
+// addGitPATs configures git to send the personal access tokens as basic authentication headers to the git servers.
+// The headers are passed through environment variables so that the tokens are not written to disk or added to URLs.
+func addGitPATs(tfEnv *terraformEnv, configs map[string]datamodel.SecretConfig, secrets map[string]map[string]string) error {
+	for i, host := range sortedHosts(configs) {
+		secret := secrets[configs[host].Secret]
+		pat, err := getSecretValue(secret, gitPATSecretKey, configs[host].Secret)
+		if err != nil {
+			return err
+		}
+
+		username := secret[gitUsernameSecretKey]
+		if username == "" {
+			username = defaultGitUsername
+		}
+
+		credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + pat))
+		tfEnv.env[fmt.Sprintf("GIT_CONFIG_KEY_%d", i)] = fmt.Sprintf("http.https://%s/.extraheader", host)
+		tfEnv.env[fmt.Sprintf("GIT_CONFIG_VALUE_%d", i)] = "Authorization: Basic " + credentials
+		tfEnv.env["GIT_CONFIG_COUNT"] = strconv.Itoa(i + 1)
+		tfEnv.env["GIT_TERMINAL_PROMPT"] = "0"
+		tfEnv.sensitiveValues = append(tfEnv.sensitiveValues, pat, credentials)
+	}
+
+	return nil
+}
+
+// addGitSSHKeys writes the SSH private keys and an SSH configuration which uses them for the git servers, and configures
+// git to use the SSH configuration. Host keys are verified when known hosts are provided, otherwise new host keys are accepted.
+func addGitSSHKeys(tfEnv *terraformEnv, authDir string, configs map[string]datamodel.SecretConfig, secrets map[string]map[string]string) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	knownHostsPath := filepath.Join(authDir, knownHostsFileName)
+	sshConfig := strings.Builder{}
+	knownHosts := strings.Builder{}
+	for i, host := range sortedHosts(configs) {
+		secret := secrets[configs[host].Secret]
+		privateKey, err := getSecretValue(secret, sshPrivateKeySecretKey, configs[host].Secret)
+		if err != nil {
+			return err
+		}
+
+		keyPath := filepath.Join(authDir, fmt.Sprintf("id_%d", i))
+		if err := os.WriteFile(keyPath, []byte(ensureTrailingNewline(privateKey)), authFileMode); err != nil {
+			return fmt.Errorf("failed to write SSH private key for host %q: %w", host, err)
+		}
+		tfEnv.sensitiveValues = append(tfEnv.sensitiveValues, privateKey)
+
+		strictHostKeyChecking := "accept-new"
+		if secret[sshKnownHostsSecretKey] != "" {
+			knownHosts.WriteString(ensureTrailingNewline(secret[sshKnownHostsSecretKey]))
+			strictHostKeyChecking = "yes"
+		}
+
+		fmt.Fprintf(&sshConfig, "Host %s\n", host)
+		fmt.Fprintf(&sshConfig, "  IdentityFile %q\n", keyPath)
+		fmt.Fprintf(&sshConfig, "  IdentitiesOnly yes\n")
+		fmt.Fprintf(&sshConfig, "  UserKnownHostsFile %q\n", knownHostsPath)
+		fmt.Fprintf(&sshConfig, "  StrictHostKeyChecking %s\n", strictHostKeyChecking)
+	}
+
+	if err := os.WriteFile(knownHostsPath, []byte(knownHosts.String()), authFileMode); err != nil {
+		return fmt.Errorf("failed to write SSH known hosts: %w", err)
+	}
+
+	sshConfigPath := filepath.Join(authDir, sshConfigFileName)
+	if err := os.WriteFile(sshConfigPath, []byte(sshConfig.String()), authFileMode); err != nil {
+		return fmt.Errorf("failed to write SSH configuration: %w", err)
+	}
+
+	tfEnv.env["GIT_SSH_COMMAND"] = fmt.Sprintf("ssh -F %q", sshConfigPath)
+	tfEnv.env["GIT_TERMINAL_PROMPT"] = "0"
+
+	return nil
+}
+
+// addRegistryTokens writes a Terraform CLI configuration with the credentials of the module registries and configures
+// Terraform to use it.
+// https://developer.hashicorp.com/terraform/cli/config/config-file#credentials
+func addRegistryTokens(tfEnv *terraformEnv, authDir string, configs map[string]datamodel.SecretConfig, secrets map[string]map[string]string) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	cliConfig := strings.Builder{}
+	for _, host := range sortedHosts(configs) {
+		token, err := getSecretValue(secrets[configs[host].Secret], registryTokenSecretKey, configs[host].Secret)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(&cliConfig, "credentials %s {\n  token = %s\n}\n", strconv.Quote(host), strconv.Quote(token))
+		tfEnv.sensitiveValues = append(tfEnv.sensitiveValues, token)
+	}
+
+	cliConfigPath := filepath.Join(authDir, cliConfigFileName)
+	if err := os.WriteFile(cliConfigPath, []byte(cliConfig.String()), authFileMode); err != nil {
+		return fmt.Errorf("failed to write Terraform CLI configuration: %w", err)
+	}
+	tfEnv.env["TF_CLI_CONFIG_FILE"] = cliConfigPath
+
+	return nil
+}
+
+func getSecretValue(secrets map[string]string, key, secretStoreID string) (string, error) {
+	value, ok := secrets[key]
+	if !ok || value == "" {
+		return "", fmt.Errorf("secret %q is not found in secret store %q", key, secretStoreID)
+	}
+
+	return value, nil
+}
+
+func sortedHosts(configs map[string]datamodel.SecretConfig) []string {
+	hosts := make([]string, 0, len(configs))
+	for host := range configs {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	return hosts
+}
+
+func ensureTrailingNewline(s string) string {
+	if strings.HasSuffix(s, "\n") {
+		return s
+	}
+
+	return s + "\n"
+}
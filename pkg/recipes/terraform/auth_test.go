@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGitSecretStoreID      = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/git-secrets"
+	testRegistrySecretStoreID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/registry-secrets"
+)
+
+func getAuthEnvConfig(authConfig datamodel.AuthConfig) *recipes.Configuration {
+	return &recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Authentication: authConfig,
+			},
+		},
+	}
+}
+
+func TestConfigureAuth_NotConfigured(t *testing.T) {
+	e := executor{}
+
+	tfEnv := newTerraformEnv()
+	err := e.configureAuth(testcontext.New(t), tfEnv, t.TempDir(), nil)
+	require.NoError(t, err)
+	require.Empty(t, tfEnv.env)
+
+	err = e.configureAuth(testcontext.New(t), tfEnv, t.TempDir(), getAuthEnvConfig(datamodel.AuthConfig{}))
+	require.NoError(t, err)
+	require.Empty(t, tfEnv.env)
+}
+
+func TestConfigureAuth_GitPAT(t *testing.T) {
+	ctx := testcontext.New(t)
+	secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+	secretsLoader.EXPECT().LoadSecrets(ctx, testGitSecretStoreID).Times(1).Return(map[string]string{"pat": "test-pat", "username": "test-user"}, nil)
+
+	envConfig := getAuthEnvConfig(datamodel.AuthConfig{
+		Git: datamodel.GitAuthConfig{
+			PAT: map[string]datamodel.SecretConfig{
+				"github.com":    {Secret: testGitSecretStoreID},
+				"dev.azure.com": {Secret: testGitSecretStoreID},
+			},
+		},
+	})
+
+	e := executor{secretsLoader: secretsLoader}
+	tfEnv := newTerraformEnv()
+	err := e.configureAuth(ctx, tfEnv, t.TempDir(), envConfig)
+	require.NoError(t, err)
+
+	credentials := base64.StdEncoding.EncodeToString([]byte("test-user:test-pat"))
+	expected := map[string]string{
+		"GIT_CONFIG_COUNT":    "2",
+		"GIT_CONFIG_KEY_0":    "http.https://dev.azure.com/.extraheader",
+		"GIT_CONFIG_VALUE_0":  "Authorization: Basic " + credentials,
+		"GIT_CONFIG_KEY_1":    "http.https://github.com/.extraheader",
+		"GIT_CONFIG_VALUE_1":  "Authorization: Basic " + credentials,
+		"GIT_TERMINAL_PROMPT": "0",
+	}
+	require.Equal(t, expected, tfEnv.env)
+	require.Contains(t, tfEnv.sensitiveValues, "test-pat")
+	require.Contains(t, tfEnv.sensitiveValues, credentials)
+}
+
+func TestConfigureAuth_GitSSH(t *testing.T) {
+	ctx := testcontext.New(t)
+	secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+	secretsLoader.EXPECT().LoadSecrets(ctx, testGitSecretStoreID).Times(1).Return(map[string]string{
+		"privateKey": "test-private-key",
+		"knownHosts": "github.com ssh-ed25519 AAAA",
+	}, nil)
+
+	envConfig := getAuthEnvConfig(datamodel.AuthConfig{
+		Git: datamodel.GitAuthConfig{
+			SSH: map[string]datamodel.SecretConfig{
+				"github.com": {Secret: testGitSecretStoreID},
+			},
+		},
+	})
+
+	rootDir := t.TempDir()
+	authDir := filepath.Join(rootDir, authDirName)
+	e := executor{secretsLoader: secretsLoader}
+	tfEnv := newTerraformEnv()
+	err := e.configureAuth(ctx, tfEnv, rootDir, envConfig)
+	require.NoError(t, err)
+
+	sshConfigPath := filepath.Join(authDir, sshConfigFileName)
+	require.Equal(t, `ssh -F "`+sshConfigPath+`"`, tfEnv.env["GIT_SSH_COMMAND"])
+	require.Equal(t, []string{"test-private-key"}, tfEnv.sensitiveValues)
+
+	keyPath := filepath.Join(authDir, "id_0")
+	knownHostsPath := filepath.Join(authDir, knownHostsFileName)
+	expectedSSHConfig := "Host github.com\n" +
+		"  IdentityFile \"" + keyPath + "\"\n" +
+		"  IdentitiesOnly yes\n" +
+		"  UserKnownHostsFile \"" + knownHostsPath + "\"\n" +
+		"  StrictHostKeyChecking yes\n"
+	requireAuthFile(t, sshConfigPath, expectedSSHConfig)
+	requireAuthFile(t, keyPath, "test-private-key\n")
+	requireAuthFile(t, knownHostsPath, "github.com ssh-ed25519 AAAA\n")
+}
+
+func TestConfigureAuth_Registries(t *testing.T) {
+	ctx := testcontext.New(t)
+	secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+	secretsLoader.EXPECT().LoadSecrets(ctx, testRegistrySecretStoreID).Times(1).Return(map[string]string{"token": "test-token"}, nil)
+
+	envConfig := getAuthEnvConfig(datamodel.AuthConfig{
+		Registries: map[string]datamodel.SecretConfig{
+			"app.terraform.io": {Secret: testRegistrySecretStoreID},
+		},
+	})
+
+	rootDir := t.TempDir()
+	e := executor{secretsLoader: secretsLoader}
+	tfEnv := newTerraformEnv()
+	err := e.configureAuth(ctx, tfEnv, rootDir, envConfig)
+	require.NoError(t, err)
+
+	cliConfigPath := filepath.Join(rootDir, authDirName, cliConfigFileName)
+	require.Equal(t, map[string]string{"TF_CLI_CONFIG_FILE": cliConfigPath}, tfEnv.env)
+	require.Equal(t, []string{"test-token"}, tfEnv.sensitiveValues)
+	requireAuthFile(t, cliConfigPath, "credentials \"app.terraform.io\" {\n  token = \"test-token\"\n}\n")
+}
+
+func TestConfigureAuth_Errors(t *testing.T) {
+	envConfig := getAuthEnvConfig(datamodel.AuthConfig{
+		Registries: map[string]datamodel.SecretConfig{
+			"app.terraform.io": {Secret: testRegistrySecretStoreID},
+		},
+	})
+
+	t.Run("secrets loader not configured", func(t *testing.T) {
+		e := executor{}
+		err := e.configureAuth(testcontext.New(t), newTerraformEnv(), t.TempDir(), envConfig)
+		require.EqualError(t, err, "secrets loader is not configured to fetch the credentials of the Terraform module sources")
+	})
+
+	t.Run("load error", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+		secretsLoader.EXPECT().LoadSecrets(ctx, testRegistrySecretStoreID).Times(1).Return(nil, errors.New("secret store not found"))
+
+		e := executor{secretsLoader: secretsLoader}
+		err := e.configureAuth(ctx, newTerraformEnv(), t.TempDir(), envConfig)
+		require.EqualError(t, err, "secret store not found")
+	})
+
+	t.Run("missing secret key", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+		secretsLoader.EXPECT().LoadSecrets(ctx, testRegistrySecretStoreID).Times(1).Return(map[string]string{"pat": "test-pat"}, nil)
+
+		e := executor{secretsLoader: secretsLoader}
+		err := e.configureAuth(ctx, newTerraformEnv(), t.TempDir(), envConfig)
+		require.EqualError(t, err, `secret "token" is not found in secret store "`+testRegistrySecretStoreID+`"`)
+	})
+}
+
+func TestTFLogWrapper_Redaction(t *testing.T) {
+	logs := []string{}
+	logger := funcr.New(func(prefix, args string) {
+		logs = append(logs, args)
+	}, funcr.Options{})
+
+	w := &tfLogWrapper{logger: logger, redactor: strings.NewReplacer("test-token", redactedValue)}
+	_, err := w.Write([]byte("downloading module with token test-token"))
+	require.NoError(t, err)
+
+	require.Len(t, logs, 1)
+	require.Contains(t, logs[0], "downloading module with token ***")
+	require.NotContains(t, logs[0], "test-token")
+}
+
+func requireAuthFile(t *testing.T, path, expected string) {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(content))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, authFileMode, info.Mode().Perm())
+}
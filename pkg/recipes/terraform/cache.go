@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/radius-project/radius/pkg/recipes/terraform/cache"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// pluginCacheDirName is the name of the directory in the Terraform root directory used as the provider
+	// plugin cache of a single execution.
+	pluginCacheDirName = ".plugins"
+	// providersInstallDir is the directory in the working directory where Terraform init installs the providers.
+	providersInstallDir = ".terraform/providers"
+)
+
+// configureProviderCache links the cached provider plugins into the plugin cache directory of the execution in rootDir
+// and configures Terraform to use it. The cache is skipped if it can't be prepared, since Terraform can still download
+// the providers.
+func (e *executor) configureProviderCache(ctx context.Context, tfEnv *terraformEnv, rootDir string) {
+	if e.cache == nil {
+		return
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Each execution uses its own plugin cache directory since the Terraform plugin cache is not safe for
+	// concurrent use. The provider plugins downloaded to it are added to the shared cache after init.
+	pluginCacheDir := filepath.Join(rootDir, pluginCacheDirName)
+	if err := os.MkdirAll(pluginCacheDir, workingDirFileMode); err != nil {
+		logger.Info(fmt.Sprintf("Failed to create Terraform plugin cache directory, skipping the cache: %s", err.Error()))
+		return
+	}
+
+	if err := e.cache.LinkProviders(ctx, pluginCacheDir); err != nil {
+		logger.Info(fmt.Sprintf("Failed to link cached Terraform providers, skipping the cache: %s", err.Error()))
+		return
+	}
+
+	tfEnv.env["TF_PLUGIN_CACHE_DIR"] = pluginCacheDir
+	// The dependency lock file of a new working directory doesn't contain the checksums of the providers, which
+	// Terraform requires by default to install the providers from the cache.
+	// https://developer.hashicorp.com/terraform/cli/config/config-file#allowing-the-provider-plugin-cache-to-break-the-dependency-lock-file
+	tfEnv.env["TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE"] = "true"
+}
+
+// storeProviders adds the provider plugins installed in workingDir by Terraform init to the cache.
+func (e *executor) storeProviders(ctx context.Context, workingDir string) {
+	if e.cache == nil {
+		return
+	}
+
+	if err := e.cache.StoreProviders(ctx, filepath.Join(workingDir, providersInstallDir)); err != nil {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Info(fmt.Sprintf("Failed to add Terraform providers to the cache: %s", err.Error()))
+	}
+}
+
+// restoreModules copies the cached modules of the recipe into workingDir. It returns false if the modules are not
+// cached and need to be downloaded.
+func (e *executor) restoreModules(ctx context.Context, workingDir string, options Options) bool {
+	key, ok := moduleCacheKey(options)
+	if e.cache == nil || !ok {
+		return false
+	}
+
+	restored, err := e.cache.RestoreModules(ctx, key, filepath.Join(workingDir, moduleRootDir))
+	if err != nil {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Info(fmt.Sprintf("Failed to restore Terraform modules from the cache: %s", err.Error()))
+
+		// Remove the partially restored modules before they are downloaded.
+		_ = os.RemoveAll(filepath.Join(workingDir, moduleRootDir))
+		return false
+	}
+
+	return restored
+}
+
+// storeModules adds the modules downloaded to workingDir for the recipe to the cache.
+func (e *executor) storeModules(ctx context.Context, workingDir string, options Options) {
+	key, ok := moduleCacheKey(options)
+	if e.cache == nil || !ok {
+		return
+	}
+
+	if err := e.cache.StoreModules(ctx, key, filepath.Join(workingDir, moduleRootDir)); err != nil {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Info(fmt.Sprintf("Failed to add Terraform modules to the cache: %s", err.Error()))
+	}
+}
+
+// moduleCacheKey returns the key of the modules of the recipe in the cache. It returns false if the modules can't be
+// cached because the module source isn't pinned to a version, and the downloaded modules can change over time.
+func moduleCacheKey(options Options) (string, bool) {
+	if options.EnvRecipe == nil || options.EnvRecipe.TemplateVersion == "" {
+		return "", false
+	}
+
+	// The modules are downloaded to a directory named after the recipe, and private modules must only be
+	// shared by the environments using the same credentials.
+	auth := []byte{}
+	if options.EnvConfig != nil {
+		var err error
+		auth, err = json.Marshal(options.EnvConfig.RecipeConfig.Terraform.Authentication)
+		if err != nil {
+			return "", false
+		}
+	}
+
+	return cache.ModuleKey(options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion, options.EnvRecipe.Name, string(auth)), true
+}
@@ -0,0 +1,407 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// providersDirName is the name of the directory containing the cached provider plugins.
+	// Provider plugins are stored in the unpacked layout of the Terraform plugin cache:
+	// <hostname>/<namespace>/<type>/<version>/<os>_<arch>.
+	// https://developer.hashicorp.com/terraform/cli/config/config-file#implied-local-mirror-directories
+	providersDirName = "providers"
+	// modulesDirName is the name of the directory containing the cached modules, keyed by ModuleKey.
+	modulesDirName = "modules"
+	// tmpDirName is the name of the directory where entries are staged before they are added to or after they
+	// are evicted from the cache.
+	tmpDirName = "tmp"
+
+	// lockFileName is the name of the file locked by the processes using the cache.
+	lockFileName = ".lock"
+
+	// lockRetryDelay is the delay between the attempts to lock the cache directory.
+	lockRetryDelay = 100 * time.Millisecond
+
+	// providerEntryPattern matches the provider plugin entries in a directory using the unpacked layout.
+	providerEntryPattern = "*/*/*/*/*"
+
+	cacheDirMode fs.FileMode = 0700
+)
+
+// Cache is a content-addressed cache of the Terraform provider plugins and modules on the local filesystem,
+// shared by the recipe executions so that Terraform doesn't download them for each execution.
+//
+// Provider plugins are keyed by their address, version and platform, and modules by their source and version.
+// Entries are added to the cache atomically, so the cache can be used by parallel executions. The least
+// recently used entries are evicted when the size of the cache exceeds the maximum size.
+//
+// Entries are read with a shared lock and added or evicted with an exclusive lock on the cache directory, so the
+// cache directory can be shared by several processes. Use Shared to get the cache of a directory within a process.
+type Cache struct {
+	// rootDir is the directory containing the cache entries.
+	rootDir string
+
+	// maxSizeBytes is the maximum size of the cache. The cache is unbounded if it is not positive.
+	maxSizeBytes int64
+
+	// mu serializes the goroutines of the process using the cache, since the lock on the cache directory
+	// only serializes processes.
+	mu sync.RWMutex
+}
+
+var (
+	// sharedCaches are the caches shared by the process, keyed by their directory.
+	sharedCaches   = map[string]*Cache{}
+	sharedCachesMu sync.Mutex
+)
+
+// New creates a new Cache in rootDir which evicts entries when its size exceeds maxSizeBytes.
+func New(rootDir string, maxSizeBytes int64) *Cache {
+	return &Cache{rootDir: rootDir, maxSizeBytes: maxSizeBytes}
+}
+
+// Shared returns the Cache in rootDir shared by the process, which is created with maxSizeBytes by the first call.
+// The recipe drivers of the process must use the same Cache for a directory, so that the goroutines using the
+// directory are serialized.
+func Shared(rootDir string, maxSizeBytes int64) *Cache {
+	sharedCachesMu.Lock()
+	defer sharedCachesMu.Unlock()
+
+	rootDir = filepath.Clean(rootDir)
+	c, ok := sharedCaches[rootDir]
+	if !ok {
+		c = New(rootDir, maxSizeBytes)
+		sharedCaches[rootDir] = c
+	}
+
+	return c
+}
+
+// ModuleKey returns the cache key of the modules downloaded for the module source, version and any other
+// values affecting the downloaded modules.
+func ModuleKey(source, version string, values ...string) string {
+	h := sha256.New()
+	for _, v := range append([]string{source, version}, values...) {
+		// Length-prefix the values so that different values can't produce the same key.
+		fmt.Fprintf(h, "%d:%s;", len(v), v)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// LinkProviders links the cached provider plugins into pluginCacheDir, which is used as the plugin cache
+// directory of a single Terraform execution. Terraform installs the linked provider plugins instead of
+// downloading them, and downloads the others into pluginCacheDir.
+// https://developer.hashicorp.com/terraform/cli/config/config-file#provider-plugin-cache
+func (c *Cache) LinkProviders(ctx context.Context, pluginCacheDir string) error {
+	unlock, err := c.lock(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	entries, err := filepath.Glob(filepath.Join(c.rootDir, providersDirName, providerEntryPattern))
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		key, err := filepath.Rel(filepath.Join(c.rootDir, providersDirName), entry)
+		if err != nil {
+			return err
+		}
+
+		if err := copyDir(entry, filepath.Join(pluginCacheDir, key), true); err != nil {
+			return fmt.Errorf("failed to link provider %q from the cache: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// StoreProviders records a cache lookup for each provider plugin installed in installDir, the directory where
+// Terraform init installs the providers, and adds the provider plugins missing from the cache.
+func (c *Cache) StoreProviders(ctx context.Context, installDir string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	installed, err := filepath.Glob(filepath.Join(installDir, providerEntryPattern))
+	if err != nil {
+		return err
+	}
+
+	unlock, err := c.lock(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	stored := false
+	for _, src := range installed {
+		key, err := filepath.Rel(installDir, src)
+		if err != nil {
+			return err
+		}
+
+		entry := filepath.Join(c.rootDir, providersDirName, key)
+		hit, err := touch(entry)
+		if err != nil {
+			return err
+		}
+		if hit {
+			recordLookup(ctx, metrics.TerraformCacheTypeProvider, metrics.TerraformCacheHit)
+			continue
+		}
+		recordLookup(ctx, metrics.TerraformCacheTypeProvider, metrics.TerraformCacheMiss)
+
+		// Terraform links the installed providers to the plugin cache directory when it is configured.
+		src, err = filepath.EvalSymlinks(src)
+		if err != nil {
+			return err
+		}
+
+		logger.Info(fmt.Sprintf("Adding Terraform provider %q to the cache", key))
+		if err := c.store(src, entry, true); err != nil {
+			return fmt.Errorf("failed to add provider %q to the cache: %w", key, err)
+		}
+		stored = true
+	}
+
+	if stored {
+		return c.evict(ctx)
+	}
+
+	return nil
+}
+
+// RestoreModules copies the modules cached with key into modulesDir. It returns false if the modules are not cached.
+func (c *Cache) RestoreModules(ctx context.Context, key, modulesDir string) (bool, error) {
+	unlock, err := c.lock(ctx, false)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	entry := filepath.Join(c.rootDir, modulesDirName, key)
+	hit, err := touch(entry)
+	if err != nil {
+		return false, err
+	}
+	if !hit {
+		recordLookup(ctx, metrics.TerraformCacheTypeModule, metrics.TerraformCacheMiss)
+		return false, nil
+	}
+	recordLookup(ctx, metrics.TerraformCacheTypeModule, metrics.TerraformCacheHit)
+
+	// Modules are copied rather than linked since Terraform updates the module manifest in place.
+	if err := copyDir(entry, modulesDir, false); err != nil {
+		return false, fmt.Errorf("failed to restore modules from the cache: %w", err)
+	}
+
+	return true, nil
+}
+
+// StoreModules adds the modules downloaded to modulesDir to the cache with key.
+func (c *Cache) StoreModules(ctx context.Context, key, modulesDir string) error {
+	unlock, err := c.lock(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	entry := filepath.Join(c.rootDir, modulesDirName, key)
+	if _, err := os.Stat(entry); err == nil {
+		return nil
+	}
+
+	if err := c.store(modulesDir, entry, false); err != nil {
+		return fmt.Errorf("failed to add modules to the cache: %w", err)
+	}
+
+	return c.evict(ctx)
+}
+
+// lock acquires the lock of the cache, which is exclusive to add or evict entries and shared to read them, and
+// returns the function releasing it. The lock is held on both the cache and the cache directory.
+func (c *Cache) lock(ctx context.Context, exclusive bool) (func(), error) {
+	lockMu, unlockMu := c.mu.RLock, c.mu.RUnlock
+	if exclusive {
+		lockMu, unlockMu = c.mu.Lock, c.mu.Unlock
+	}
+	lockMu()
+
+	if err := os.MkdirAll(c.rootDir, cacheDirMode); err != nil {
+		unlockMu()
+		return nil, err
+	}
+
+	// The lock on the file is released when the file is closed, so each holder of a shared lock opens the file.
+	fileLock := flock.New(filepath.Join(c.rootDir, lockFileName))
+	var err error
+	if exclusive {
+		_, err = fileLock.TryLockContext(ctx, lockRetryDelay)
+	} else {
+		_, err = fileLock.TryRLockContext(ctx, lockRetryDelay)
+	}
+	if err != nil {
+		unlockMu()
+		return nil, fmt.Errorf("failed to lock the Terraform cache directory %q: %w", c.rootDir, err)
+	}
+
+	return func() {
+		if err := fileLock.Unlock(); err != nil {
+			ucplog.FromContextOrDiscard(ctx).Error(err, "failed to unlock the Terraform cache directory", "path", c.rootDir)
+		}
+		unlockMu()
+	}, nil
+}
+
+// store copies src to a staging directory and then moves it to entry, so that partially copied entries are never
+// visible in the cache. It must be called with the exclusive lock held.
+func (c *Cache) store(src, entry string, link bool) error {
+	tmpDir, err := c.makeTempDir()
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	staged := filepath.Join(tmpDir, filepath.Base(entry))
+	if err := copyDir(src, staged, link); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(entry), cacheDirMode); err != nil {
+		return err
+	}
+
+	return os.Rename(staged, entry)
+}
+
+// cacheEntry is an entry of the cache considered for eviction.
+type cacheEntry struct {
+	path     string
+	size     int64
+	lastUsed time.Time
+}
+
+// evict removes the least recently used entries until the size of the cache doesn't exceed the maximum size.
+// It must be called with the exclusive lock held.
+func (c *Cache) evict(ctx context.Context) error {
+	if c.maxSizeBytes <= 0 {
+		return nil
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	providers, err := filepath.Glob(filepath.Join(c.rootDir, providersDirName, providerEntryPattern))
+	if err != nil {
+		return err
+	}
+	modules, err := filepath.Glob(filepath.Join(c.rootDir, modulesDirName, "*"))
+	if err != nil {
+		return err
+	}
+
+	entries := []cacheEntry{}
+	var totalSize int64
+	for _, path := range append(providers, modules...) {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		size, err := dirSize(path)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, cacheEntry{path: path, size: size, lastUsed: info.ModTime()})
+		totalSize += size
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].lastUsed.Before(entries[j].lastUsed)
+	})
+
+	for _, entry := range entries {
+		if totalSize <= c.maxSizeBytes {
+			break
+		}
+
+		logger.Info(fmt.Sprintf("Evicting %q from the Terraform cache", entry.path))
+		if err := c.remove(entry.path); err != nil {
+			return err
+		}
+		totalSize -= entry.size
+	}
+
+	return nil
+}
+
+// remove moves the entry out of the cache before deleting it, so that partially deleted entries are never
+// visible in the cache. It must be called with the exclusive lock held.
+func (c *Cache) remove(entry string) error {
+	tmpDir, err := c.makeTempDir()
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	return os.Rename(entry, filepath.Join(tmpDir, filepath.Base(entry)))
+}
+
+func (c *Cache) makeTempDir() (string, error) {
+	tmpRoot := filepath.Join(c.rootDir, tmpDirName)
+	if err := os.MkdirAll(tmpRoot, cacheDirMode); err != nil {
+		return "", err
+	}
+
+	return os.MkdirTemp(tmpRoot, "")
+}
+
+// touch updates the modification time of the entry, which is used to evict the least recently used entries.
+// It returns false if the entry doesn't exist.
+func touch(entry string) (bool, error) {
+	now := time.Now()
+	err := os.Chtimes(entry, now, now)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func recordLookup(ctx context.Context, cacheType, cacheResult string) {
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformCacheLookup(ctx, metrics.NewTerraformCacheAttributes(cacheType, cacheResult))
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testProviderKey = "registry.terraform.io/hashicorp/aws/5.0.0/linux_amd64"
+	testModuleKey   = "test-module-key"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func requireFile(t *testing.T, path, expected string) {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(content))
+}
+
+func TestModuleKey(t *testing.T) {
+	key := ModuleKey("Azure/cosmosdb/azurerm", "1.0.0", "cosmosdb")
+	require.Len(t, key, 64)
+	require.Equal(t, key, ModuleKey("Azure/cosmosdb/azurerm", "1.0.0", "cosmosdb"))
+	require.NotEqual(t, key, ModuleKey("Azure/cosmosdb/azurerm", "1.0.1", "cosmosdb"))
+	require.NotEqual(t, key, ModuleKey("Azure/cosmosdb/azurerm", "1.0.0", "cosmos"))
+	require.NotEqual(t, ModuleKey("a", "b;c"), ModuleKey("a;b", "c"))
+}
+
+func TestProviders(t *testing.T) {
+	ctx := testcontext.New(t)
+	c := New(t.TempDir(), 0)
+
+	// The first execution downloads the provider to its plugin cache directory, and Terraform links it to the install directory.
+	pluginCacheDir := t.TempDir()
+	require.NoError(t, c.LinkProviders(ctx, pluginCacheDir))
+	writeFile(t, filepath.Join(pluginCacheDir, testProviderKey, "terraform-provider-aws"), "provider")
+
+	installDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(installDir, testProviderKey)), 0700))
+	require.NoError(t, os.Symlink(filepath.Join(pluginCacheDir, testProviderKey), filepath.Join(installDir, testProviderKey)))
+
+	require.NoError(t, c.StoreProviders(ctx, installDir))
+	requireFile(t, filepath.Join(c.rootDir, providersDirName, testProviderKey, "terraform-provider-aws"), "provider")
+
+	// The next execution gets the provider from the cache.
+	pluginCacheDir = t.TempDir()
+	require.NoError(t, c.LinkProviders(ctx, pluginCacheDir))
+	requireFile(t, filepath.Join(pluginCacheDir, testProviderKey, "terraform-provider-aws"), "provider")
+
+	// Storing a cached provider is a no-op.
+	require.NoError(t, c.StoreProviders(ctx, installDir))
+
+	// Staging directories are removed.
+	tmpEntries, err := os.ReadDir(filepath.Join(c.rootDir, tmpDirName))
+	require.NoError(t, err)
+	require.Empty(t, tmpEntries)
+}
+
+func TestStoreProviders_NotInstalled(t *testing.T) {
+	c := New(t.TempDir(), 0)
+	require.NoError(t, c.StoreProviders(testcontext.New(t), filepath.Join(t.TempDir(), "not-found")))
+}
+
+func TestModules(t *testing.T) {
+	ctx := testcontext.New(t)
+	c := New(t.TempDir(), 0)
+
+	modulesDir := filepath.Join(t.TempDir(), "modules")
+	restored, err := c.RestoreModules(ctx, testModuleKey, modulesDir)
+	require.NoError(t, err)
+	require.False(t, restored)
+
+	writeFile(t, filepath.Join(modulesDir, "modules.json"), "manifest")
+	writeFile(t, filepath.Join(modulesDir, "redis", "main.tf"), "module")
+	require.NoError(t, c.StoreModules(ctx, testModuleKey, modulesDir))
+
+	restoreDir := filepath.Join(t.TempDir(), "modules")
+	restored, err = c.RestoreModules(ctx, testModuleKey, restoreDir)
+	require.NoError(t, err)
+	require.True(t, restored)
+	requireFile(t, filepath.Join(restoreDir, "modules.json"), "manifest")
+	requireFile(t, filepath.Join(restoreDir, "redis", "main.tf"), "module")
+
+	// Restored modules are copies, so changes to them don't affect the cache.
+	writeFile(t, filepath.Join(restoreDir, "modules.json"), "updated")
+	requireFile(t, filepath.Join(c.rootDir, modulesDirName, testModuleKey, "modules.json"), "manifest")
+}
+
+func TestEvict(t *testing.T) {
+	ctx := testcontext.New(t)
+	c := New(t.TempDir(), 10)
+
+	oldDir := t.TempDir()
+	writeFile(t, filepath.Join(oldDir, "main.tf"), "123456")
+	require.NoError(t, c.StoreModules(ctx, "old", oldDir))
+
+	// Make sure the entries have different last used times.
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(c.rootDir, modulesDirName, "old"), past, past))
+
+	newDir := t.TempDir()
+	writeFile(t, filepath.Join(newDir, "main.tf"), "123456")
+	require.NoError(t, c.StoreModules(ctx, "new", newDir))
+
+	_, err := os.Stat(filepath.Join(c.rootDir, modulesDirName, "old"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	_, err = os.Stat(filepath.Join(c.rootDir, modulesDirName, "new"))
+	require.NoError(t, err)
+}
+
+func TestShared(t *testing.T) {
+	dir := t.TempDir()
+	c := Shared(dir, 10)
+	require.Same(t, c, Shared(dir+"/", 20))
+	require.Equal(t, int64(10), c.maxSizeBytes)
+	require.NotSame(t, c, Shared(t.TempDir(), 10))
+}
+
+func TestLock(t *testing.T) {
+	ctx := testcontext.New(t)
+	dir := t.TempDir()
+
+	// Caches created separately for the same directory stand for the caches of different processes.
+	c1, c2 := New(dir, 0), New(dir, 0)
+
+	unlock, err := c1.lock(ctx, false)
+	require.NoError(t, err)
+
+	// Readers share the lock on the cache directory.
+	unlockShared, err := c2.lock(ctx, false)
+	require.NoError(t, err)
+	unlockShared()
+
+	// Writers wait for the readers to release the lock on the cache directory.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+	_, err = c2.lock(timeoutCtx, true)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	unlock()
+	unlockExclusive, err := c2.lock(ctx, true)
+	require.NoError(t, err)
+	unlockExclusive()
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform/cache"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleCacheKey(t *testing.T) {
+	options := Options{
+		EnvConfig: &recipes.Configuration{},
+		EnvRecipe: &recipes.EnvironmentDefinition{
+			Name:            "redis",
+			TemplatePath:    "Azure/redis/azurerm",
+			TemplateVersion: "1.0.0",
+		},
+	}
+
+	key, ok := moduleCacheKey(options)
+	require.True(t, ok)
+
+	t.Run("modules of unpinned sources are not cached", func(t *testing.T) {
+		_, ok := moduleCacheKey(Options{EnvRecipe: &recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "git::https://dev.azure.com/project/module"}})
+		require.False(t, ok)
+	})
+
+	t.Run("modules downloaded with different credentials have different keys", func(t *testing.T) {
+		withAuth := options
+		withAuth.EnvConfig = &recipes.Configuration{
+			RecipeConfig: datamodel.RecipeConfigProperties{
+				Terraform: datamodel.TerraformConfigProperties{
+					Authentication: datamodel.AuthConfig{
+						Registries: map[string]datamodel.SecretConfig{
+							"app.terraform.io": {Secret: testRegistrySecretStoreID},
+						},
+					},
+				},
+			},
+		}
+
+		authKey, ok := moduleCacheKey(withAuth)
+		require.True(t, ok)
+		require.NotEqual(t, key, authKey)
+	})
+}
+
+func TestConfigureProviderCache(t *testing.T) {
+	t.Run("cache disabled", func(t *testing.T) {
+		e := executor{}
+		tfEnv := newTerraformEnv()
+		e.configureProviderCache(testcontext.New(t), tfEnv, t.TempDir())
+		require.Empty(t, tfEnv.env)
+	})
+
+	t.Run("cache enabled", func(t *testing.T) {
+		e := executor{cache: cache.New(t.TempDir(), 0)}
+		tfEnv := newTerraformEnv()
+		rootDir := t.TempDir()
+		e.configureProviderCache(testcontext.New(t), tfEnv, rootDir)
+
+		expected := map[string]string{
+			"TF_PLUGIN_CACHE_DIR":                            filepath.Join(rootDir, pluginCacheDirName),
+			"TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE": "true",
+		}
+		require.Equal(t, expected, tfEnv.env)
+		require.DirExists(t, filepath.Join(rootDir, pluginCacheDirName))
+	})
+}
+
+func TestRestoreModules(t *testing.T) {
+	ctx := testcontext.New(t)
+	options := Options{
+		EnvRecipe: &recipes.EnvironmentDefinition{
+			Name:            "redis",
+			TemplatePath:    "Azure/redis/azurerm",
+			TemplateVersion: "1.0.0",
+		},
+	}
+
+	e := executor{cache: cache.New(t.TempDir(), 0)}
+	workingDir := t.TempDir()
+	require.False(t, e.restoreModules(ctx, workingDir, options))
+
+	// Modules downloaded by an execution are restored by the next executions.
+	modulesDir := filepath.Join(workingDir, moduleRootDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(modulesDir, "redis"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(modulesDir, "redis", "main.tf"), []byte("module"), 0600))
+	e.storeModules(ctx, workingDir, options)
+
+	workingDir = t.TempDir()
+	require.True(t, e.restoreModules(ctx, workingDir, options))
+	require.FileExists(t, filepath.Join(workingDir, moduleRootDir, "redis", "main.tf"))
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	BackendAzureRM = "azurerm"
+
+	// azureStorageScope is the scope of the token used to access Azure Storage.
+	azureStorageScope = "https://storage.azure.com/.default"
+
+	// azureStorageAPIVersion is the version of the Azure Storage REST API used to check the blob existence.
+	azureStorageAPIVersion = "2020-10-02"
+)
+
+var _ Backend = (*azureRMBackend)(nil)
+
+type azureRMBackend struct {
+	config          datamodel.TerraformAzureRMBackend
+	credentials     *credentials.AzureCredential
+	tokenCredential azcore.TokenCredential
+	blobEndpoint    string
+	httpClient      *http.Client
+}
+
+// NewAzureRMBackend creates a Terraform backend which stores the Terraform state in an Azure Storage blob container.
+// credentials are passed to Terraform and can be nil, in which case Terraform uses the default Azure credential chain.
+// tokenCredential is used to check the existence of the Terraform state blob.
+func NewAzureRMBackend(config datamodel.TerraformAzureRMBackend, credentials *credentials.AzureCredential, tokenCredential azcore.TokenCredential) Backend {
+	return &azureRMBackend{
+		config:          config,
+		credentials:     credentials,
+		tokenCredential: tokenCredential,
+		blobEndpoint:    fmt.Sprintf("https://%s.blob.core.windows.net", config.StorageAccountName),
+		httpClient:      http.DefaultClient,
+	}
+}
+
+// BuildBackend generates the Terraform backend configuration for the Azure Storage backend. Each resource stores
+// its state in a separate blob in the container.
+// https://developer.hashicorp.com/terraform/language/settings/backends/azurerm
+func (b *azureRMBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	stateSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{
+		"resource_group_name":  b.config.ResourceGroupName,
+		"storage_account_name": b.config.StorageAccountName,
+		"container_name":       b.config.ContainerName,
+		"key":                  stateFileKey(stateSuffix),
+	}
+
+	if b.config.SubscriptionID != "" {
+		config["subscription_id"] = b.config.SubscriptionID
+	}
+
+	return map[string]any{
+		BackendAzureRM: config,
+	}, nil
+}
+
+// BuildEnv returns the Azure credential as the ARM environment variables read by the Azure Storage backend.
+func (b *azureRMBackend) BuildEnv() map[string]string {
+	if b.credentials == nil {
+		return nil
+	}
+
+	return map[string]string{
+		"ARM_CLIENT_ID":     b.credentials.ClientID,
+		"ARM_CLIENT_SECRET": b.credentials.ClientSecret,
+		"ARM_TENANT_ID":     b.credentials.TenantID,
+	}
+}
+
+// ValidateBackendExists checks if the Terraform state blob exists in the container. name is the state suffix of the resource.
+func (b *azureRMBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	token, err := b.tokenCredential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{azureStorageScope}})
+	if err != nil {
+		return false, err
+	}
+
+	blobURL := fmt.Sprintf("%s/%s/%s", b.blobEndpoint, b.config.ContainerName, stateFileKey(name))
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, blobURL, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+	req.Header.Set("x-ms-version", azureStorageAPIVersion)
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		logger.Info(fmt.Sprintf("Terraform state blob %q does not exist in container %q", stateFileKey(name), b.config.ContainerName))
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to retrieve Terraform state blob %q from container %q: %s", stateFileKey(name), b.config.ContainerName, resp.Status)
+	}
+}
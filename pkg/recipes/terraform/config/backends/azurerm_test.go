@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTokenCredential struct{}
+
+func (*fakeTokenCredential) GetToken(ctx context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "test-token"}, nil
+}
+
+var testAzureRMConfig = datamodel.TerraformAzureRMBackend{
+	SubscriptionID:     "00000000-0000-0000-0000-000000000000",
+	ResourceGroupName:  "test-rg",
+	StorageAccountName: "tfstate",
+	ContainerName:      "states",
+}
+
+func Test_AzureRMBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	stateSuffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	creds := &credentials.AzureCredential{ClientID: "test-client-id", ClientSecret: "test-client-secret", TenantID: "test-tenant-id"}
+	backend := NewAzureRMBackend(testAzureRMConfig, creds, &fakeTokenCredential{})
+	actual, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		BackendAzureRM: map[string]any{
+			"subscription_id":      "00000000-0000-0000-0000-000000000000",
+			"resource_group_name":  "test-rg",
+			"storage_account_name": "tfstate",
+			"container_name":       "states",
+			"key":                  stateSuffix + "/terraform.tfstate",
+		},
+	}
+	require.Equal(t, expected, actual)
+
+	expectedEnv := map[string]string{
+		"ARM_CLIENT_ID":     "test-client-id",
+		"ARM_CLIENT_SECRET": "test-client-secret",
+		"ARM_TENANT_ID":     "test-tenant-id",
+	}
+	require.Equal(t, expectedEnv, backend.BuildEnv())
+}
+
+func Test_AzureRMBackend_ValidateBackendExists(t *testing.T) {
+	ctx := testcontext.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		if r.Method == http.MethodHead && strings.TrimPrefix(r.URL.Path, "/") == "states/existing/terraform.tfstate" {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	backend := NewAzureRMBackend(testAzureRMConfig, nil, &fakeTokenCredential{}).(*azureRMBackend)
+	backend.blobEndpoint = server.URL
+
+	exists, err := backend.ValidateBackendExists(ctx, "existing")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = backend.ValidateBackendExists(ctx, "missing")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NewBackend creates the Terraform backend configured for the environment. The Kubernetes backend is used when the
+// environment doesn't configure one. The AWS and Azure credentials of the S3 and Azure Storage backends are fetched from
+// the UCP credential storage, and the connection string of the Postgres backend is fetched from the secret store
+// referenced by the environment.
+func NewBackend(ctx context.Context, envConfig *recipes.Configuration, k8sClientSet kubernetes.Interface, ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, secretsLoader configloader.SecretsLoader) (Backend, error) {
+	config := getBackendConfig(envConfig)
+	if config == nil {
+		return NewKubernetesBackend(k8sClientSet), nil
+	}
+
+	switch config.Kind {
+	case BackendKubernetes:
+		return NewKubernetesBackend(k8sClientSet), nil
+	case BackendS3:
+		if config.S3 == nil {
+			return nil, newMissingBackendConfigError(config.Kind)
+		}
+
+		provider, err := credentials.NewAWSCredentialProvider(secretProvider, ucpConn, &tokencredentials.AnonymousCredential{})
+		if err != nil {
+			return nil, err
+		}
+
+		// The default AWS credential chain is used when no AWS credential is registered.
+		creds, err := fetchAWSCredentials(ctx, provider)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewS3Backend(*config.S3, creds), nil
+	case BackendPostgres:
+		if config.Postgres == nil {
+			return nil, newMissingBackendConfigError(config.Kind)
+		}
+
+		connectionString, err := loadBackendSecret(ctx, secretsLoader, config.Kind, config.Postgres.ConnectionString)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewPostgresBackend(connectionString), nil
+	case BackendAzureRM:
+		if config.AzureRM == nil {
+			return nil, newMissingBackendConfigError(config.Kind)
+		}
+
+		creds, err := fetchAzureCredentials(ctx, ucpConn, secretProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		var tokenCredential azcore.TokenCredential
+		if creds != nil {
+			tokenCredential, err = azidentity.NewClientSecretCredential(creds.TenantID, creds.ClientID, creds.ClientSecret, nil)
+		} else {
+			tokenCredential, err = azidentity.NewDefaultAzureCredential(nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return NewAzureRMBackend(*config.AzureRM, creds, tokenCredential), nil
+	case BackendLocal:
+		if config.Local == nil {
+			return nil, newMissingBackendConfigError(config.Kind)
+		}
+
+		// Relative paths would resolve into the temporary working directory of the deployment, which loses the state.
+		if !filepath.IsAbs(config.Local.Path) {
+			return nil, fmt.Errorf("the path %q of the Terraform local backend must be an absolute path", config.Local.Path)
+		}
+
+		return NewLocalBackend(config.Local.Path), nil
+	default:
+		return nil, fmt.Errorf("unsupported Terraform backend kind %q", config.Kind)
+	}
+}
+
+// loadBackendSecret fetches the value of the secret referenced by the configuration of the backend.
+func loadBackendSecret(ctx context.Context, secretsLoader configloader.SecretsLoader, kind string, ref datamodel.SecretReference) (string, error) {
+	if secretsLoader == nil {
+		return "", fmt.Errorf("secrets loader is not configured to fetch the secrets of the Terraform %s backend", kind)
+	}
+
+	secrets, err := secretsLoader.LoadSecrets(ctx, ref.Source)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the secrets of the Terraform %s backend from secret store %q: %w", kind, ref.Source, err)
+	}
+
+	value, ok := secrets[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("the secret %q referenced by the Terraform %s backend is not found in secret store %q", ref.Key, kind, ref.Source)
+	}
+
+	return value, nil
+}
+
+// GetStateName returns the name of the Terraform state of the resource, which is passed to ValidateBackendExists of
+// the backend configured for the environment. It is the name of the Kubernetes secret for the Kubernetes backend and
+// the state suffix of the resource for the other backends.
+func GetStateName(envConfig *recipes.Configuration, resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	stateSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	if GetBackendKind(envConfig) == BackendKubernetes {
+		return KubernetesBackendNamePrefix + stateSuffix, nil
+	}
+
+	return stateSuffix, nil
+}
+
+// GetBackendKind returns the kind of the Terraform backend configured for the environment.
+func GetBackendKind(envConfig *recipes.Configuration) string {
+	config := getBackendConfig(envConfig)
+	if config == nil || config.Kind == "" {
+		return BackendKubernetes
+	}
+
+	return config.Kind
+}
+
+func getBackendConfig(envConfig *recipes.Configuration) *datamodel.TerraformBackend {
+	if envConfig == nil {
+		return nil
+	}
+
+	return envConfig.RecipeConfig.Terraform.Backend
+}
+
+func newMissingBackendConfigError(kind string) error {
+	return fmt.Errorf("the configuration of the Terraform %q backend is missing", kind)
+}
+
+// fetchAWSCredentials fetches AWS credentials from UCP. Returns nil if the credentials are not registered.
+func fetchAWSCredentials(ctx context.Context, provider credentials.CredentialProvider[credentials.AWSCredential]) (*credentials.AWSCredential, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	creds, err := provider.Fetch(ctx, credentials.AWSPublic, "default")
+	if err != nil {
+		if errors.Is(err, &secret.ErrNotFound{}) {
+			logger.Info("AWS credentials are not registered, skipping credentials configuration for the Terraform backend.")
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if creds == nil || creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		logger.Info("AWS credentials are not registered, skipping credentials configuration for the Terraform backend.")
+		return nil, nil
+	}
+
+	return creds, nil
+}
+
+// fetchAzureCredentials fetches Azure credentials from UCP. Returns nil if the credentials are not registered.
+func fetchAzureCredentials(ctx context.Context, ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider) (*credentials.AzureCredential, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	provider, err := credentials.NewAzureCredentialProvider(secretProvider, ucpConn, &tokencredentials.AnonymousCredential{})
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := provider.Fetch(ctx, credentials.AzureCloud, "default")
+	if err != nil {
+		if errors.Is(err, &secret.ErrNotFound{}) {
+			logger.Info("Azure credentials are not registered, skipping credentials configuration for the Terraform backend.")
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if creds == nil || creds.ClientID == "" || creds.TenantID == "" || creds.ClientSecret == "" {
+		logger.Info("Azure credentials are not registered, skipping credentials configuration for the Terraform backend.")
+		return nil, nil
+	}
+
+	return creds, nil
+}
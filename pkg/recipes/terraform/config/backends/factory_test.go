@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testBackendSecretStoreID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/tfstate"
+
+func newTestEnvConfig(backend *datamodel.TerraformBackend) *recipes.Configuration {
+	return &recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Backend: backend,
+			},
+		},
+	}
+}
+
+func Test_NewBackend(t *testing.T) {
+	ctx := testcontext.New(t)
+	k8sClientSet := fake.NewSimpleClientset()
+
+	t.Run("default", func(t *testing.T) {
+		backend, err := NewBackend(ctx, &recipes.Configuration{}, k8sClientSet, nil, nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, &kubernetesBackend{}, backend)
+	})
+
+	t.Run("kubernetes", func(t *testing.T) {
+		envConfig := newTestEnvConfig(&datamodel.TerraformBackend{Kind: datamodel.TerraformBackendKindKubernetes})
+		backend, err := NewBackend(ctx, envConfig, k8sClientSet, nil, nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, &kubernetesBackend{}, backend)
+	})
+
+	t.Run("local", func(t *testing.T) {
+		envConfig := newTestEnvConfig(&datamodel.TerraformBackend{
+			Kind:  datamodel.TerraformBackendKindLocal,
+			Local: &datamodel.TerraformLocalBackend{Path: "/var/lib/radius/tfstate"},
+		})
+		backend, err := NewBackend(ctx, envConfig, k8sClientSet, nil, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, &localBackend{path: "/var/lib/radius/tfstate"}, backend)
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+		secretsLoader.EXPECT().LoadSecrets(ctx, testBackendSecretStoreID).Times(1).Return(map[string]string{"connectionString": "postgres://localhost/tfstate"}, nil)
+
+		envConfig := newTestEnvConfig(&datamodel.TerraformBackend{
+			Kind: datamodel.TerraformBackendKindPostgres,
+			Postgres: &datamodel.TerraformPostgresBackend{
+				ConnectionString: datamodel.SecretReference{Source: testBackendSecretStoreID, Key: "connectionString"},
+			},
+		})
+		backend, err := NewBackend(ctx, envConfig, k8sClientSet, nil, nil, secretsLoader)
+		require.NoError(t, err)
+		require.Equal(t, &postgresBackend{connectionString: "postgres://localhost/tfstate"}, backend)
+	})
+
+	t.Run("postgres secret not found", func(t *testing.T) {
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+		secretsLoader.EXPECT().LoadSecrets(ctx, testBackendSecretStoreID).Times(1).Return(map[string]string{}, nil)
+
+		envConfig := newTestEnvConfig(&datamodel.TerraformBackend{
+			Kind: datamodel.TerraformBackendKindPostgres,
+			Postgres: &datamodel.TerraformPostgresBackend{
+				ConnectionString: datamodel.SecretReference{Source: testBackendSecretStoreID, Key: "connectionString"},
+			},
+		})
+		_, err := NewBackend(ctx, envConfig, k8sClientSet, nil, nil, secretsLoader)
+		require.EqualError(t, err, "the secret \"connectionString\" referenced by the Terraform pg backend is not found in secret store \""+testBackendSecretStoreID+"\"")
+	})
+
+	t.Run("local relative path", func(t *testing.T) {
+		envConfig := newTestEnvConfig(&datamodel.TerraformBackend{
+			Kind:  datamodel.TerraformBackendKindLocal,
+			Local: &datamodel.TerraformLocalBackend{Path: "tfstate"},
+		})
+		_, err := NewBackend(ctx, envConfig, k8sClientSet, nil, nil, nil)
+		require.EqualError(t, err, "the path \"tfstate\" of the Terraform local backend must be an absolute path")
+	})
+
+	t.Run("missing configuration", func(t *testing.T) {
+		envConfig := newTestEnvConfig(&datamodel.TerraformBackend{Kind: datamodel.TerraformBackendKindS3})
+		_, err := NewBackend(ctx, envConfig, k8sClientSet, nil, nil, nil)
+		require.EqualError(t, err, "the configuration of the Terraform \"s3\" backend is missing")
+	})
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		envConfig := newTestEnvConfig(&datamodel.TerraformBackend{Kind: "gcs"})
+		_, err := NewBackend(ctx, envConfig, k8sClientSet, nil, nil, nil)
+		require.EqualError(t, err, "unsupported Terraform backend kind \"gcs\"")
+	})
+}
+
+func Test_GetStateName(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	stateSuffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	name, err := GetStateName(&recipes.Configuration{}, &resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, KubernetesBackendNamePrefix+stateSuffix, name)
+
+	envConfig := newTestEnvConfig(&datamodel.TerraformBackend{
+		Kind: datamodel.TerraformBackendKindS3,
+		S3:   &datamodel.TerraformS3Backend{Bucket: "tfstate"},
+	})
+	name, err = GetStateName(envConfig, &resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, stateSuffix, name)
+}
+
+type fakeAWSCredentialProvider struct {
+	credential *credentials.AWSCredential
+}
+
+func (p *fakeAWSCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*credentials.AWSCredential, error) {
+	if p.credential == nil {
+		return nil, &secret.ErrNotFound{}
+	}
+
+	return p.credential, nil
+}
+
+func Test_FetchAWSCredentials(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	t.Run("registered", func(t *testing.T) {
+		expected := &credentials.AWSCredential{AccessKeyID: "test-key-id", SecretAccessKey: "test-secret-key"}
+		creds, err := fetchAWSCredentials(ctx, &fakeAWSCredentialProvider{credential: expected})
+		require.NoError(t, err)
+		require.Equal(t, expected, creds)
+
+		// The access keys of the registered credential are passed to Terraform as environment variables.
+		backend := NewS3Backend(datamodel.TerraformS3Backend{Bucket: "tfstate"}, creds)
+		require.Equal(t, map[string]string{"AWS_ACCESS_KEY_ID": "test-key-id", "AWS_SECRET_ACCESS_KEY": "test-secret-key"}, backend.BuildEnv())
+	})
+
+	t.Run("not registered", func(t *testing.T) {
+		creds, err := fetchAWSCredentials(ctx, &fakeAWSCredentialProvider{})
+		require.NoError(t, err)
+		require.Nil(t, creds)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		creds, err := fetchAWSCredentials(ctx, &fakeAWSCredentialProvider{credential: &credentials.AWSCredential{}})
+		require.NoError(t, err)
+		require.Nil(t, creds)
+	})
+}
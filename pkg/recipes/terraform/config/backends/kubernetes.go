@@ -89,6 +89,11 @@ func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name stri
 	return true, nil
 }
 
+// BuildEnv returns nil. The Kubernetes backend uses the in-cluster config or the default kubeconfig file.
+func (p *kubernetesBackend) BuildEnv() map[string]string {
+	return nil
+}
+
 // generateSecretSuffix returns a unique string from the resourceID, environmentID, and applicationID
 // which is used as key for kubernetes secret in defining terraform backend.
 func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, error) {
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	BackendLocal = "local"
+)
+
+var _ Backend = (*localBackend)(nil)
+
+type localBackend struct {
+	path string
+}
+
+// NewLocalBackend creates a Terraform backend which stores the Terraform state in a local directory. The directory
+// should be on a persistent volume, otherwise the state is lost when the Radius pod restarts.
+func NewLocalBackend(path string) Backend {
+	return &localBackend{path: path}
+}
+
+// BuildBackend generates the Terraform backend configuration for the local backend. Each resource stores its
+// state in a separate sub-directory.
+// https://developer.hashicorp.com/terraform/language/settings/backends/local
+func (b *localBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	stateSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		BackendLocal: map[string]any{
+			"path": b.statePath(stateSuffix),
+		},
+	}, nil
+}
+
+// ValidateBackendExists checks if the Terraform state file exists. name is the state suffix of the resource.
+func (b *localBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := os.Stat(b.statePath(name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			logger.Info(fmt.Sprintf("Terraform state file %q does not exist", b.statePath(name)))
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// BuildEnv returns nil. The local backend doesn't need credentials.
+func (b *localBackend) BuildEnv() map[string]string {
+	return nil
+}
+
+func (b *localBackend) statePath(stateSuffix string) string {
+	return filepath.Join(b.path, filepath.FromSlash(stateFileKey(stateSuffix)))
+}
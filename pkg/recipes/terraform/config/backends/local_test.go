@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LocalBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	stateSuffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	backend := NewLocalBackend("/var/lib/radius/tfstate")
+	actual, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		BackendLocal: map[string]any{
+			"path": filepath.Join("/var/lib/radius/tfstate", stateSuffix, "terraform.tfstate"),
+		},
+	}
+	require.Equal(t, expected, actual)
+}
+
+func Test_LocalBackend_ValidateBackendExists(t *testing.T) {
+	ctx := testcontext.New(t)
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "existing"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "existing", "terraform.tfstate"), []byte("{}"), 0644))
+
+	backend := NewLocalBackend(dir)
+
+	exists, err := backend.ValidateBackendExists(ctx, "existing")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = backend.ValidateBackendExists(ctx, "missing")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
@@ -50,6 +50,20 @@ func (mr *MockBackendMockRecorder) BuildBackend(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBackend", reflect.TypeOf((*MockBackend)(nil).BuildBackend), arg0)
 }
 
+// BuildEnv mocks base method.
+func (m *MockBackend) BuildEnv() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildEnv")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// BuildEnv indicates an expected call of BuildEnv.
+func (mr *MockBackendMockRecorder) BuildEnv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildEnv", reflect.TypeOf((*MockBackend)(nil).BuildEnv))
+}
+
 // ValidateBackendExists mocks base method.
 func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
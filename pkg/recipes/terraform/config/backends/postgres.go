@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	BackendPostgres = "pg"
+
+	// postgresSchemaPrefix is the prefix of the schema which stores the Terraform state of a resource.
+	postgresSchemaPrefix = "tfstate_"
+
+	// postgresUndefinedTable is the Postgres error code returned when the queried table doesn't exist.
+	// https://www.postgresql.org/docs/current/errcodes-appendix.html
+	postgresUndefinedTable = "42P01"
+
+	// terraformDefaultWorkspace is the Terraform workspace used for recipe deployments.
+	terraformDefaultWorkspace = "default"
+)
+
+var _ Backend = (*postgresBackend)(nil)
+
+type postgresBackend struct {
+	connectionString string
+}
+
+// NewPostgresBackend creates a Terraform backend which stores the Terraform state in a Postgres database.
+func NewPostgresBackend(connectionString string) Backend {
+	return &postgresBackend{connectionString: connectionString}
+}
+
+// BuildBackend generates the Terraform backend configuration for the Postgres backend. Each resource stores
+// its state in a separate schema, which Terraform creates during initialization.
+// https://developer.hashicorp.com/terraform/language/settings/backends/pg
+func (b *postgresBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	stateSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		BackendPostgres: map[string]any{
+			"schema_name": postgresSchemaPrefix + stateSuffix,
+		},
+	}, nil
+}
+
+// BuildEnv returns the connection string as the PG_CONN_STR environment variable read by the Postgres backend.
+func (b *postgresBackend) BuildEnv() map[string]string {
+	return map[string]string{
+		"PG_CONN_STR": b.connectionString,
+	}
+}
+
+// ValidateBackendExists checks if the Terraform state of the default workspace exists in the schema of the resource.
+// name is the state suffix of the resource.
+func (b *postgresBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	db, err := sql.Open("postgres", b.connectionString)
+	if err != nil {
+		return false, err
+	}
+	defer db.Close()
+
+	schema := pq.QuoteIdentifier(postgresSchemaPrefix + name)
+	var exists bool
+	err = db.QueryRowContext(ctx, fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s.states WHERE name = $1)", schema), terraformDefaultWorkspace).Scan(&exists)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == postgresUndefinedTable {
+			logger.Info(fmt.Sprintf("Terraform state schema %s does not exist", schema))
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_config "github.com/aws/aws-sdk-go-v2/config"
+	aws_credentials "github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	BackendS3 = "s3"
+
+	// s3DefaultRegion is the region used for the S3 backend when the environment doesn't specify one.
+	s3DefaultRegion = "us-east-1"
+)
+
+var _ Backend = (*s3Backend)(nil)
+
+type s3Backend struct {
+	config      datamodel.TerraformS3Backend
+	credentials *credentials.AWSCredential
+}
+
+// NewS3Backend creates a Terraform backend which stores the Terraform state in an S3 or S3-compatible bucket, for example MinIO.
+// credentials are the access keys of the bucket and can be nil, in which case Terraform uses the default AWS credential chain.
+func NewS3Backend(config datamodel.TerraformS3Backend, credentials *credentials.AWSCredential) Backend {
+	return &s3Backend{config: config, credentials: credentials}
+}
+
+// BuildBackend generates the Terraform backend configuration for the S3 backend. Each resource stores its state
+// under a separate key in the bucket.
+// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+func (b *s3Backend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	stateSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{
+		"bucket": b.config.Bucket,
+		"key":    stateFileKey(stateSuffix),
+		"region": b.region(),
+	}
+
+	if b.config.Endpoint != "" {
+		// S3-compatible storage services don't support the AWS STS and metadata APIs used for validation.
+		config["endpoints"] = map[string]any{
+			"s3": b.config.Endpoint,
+		}
+		config["skip_credentials_validation"] = true
+		config["skip_region_validation"] = true
+		config["skip_requesting_account_id"] = true
+	}
+
+	if b.config.UsePathStyle {
+		config["use_path_style"] = true
+	}
+
+	return map[string]any{
+		BackendS3: config,
+	}, nil
+}
+
+// BuildEnv returns the access keys of the bucket as the AWS environment variables read by the S3 backend.
+func (b *s3Backend) BuildEnv() map[string]string {
+	if b.credentials == nil {
+		return nil
+	}
+
+	return map[string]string{
+		"AWS_ACCESS_KEY_ID":     b.credentials.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY": b.credentials.SecretAccessKey,
+	}
+}
+
+// ValidateBackendExists checks if the bucket which stores the Terraform state exists. The bucket is not created by
+// Terraform, so it is checked before Terraform init. name is the state suffix of the resource and is not used.
+func (b *s3Backend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	client, err := b.newClient(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(b.config.Bucket)})
+	var notFound *types.NotFound
+	if errors.As(err, &notFound) {
+		logger.Info(fmt.Sprintf("S3 bucket %q for the Terraform state does not exist", b.config.Bucket))
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to retrieve S3 bucket %q: %w", b.config.Bucket, err)
+	}
+
+	return true, nil
+}
+
+// newClient creates an S3 client for the bucket. The access keys of the bucket are used if they are set, otherwise
+// the credentials are loaded from the default AWS credential chain, which is also used by Terraform.
+func (b *s3Backend) newClient(ctx context.Context) (*s3.Client, error) {
+	optFns := []func(*aws_config.LoadOptions) error{aws_config.WithRegion(b.region())}
+	if b.credentials != nil {
+		optFns = append(optFns, aws_config.WithCredentialsProvider(
+			aws_credentials.NewStaticCredentialsProvider(b.credentials.AccessKeyID, b.credentials.SecretAccessKey, "")))
+	}
+
+	cfg, err := aws_config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration for S3 bucket %q: %w", b.config.Bucket, err)
+	}
+
+	return s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = b.config.UsePathStyle
+		if b.config.Endpoint != "" {
+			o.EndpointResolver = s3.EndpointResolverFromURL(b.config.Endpoint)
+		}
+	}), nil
+}
+
+func (b *s3Backend) region() string {
+	if b.config.Region != "" {
+		return b.config.Region
+	}
+
+	return s3DefaultRegion
+}
+
+// stateFileKey returns the key of the Terraform state file of a resource in object storage backends.
+func stateFileKey(stateSuffix string) string {
+	return stateSuffix + "/terraform.tfstate"
+}
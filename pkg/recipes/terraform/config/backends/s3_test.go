@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeS3Server creates a fake S3-compatible server, similar to MinIO, which serves the given bucket using
+// path-style addressing.
+func newFakeS3Server(t *testing.T, bucket string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=test-access-key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		if r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if strings.Trim(r.URL.Path, "/") == bucket {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func Test_S3Backend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	stateSuffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	t.Run("aws", func(t *testing.T) {
+		backend := NewS3Backend(datamodel.TerraformS3Backend{Bucket: "tfstate", Region: "us-west-2"}, nil)
+		actual, err := backend.BuildBackend(&resourceRecipe)
+		require.NoError(t, err)
+
+		expected := map[string]any{
+			BackendS3: map[string]any{
+				"bucket": "tfstate",
+				"key":    stateSuffix + "/terraform.tfstate",
+				"region": "us-west-2",
+			},
+		}
+		require.Equal(t, expected, actual)
+		require.Nil(t, backend.BuildEnv())
+	})
+
+	t.Run("s3-compatible with credentials", func(t *testing.T) {
+		config := datamodel.TerraformS3Backend{Bucket: "tfstate", Endpoint: "http://minio:9000", UsePathStyle: true}
+		creds := &credentials.AWSCredential{AccessKeyID: "test-access-key", SecretAccessKey: "test-secret-key"}
+		backend := NewS3Backend(config, creds)
+		actual, err := backend.BuildBackend(&resourceRecipe)
+		require.NoError(t, err)
+
+		expected := map[string]any{
+			BackendS3: map[string]any{
+				"bucket":                      "tfstate",
+				"key":                         stateSuffix + "/terraform.tfstate",
+				"region":                      s3DefaultRegion,
+				"endpoints":                   map[string]any{"s3": "http://minio:9000"},
+				"skip_credentials_validation": true,
+				"skip_region_validation":      true,
+				"skip_requesting_account_id":  true,
+				"use_path_style":              true,
+			},
+		}
+		require.Equal(t, expected, actual)
+		require.Equal(t, map[string]string{"AWS_ACCESS_KEY_ID": "test-access-key", "AWS_SECRET_ACCESS_KEY": "test-secret-key"}, backend.BuildEnv())
+	})
+}
+
+func Test_S3Backend_ValidateBackendExists(t *testing.T) {
+	ctx := testcontext.New(t)
+	server := newFakeS3Server(t, "tfstate")
+	creds := &credentials.AWSCredential{AccessKeyID: "test-access-key", SecretAccessKey: "test-secret-key"}
+
+	backend := NewS3Backend(datamodel.TerraformS3Backend{Bucket: "tfstate", Endpoint: server.URL, UsePathStyle: true}, creds)
+	exists, err := backend.ValidateBackendExists(ctx, "existing")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	backend = NewS3Backend(datamodel.TerraformS3Backend{Bucket: "missing", Endpoint: server.URL, UsePathStyle: true}, creds)
+	exists, err = backend.ValidateBackendExists(ctx, "existing")
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func Test_S3Backend_ValidateBackendExists_Error(t *testing.T) {
+	ctx := testcontext.New(t)
+	server := newFakeS3Server(t, "tfstate")
+
+	// Requests signed with other credentials are rejected by the fake server.
+	creds := &credentials.AWSCredential{AccessKeyID: "other-access-key", SecretAccessKey: "other-secret-key"}
+	backend := NewS3Backend(datamodel.TerraformS3Backend{Bucket: "tfstate", Endpoint: server.URL, UsePathStyle: true}, creds)
+	exists, err := backend.ValidateBackendExists(ctx, "existing")
+	require.ErrorContains(t, err, "StatusCode: 403")
+	require.False(t, exists)
+}
@@ -35,4 +35,9 @@ type Backend interface {
 	// For example, for Kubernetes backend, it checks if the Kubernetes secret for Terraform state file exists.
 	// returns true if backend is found, false otherwise.
 	ValidateBackendExists(ctx context.Context, name string) (bool, error)
+
+	// BuildEnv returns the environment variables which pass the credentials of the backend to Terraform, so that
+	// they are not written to the backend configuration in the working directory. Returns nil if the backend
+	// doesn't need credentials.
+	BuildEnv() map[string]string
 }
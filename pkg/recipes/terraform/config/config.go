@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/providers"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -80,15 +81,23 @@ func (cfg *TerraformConfig) Save(ctx context.Context, workingDir string) error {
 }
 
 // AddProviders adds provider configurations for requiredProviders that are supported
-// by Radius to generate custom provider configurations. Save() must be called to save
-// the generated providers config. requiredProviders contains a list of provider names
-// that are required for the module.
-func (cfg *TerraformConfig) AddProviders(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration) error {
+// by Radius to generate custom provider configurations, along with the provider configurations
+// of the environment. Save() must be called to save the generated providers config.
+// requiredProviders contains a list of provider names that are required for the module.
+// secrets contains the secret values of the secret stores referenced by the provider configurations
+// of the environment, keyed by the secret store ID.
+func (cfg *TerraformConfig) AddProviders(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration, secrets map[string]map[string]string) error {
 	providerConfigs, err := getProviderConfigs(ctx, requiredProviders, supportedProviders, envConfig)
 	if err != nil {
 		return err
 	}
 
+	envProviderConfigs, err := getEnvironmentProviderConfigs(requiredProviders, envConfig, secrets)
+	if err != nil {
+		return err
+	}
+	mergeProviderConfigs(providerConfigs, envProviderConfigs)
+
 	// Add generated provider configs for required providers to the existing terraform json config file
 	if len(providerConfigs) > 0 {
 		cfg.Provider = providerConfigs
@@ -157,6 +166,93 @@ func getProviderConfigs(ctx context.Context, requiredProviders []string, support
 	return providerConfigs, nil
 }
 
+// GetProviderSecretStoreIDs returns the IDs of the secret stores referenced by the provider configurations of the
+// environment for requiredProviders.
+func GetProviderSecretStoreIDs(requiredProviders []string, envConfig *recipes.Configuration) []string {
+	if envConfig == nil {
+		return nil
+	}
+
+	ids := []string{}
+	for _, provider := range requiredProviders {
+		for _, config := range envConfig.RecipeConfig.Terraform.Providers[provider] {
+			for _, secret := range config.Secrets {
+				if !slices.Contains(ids, secret.Source) {
+					ids = append(ids, secret.Source)
+				}
+			}
+		}
+	}
+
+	return ids
+}
+
+// getEnvironmentProviderConfigs generates the Terraform provider configurations of the environment for the required providers.
+// Secret properties are resolved from secrets, which are keyed by the secret store ID.
+func getEnvironmentProviderConfigs(requiredProviders []string, envConfig *recipes.Configuration, secrets map[string]map[string]string) (map[string][]map[string]any, error) {
+	providerConfigs := make(map[string][]map[string]any)
+	if envConfig == nil {
+		return providerConfigs, nil
+	}
+
+	for _, provider := range requiredProviders {
+		configs, ok := envConfig.RecipeConfig.Terraform.Providers[provider]
+		if !ok {
+			continue
+		}
+
+		for _, config := range configs {
+			providerConfig := make(map[string]any)
+			for key, value := range config.AdditionalProperties {
+				providerConfig[key] = value
+			}
+
+			for key, secret := range config.Secrets {
+				value, ok := secrets[secret.Source][secret.Key]
+				if !ok {
+					return nil, fmt.Errorf("secret %q referenced by the %q property of the Terraform provider %q is not found in secret store %q", secret.Key, key, provider, secret.Source)
+				}
+				providerConfig[key] = value
+			}
+
+			providerConfigs[provider] = append(providerConfigs[provider], providerConfig)
+		}
+	}
+
+	return providerConfigs, nil
+}
+
+// mergeProviderConfigs adds the provider configurations of the environment to the provider configurations generated by Radius.
+// The configuration generated by Radius is kept only when all configurations of the environment for the provider have an alias,
+// because Terraform doesn't allow multiple default configurations for a provider.
+func mergeProviderConfigs(providerConfigs map[string]any, envProviderConfigs map[string][]map[string]any) {
+	for provider, envConfigs := range envProviderConfigs {
+		if len(envConfigs) == 0 {
+			continue
+		}
+
+		configs := []any{}
+		if generated, ok := providerConfigs[provider]; ok && allProviderConfigsHaveAlias(envConfigs) {
+			configs = append(configs, generated)
+		}
+		for _, config := range envConfigs {
+			configs = append(configs, config)
+		}
+
+		providerConfigs[provider] = configs
+	}
+}
+
+func allProviderConfigsHaveAlias(configs []map[string]any) bool {
+	for _, config := range configs {
+		if _, ok := config[providerAliasKey]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
 // AddTerraformBackend adds backend configurations to store Terraform state file for the deployment.
 // Save() must be called to save the generated backend config.
 // Currently, the supported backend for Terraform Recipes is Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
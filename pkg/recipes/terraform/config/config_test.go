@@ -423,7 +423,7 @@ func Test_AddProviders(t *testing.T) {
 			if tc.Err != nil {
 				mProvider.EXPECT().BuildConfig(ctx, &tc.envConfig).Times(1).Return(nil, tc.Err)
 			}
-			err := tfconfig.AddProviders(ctx, tc.requiredProviders, supportedProviders, &tc.envConfig)
+			err := tfconfig.AddProviders(ctx, tc.requiredProviders, supportedProviders, &tc.envConfig, nil)
 			if tc.Err != nil {
 				require.ErrorContains(t, err, tc.Err.Error())
 				return
@@ -445,6 +445,79 @@ func Test_AddProviders(t *testing.T) {
 	}
 }
 
+func Test_AddProviders_EnvironmentProviders(t *testing.T) {
+	const secretStoreID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/pg-secrets"
+	envRecipe, resourceRecipe := getTestInputs()
+	envConfig := recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Providers: map[string][]datamodel.ProviderConfigProperties{
+					providers.AWSProviderName: {
+						{
+							AdditionalProperties: map[string]any{
+								"alias":  "west",
+								"region": "us-west-2",
+							},
+						},
+					},
+					"postgresql": {
+						{
+							AdditionalProperties: map[string]any{
+								"host": "postgres.default.svc.cluster.local",
+							},
+							Secrets: map[string]datamodel.SecretReference{
+								"password": {Source: secretStoreID, Key: "password"},
+							},
+						},
+					},
+					"random": {
+						{
+							AdditionalProperties: map[string]any{},
+						},
+					},
+				},
+			},
+		},
+	}
+	requiredProviders := []string{providers.AWSProviderName, "postgresql"}
+
+	t.Run("merged with generated configuration", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		mProvider, supportedProviders, _ := setup(t)
+		mProvider.EXPECT().BuildConfig(ctx, &envConfig).Times(1).Return(map[string]any{"region": "test-region"}, nil)
+
+		require.Equal(t, []string{secretStoreID}, GetProviderSecretStoreIDs(requiredProviders, &envConfig))
+
+		tfconfig := New(testRecipeName, &envRecipe, &resourceRecipe)
+		secrets := map[string]map[string]string{
+			secretStoreID: {"password": "test-password"},
+		}
+		err := tfconfig.AddProviders(ctx, requiredProviders, supportedProviders, &envConfig, secrets)
+		require.NoError(t, err)
+
+		expected := map[string]any{
+			providers.AWSProviderName: []any{
+				map[string]any{"region": "test-region"},
+				map[string]any{"alias": "west", "region": "us-west-2"},
+			},
+			"postgresql": []any{
+				map[string]any{"host": "postgres.default.svc.cluster.local", "password": "test-password"},
+			},
+		}
+		require.Equal(t, expected, tfconfig.Provider)
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		mProvider, supportedProviders, _ := setup(t)
+		mProvider.EXPECT().BuildConfig(ctx, &envConfig).Times(1).Return(map[string]any{"region": "test-region"}, nil)
+
+		tfconfig := New(testRecipeName, &envRecipe, &resourceRecipe)
+		err := tfconfig.AddProviders(ctx, requiredProviders, supportedProviders, &envConfig, map[string]map[string]string{})
+		require.EqualError(t, err, fmt.Sprintf("secret \"password\" referenced by the \"password\" property of the Terraform provider \"postgresql\" is not found in secret store %q", secretStoreID))
+	})
+}
+
 func Test_AddOutputs(t *testing.T) {
 	envRecipe, resourceRecipe := getTestInputs()
 	tests := []struct {
@@ -21,6 +21,8 @@ const (
 	moduleSourceKey = "source"
 	// moduleVersionKey represents the key for the module version parameter.
 	moduleVersionKey = "version"
+	// providerAliasKey represents the key for the alias of a provider configuration.
+	providerAliasKey = "alias"
 
 	mainConfigFileName = "main.tf.json"
 )
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
+)
+
+// terraformEnv contains the environment of the Terraform processes run to execute a recipe, such as the
+// credentials of private module sources and the location of the provider plugin cache.
+type terraformEnv struct {
+	// env contains the environment variables added to the Terraform process.
+	env map[string]string
+
+	// sensitiveValues contains the credentials, which are redacted from the Terraform logs.
+	sensitiveValues []string
+
+	// recorder records the log of the operation. The sensitive values are registered with it as soon as they are
+	// added, so that they are redacted before any output of Terraform is recorded and saved.
+	recorder *oplog.Recorder
+}
+
+// newTerraformEnv creates an empty terraformEnv.
+func newTerraformEnv() *terraformEnv {
+	return &terraformEnv{env: map[string]string{}}
+}
+
+// addSensitiveEnv adds environment variables containing credentials to the Terraform process. Their values are
+// redacted from the logs.
+func (tfEnv *terraformEnv) addSensitiveEnv(env map[string]string) {
+	for k, v := range env {
+		tfEnv.env[k] = v
+		tfEnv.sensitiveValues = append(tfEnv.sensitiveValues, v)
+		tfEnv.recorder.AddSensitiveValues(v)
+	}
+}
+
+// addSensitiveValues adds credentials which are redacted from the logs.
+func (tfEnv *terraformEnv) addSensitiveValues(values ...string) {
+	tfEnv.sensitiveValues = append(tfEnv.sensitiveValues, values...)
+	tfEnv.recorder.AddSensitiveValues(values...)
+}
+
+// apply adds the environment variables to the Terraform process and redacts the sensitive values from its logs.
+// It is a no-op if tfEnv is nil or empty.
+func (tfEnv *terraformEnv) apply(ctx context.Context, tf *tfexec.Terraform) error {
+	if tfEnv == nil || (len(tfEnv.env) == 0 && len(tfEnv.sensitiveValues) == 0) {
+		return nil
+	}
+
+	// Terraform uses only the given environment variables once they are set, so the environment of
+	// the current process needs to be added.
+	env := map[string]string{}
+	for _, kv := range os.Environ() {
+		if k, v, found := strings.Cut(kv, "="); found {
+			env[k] = v
+		}
+	}
+	env = tfexec.CleanEnv(env)
+	for k, v := range tfEnv.env {
+		env[k] = v
+	}
+
+	if err := tf.SetEnv(env); err != nil {
+		return err
+	}
+
+	configureTerraformLogs(ctx, tf, tfEnv.sensitiveValues...)
+
+	return nil
+}
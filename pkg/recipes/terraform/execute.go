@@ -26,15 +26,19 @@ import (
 	"time"
 
 	install "github.com/hashicorp/hc-install"
+	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/terraform/cache"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/providers"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
 	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
@@ -45,6 +49,9 @@ import (
 const (
 	executionSubDir                = "deploy"
 	workingDirFileMode fs.FileMode = 0700
+
+	// planFileName is the name of the file Terraform plan writes the plan to.
+	planFileName = "tfplan"
 )
 
 var (
@@ -55,8 +62,9 @@ var (
 var _ TerraformExecutor = (*executor)(nil)
 
 // NewExecutor creates a new Executor with the given UCP connection and secret provider, to execute a Terraform recipe.
-func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface) *executor {
-	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet}
+// tfCache is the cache of provider plugins and modules shared by the executions, and can be nil to disable caching.
+func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface, secretsLoader configloader.SecretsLoader, tfCache *cache.Cache) *executor {
+	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet, secretsLoader: secretsLoader, cache: tfCache}
 }
 
 type executor struct {
@@ -68,6 +76,12 @@ type executor struct {
 
 	// k8sClientSet is the Kubernetes client.
 	k8sClientSet kubernetes.Interface
+
+	// secretsLoader is used to fetch the secrets referenced by the Terraform provider configurations of the environment.
+	secretsLoader configloader.SecretsLoader
+
+	// cache is the cache of Terraform provider plugins and modules shared by the executions. Caching is disabled if it is nil.
+	cache *cache.Cache
 }
 
 // Deploy installs Terraform, creates a working directory, generates a config, and runs Terraform init and
@@ -95,28 +109,107 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 		return nil, err
 	}
 
+	tfEnv, err := e.prepareEnv(ctx, options.RootDir, options.EnvConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := backends.NewBackend(ctx, options.EnvConfig, e.k8sClientSet, e.ucpConn, e.secretProvider, e.secretsLoader)
+	if err != nil {
+		return nil, err
+	}
+	tfEnv.addSensitiveEnv(backend.BuildEnv())
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, workingDir, execPath, options)
+	stateName, err := e.generateConfig(ctx, workingDir, execPath, options, backend, tfEnv)
 	if err != nil {
 		return nil, err
 	}
 
+	// The S3 bucket is not created by Terraform, so it is validated before Terraform init and apply. The state of the
+	// other backends is created by Terraform apply and is validated after apply.
+	backendKind := backends.GetBackendKind(options.EnvConfig)
+	if backendKind == backends.BackendS3 {
+		if err := validateBackendExists(ctx, backend, backendKind, stateName); err != nil {
+			return nil, err
+		}
+	}
+
 	// Run TF Init and Apply in the working directory
-	state, err := initAndApply(ctx, workingDir, execPath)
+	state, err := initAndApply(ctx, workingDir, execPath, tfEnv)
+	e.storeProviders(ctx, workingDir)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	if backendKind != backends.BackendS3 {
+		if err := validateBackendExists(ctx, backend, backendKind, stateName); err != nil {
+			return nil, err
+		}
+	}
+
+	return state, nil
+}
+
+// validateBackendExists validates that the Terraform state file backend source exists.
+func validateBackendExists(ctx context.Context, backend backends.Backend, backendKind string, stateName string) error {
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
+		return fmt.Errorf("error retrieving terraform state from the %s backend: %w", backendKind, err)
 	} else if !backendExists {
-		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+		return fmt.Errorf("expected terraform state is not found in the %s backend", backendKind)
 	}
 
-	return state, nil
+	return nil
+}
+
+// Plan installs Terraform, creates a working directory, generates a config, and runs Terraform init and plan in the
+// working directory, returning the plan or an error if any of these steps fail.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Install Terraform
+	i := install.NewInstaller()
+	execPath, err := Install(ctx, i, options.RootDir)
+	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
+	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
+	defer func() {
+		if err := i.Remove(ctx); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Working Directory
+	workingDir, err := createWorkingDir(ctx, options.RootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tfEnv, err := e.prepareEnv(ctx, options.RootDir, options.EnvConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := backends.NewBackend(ctx, options.EnvConfig, e.k8sClientSet, e.ucpConn, e.secretProvider, e.secretsLoader)
+	if err != nil {
+		return nil, err
+	}
+	tfEnv.addSensitiveEnv(backend.BuildEnv())
+
+	// Create Terraform config in the working directory
+	_, err = e.generateConfig(ctx, workingDir, execPath, options, backend, tfEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run TF Init and Plan in the working directory
+	plan, err := initAndPlan(ctx, workingDir, execPath, tfEnv)
+	e.storeProviders(ctx, workingDir)
+
+	return plan, err
 }
 
 // Delete installs Terraform, creates a working directory, generates a config, and runs Terraform destroy
@@ -144,8 +237,19 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return err
 	}
 
+	tfEnv, err := e.prepareEnv(ctx, options.RootDir, options.EnvConfig)
+	if err != nil {
+		return err
+	}
+
+	backend, err := backends.NewBackend(ctx, options.EnvConfig, e.k8sClientSet, e.ucpConn, e.secretProvider, e.secretsLoader)
+	if err != nil {
+		return err
+	}
+	tfEnv.addSensitiveEnv(backend.BuildEnv())
+
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, workingDir, execPath, options)
+	stateName, err := e.generateConfig(ctx, workingDir, execPath, options, backend, tfEnv)
 	if err != nil {
 		return err
 	}
@@ -153,7 +257,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
 	// If the state file source has been deleted or wasn't created due to a failure during apply then
 	// terraform initialization will fail due to missing backend source.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	backendExists, err := backend.ValidateBackendExists(ctx, stateName)
 	if err != nil {
 		// Continue with the delete flow for all errors other than backend not found.
 		// If it is an intermittent error then the delete flow will fail and should be retried from the client.
@@ -165,17 +269,21 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	}
 
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
-	err = initAndDestroy(ctx, workingDir, execPath)
+	err = initAndDestroy(ctx, workingDir, execPath, tfEnv)
+	e.storeProviders(ctx, workingDir)
 	if err != nil {
 		return err
 	}
 
-	// Delete the kubernetes secret created for terraform state file.
-	err = e.k8sClientSet.CoreV1().
-		Secrets(backends.RadiusNamespace).
-		Delete(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix, metav1.DeleteOptions{})
-	if err != nil {
-		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+	// Delete the kubernetes secret created for terraform state file. The state stored in the other backends is left
+	// empty by terraform destroy.
+	if backends.GetBackendKind(options.EnvConfig) == backends.BackendKubernetes {
+		err = e.k8sClientSet.CoreV1().
+			Secrets(backends.RadiusNamespace).
+			Delete(ctx, stateName, metav1.DeleteOptions{})
+		if err != nil {
+			return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+		}
 	}
 
 	return nil
@@ -204,12 +312,17 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 		return nil, err
 	}
 
+	tfEnv, err := e.prepareEnv(ctx, options.RootDir, options.EnvConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = getTerraformConfig(ctx, workingDir, options)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := downloadAndInspect(ctx, workingDir, execPath, options)
+	result, err := e.downloadAndInspect(ctx, workingDir, execPath, options, tfEnv)
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +332,18 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 	}, nil
 }
 
+// prepareEnv creates the environment of the Terraform processes run to execute the recipe in rootDir.
+func (e *executor) prepareEnv(ctx context.Context, rootDir string, envConfig *recipes.Configuration) (*terraformEnv, error) {
+	tfEnv := newTerraformEnv()
+	tfEnv.recorder = oplog.FromContext(ctx)
+	if err := e.configureAuth(ctx, tfEnv, rootDir, envConfig); err != nil {
+		return nil, err
+	}
+	e.configureProviderCache(ctx, tfEnv, rootDir)
+
+	return tfEnv, nil
+}
+
 func createWorkingDir(ctx context.Context, tfDir string) (string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -232,7 +357,8 @@ func createWorkingDir(ctx context.Context, tfDir string) (string, error) {
 }
 
 // generateConfig generates Terraform configuration with required inputs for the module, providers and backend to be initialized and applied.
-func (e *executor) generateConfig(ctx context.Context, workingDir, execPath string, options Options) (string, error) {
+// It returns the name of the Terraform state of the resource in the backend.
+func (e *executor) generateConfig(ctx context.Context, workingDir, execPath string, options Options, backend backends.Backend, tfEnv *terraformEnv) (string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	tfConfig, err := getTerraformConfig(ctx, workingDir, options)
@@ -240,30 +366,34 @@ func (e *executor) generateConfig(ctx context.Context, workingDir, execPath stri
 		return "", err
 	}
 
-	loadedModule, err := downloadAndInspect(ctx, workingDir, execPath, options)
+	loadedModule, err := e.downloadAndInspect(ctx, workingDir, execPath, options, tfEnv)
 	if err != nil {
 		return "", err
 	}
 
+	// Fetch the secrets referenced by the provider configurations of the environment for required providers.
+	secrets, err := e.loadProviderSecrets(ctx, loadedModule.RequiredProviders, options.EnvConfig)
+	if err != nil {
+		return "", err
+	}
+	tfEnv.addSensitiveValues(getProviderSecretValues(loadedModule.RequiredProviders, options.EnvConfig, secrets)...)
+
 	// Generate Terraform providers configuration for required providers and add it to the Terraform configuration.
 	logger.Info(fmt.Sprintf("Adding provider config for required providers %+v", loadedModule.RequiredProviders))
 	if err := tfConfig.AddProviders(ctx, loadedModule.RequiredProviders, providers.GetSupportedTerraformProviders(e.ucpConn, e.secretProvider),
-		options.EnvConfig); err != nil {
+		options.EnvConfig, secrets); err != nil {
 		return "", err
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(e.k8sClientSet))
+	_, err = tfConfig.AddTerraformBackend(options.ResourceRecipe, backend)
 	if err != nil {
 		return "", err
 	}
-	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
-	var secretSuffix string
-	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
-		backendMap := backendDetails.(map[string]any)
-		if secret, ok := backendMap["secret_suffix"]; ok {
-			secretSuffix = secret.(string)
-		}
+
+	// The state name is used to verify the state creation during terraform apply.
+	stateName, err := backends.GetStateName(options.EnvConfig, options.ResourceRecipe)
+	if err != nil {
+		return "", err
 	}
 
 	// Add recipe context parameter to the generated Terraform config's module parameters.
@@ -294,25 +424,77 @@ func (e *executor) generateConfig(ctx context.Context, workingDir, execPath stri
 		return "", err
 	}
 
-	return secretSuffix, nil
+	return stateName, nil
 }
 
-// downloadAndInspect handles downloading the TF module and retrieving the necessary information
-func downloadAndInspect(ctx context.Context, workingDir string, execPath string, options Options) (*moduleInspectResult, error) {
+// loadProviderSecrets fetches the secrets of the secret stores referenced by the provider configurations of the environment
+// for requiredProviders. It returns the secrets keyed by the secret store ID.
+func (e *executor) loadProviderSecrets(ctx context.Context, requiredProviders []string, envConfig *recipes.Configuration) (map[string]map[string]string, error) {
+	secrets := map[string]map[string]string{}
+	secretStoreIDs := config.GetProviderSecretStoreIDs(requiredProviders, envConfig)
+	if len(secretStoreIDs) == 0 {
+		return secrets, nil
+	}
+
+	if e.secretsLoader == nil {
+		return nil, errors.New("secrets loader is not configured to fetch the secrets of the Terraform provider configurations")
+	}
+
+	for _, id := range secretStoreIDs {
+		data, err := e.secretsLoader.LoadSecrets(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		secrets[id] = data
+	}
+
+	return secrets, nil
+}
+
+// getProviderSecretValues returns the values of the secrets referenced by the provider configurations of the environment
+// for requiredProviders, so that they can be redacted from the logs.
+func getProviderSecretValues(requiredProviders []string, envConfig *recipes.Configuration, secrets map[string]map[string]string) []string {
+	if envConfig == nil {
+		return nil
+	}
+
+	values := []string{}
+	for _, provider := range requiredProviders {
+		for _, config := range envConfig.RecipeConfig.Terraform.Providers[provider] {
+			for _, secret := range config.Secrets {
+				if value, ok := secrets[secret.Source][secret.Key]; ok {
+					values = append(values, value)
+				}
+			}
+		}
+	}
+
+	return values
+}
+
+// downloadAndInspect handles downloading the TF module and retrieving the necessary information.
+// The module is restored from the cache instead of being downloaded if it is cached.
+func (e *executor) downloadAndInspect(ctx context.Context, workingDir string, execPath string, options Options, tfEnv *terraformEnv) (*moduleInspectResult, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Download the Terraform module to the working directory.
-	logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
-	downloadStartTime := time.Now()
-	if err := downloadModule(ctx, workingDir, execPath, options.EnvRecipe.TemplatePath); err != nil {
+	if e.restoreModules(ctx, workingDir, options) {
+		logger.Info(fmt.Sprintf("Restored Terraform module from the cache: %s", options.EnvRecipe.TemplatePath))
+	} else {
+		// Download the Terraform module to the working directory.
+		logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
+		downloadStartTime := time.Now()
+		if err := downloadModule(ctx, workingDir, execPath, options.EnvRecipe.TemplatePath, tfEnv); err != nil {
+			metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+				metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
+					options.EnvRecipe, recipes.RecipeDownloadFailed))
+			return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+		}
 		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
-				options.EnvRecipe, recipes.RecipeDownloadFailed))
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+				options.EnvRecipe, metrics.SuccessfulOperationState))
+
+		e.storeModules(ctx, workingDir, options)
 	}
-	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
-			options.EnvRecipe, metrics.SuccessfulOperationState))
 
 	// Load the downloaded module to retrieve providers and variables required by the module.
 	// This is needed to add the appropriate providers config and populate the value of recipe context variable.
@@ -349,14 +531,19 @@ func getTerraformConfig(ctx context.Context, workingDir string, options Options)
 	return tfConfig, nil
 }
 
-// initAndApply runs Terraform init and apply in the provided working directory.
-func initAndApply(ctx context.Context, workingDir, execPath string) (*tfjson.State, error) {
+// initAndApply runs Terraform init and apply in the provided working directory, with the environment variables of tfEnv.
+func initAndApply(ctx context.Context, workingDir, execPath string, tfEnv *terraformEnv) (*tfjson.State, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	tf, err := NewTerraform(ctx, workingDir, execPath)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := tfEnv.apply(ctx, tf); err != nil {
+		return nil, err
+	}
+
 	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 
@@ -381,8 +568,40 @@ func initAndApply(ctx context.Context, workingDir, execPath string) (*tfjson.Sta
 	return tf.Show(ctx)
 }
 
+// initAndPlan runs Terraform init and plan in the provided working directory and returns the plan.
+func initAndPlan(ctx context.Context, workingDir, execPath string, tfEnv *terraformEnv) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	tf, err := NewTerraform(ctx, workingDir, execPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tfEnv.apply(ctx, tf); err != nil {
+		return nil, err
+	}
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+
+	terraformInitStartTime := time.Now()
+	if err := tf.Init(ctx); err != nil {
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime, nil)
+
+	// Plan Terraform configuration and save the plan so that it can be read as JSON.
+	logger.Info("Running Terraform plan")
+	planFile := filepath.Join(workingDir, planFileName)
+	if _, err := tf.Plan(ctx, tfexec.Out(planFile)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	return tf.ShowPlanFile(ctx, planFile)
+}
+
 // initAndDestroy runs Terraform init and destroy in the provided working directory.
-func initAndDestroy(ctx context.Context, workingDir, execPath string) error {
+func initAndDestroy(ctx context.Context, workingDir, execPath string, tfEnv *terraformEnv) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	tf, err := NewTerraform(ctx, workingDir, execPath)
@@ -390,6 +609,10 @@ func initAndDestroy(ctx context.Context, workingDir, execPath string) error {
 		return err
 	}
 
+	if err := tfEnv.apply(ctx, tf); err != nil {
+		return err
+	}
+
 	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 
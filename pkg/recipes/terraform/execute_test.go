@@ -17,12 +17,17 @@ limitations under the License.
 package terraform
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
@@ -62,7 +67,7 @@ func TestInitAndApply_EmptyWorkingDirPath(t *testing.T) {
 	testDir := t.TempDir()
 	execPath := filepath.Join(testDir, "terraform")
 
-	_, err := initAndApply(testcontext.New(t), "", execPath)
+	_, err := initAndApply(testcontext.New(t), "", execPath, nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Terraform cannot be initialised with empty workdir")
 }
@@ -114,7 +119,7 @@ func TestGeneratedConfig(t *testing.T) {
 			}
 			execPath := filepath.Join(tc.workingDir, "terraform")
 			e := executor{}
-			_, err := e.generateConfig(ctx, tc.workingDir, execPath, tc.opts)
+			_, err := e.generateConfig(ctx, tc.workingDir, execPath, tc.opts, backends.NewKubernetesBackend(nil), nil)
 			require.Error(t, err)
 			require.ErrorContains(t, err, tc.err)
 		})
@@ -178,3 +183,54 @@ func Test_GetTerraformConfig_InvalidDirectory(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "error creating file: open invalid directory/main.tf.json: no such file or directory")
 }
+
+func TestLoadProviderSecrets(t *testing.T) {
+	const secretStoreID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/pg-secrets"
+	envConfig := &recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Providers: map[string][]datamodel.ProviderConfigProperties{
+					"postgresql": {
+						{
+							Secrets: map[string]datamodel.SecretReference{
+								"password": {Source: secretStoreID, Key: "password"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("secrets of required providers are loaded", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+		secretsLoader.EXPECT().LoadSecrets(ctx, secretStoreID).Times(1).Return(map[string]string{"password": "test-password"}, nil)
+
+		e := executor{secretsLoader: secretsLoader}
+		secrets, err := e.loadProviderSecrets(ctx, []string{"postgresql"}, envConfig)
+		require.NoError(t, err)
+		require.Equal(t, map[string]map[string]string{secretStoreID: {"password": "test-password"}}, secrets)
+		require.Equal(t, []string{"test-password"}, getProviderSecretValues([]string{"postgresql"}, envConfig, secrets))
+	})
+
+	t.Run("secrets of other providers are not loaded", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+
+		e := executor{secretsLoader: secretsLoader}
+		secrets, err := e.loadProviderSecrets(ctx, []string{"random"}, envConfig)
+		require.NoError(t, err)
+		require.Empty(t, secrets)
+	})
+
+	t.Run("load error", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		secretsLoader := configloader.NewMockSecretsLoader(gomock.NewController(t))
+		secretsLoader.EXPECT().LoadSecrets(ctx, secretStoreID).Times(1).Return(nil, errors.New("secret store not found"))
+
+		e := executor{secretsLoader: secretsLoader}
+		_, err := e.loadProviderSecrets(ctx, []string{"postgresql"}, envConfig)
+		require.EqualError(t, err, "secret store not found")
+	})
+}
@@ -97,13 +97,17 @@ func inspectModule(workingDir, localModuleName string) (*moduleInspectResult, er
 
 // downloadModule downloads the module to the workingDir from the module source specified in the Terraform configuration.
 // It uses Terraform's Get command to download the module using the Terraform executable available at execPath.
-// An error is returned if the module could not be downloaded.
-func downloadModule(ctx context.Context, workingDir, execPath, templatePath string) error {
+// tfEnv contains the environment variables used to download the module, such as the credentials of private sources. An error is returned if the module could not be downloaded.
+func downloadModule(ctx context.Context, workingDir, execPath, templatePath string, tfEnv *terraformEnv) error {
 	tf, err := NewTerraform(ctx, workingDir, execPath)
 	if err != nil {
 		return err
 	}
 
+	if err := tfEnv.apply(ctx, tf); err != nil {
+		return err
+	}
+
 	if err = tf.Get(ctx); err != nil {
 		return fmt.Errorf("failed to run terraform get to download the module from source %q: %w", templatePath, err)
 	}
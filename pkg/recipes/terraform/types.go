@@ -17,22 +17,36 @@ limitations under the License.
 package terraform
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/oplog"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
+const (
+	// redactedValue replaces the sensitive values in the Terraform logs.
+	redactedValue = "***"
+)
+
 //go:generate mockgen -destination=./mock_executor.go -package=terraform -self_package github.com/radius-project/radius/pkg/recipes/terraform github.com/radius-project/radius/pkg/recipes/terraform TerraformExecutor
 
 type TerraformExecutor interface {
 	// Deploy installs terraform and runs terraform init and apply on the terraform module referenced by the recipe using terraform-exec.
 	Deploy(ctx context.Context, options Options) (*tfjson.State, error)
 
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe using terraform-exec.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
+
 	// Delete installs terraform and runs terraform destroy on the terraform module referenced by the recipe using terraform-exec,
 	// and deletes the Kubernetes secret created for terraform state store.
 	Delete(ctx context.Context, options Options) error
@@ -72,20 +86,65 @@ func NewTerraform(ctx context.Context, workingDir, execPath string) (*tfexec.Ter
 type tfLogWrapper struct {
 	logger   logr.Logger
 	isStdErr bool
+
+	// redactor replaces sensitive values in the logs. It is nil if there are no sensitive values.
+	redactor *strings.Replacer
 }
 
 // Write implements the io.Writer interface to stream the Terraform logs to the Radius logger.
 func (w *tfLogWrapper) Write(p []byte) (n int, err error) {
+	msg := string(p)
+	if w.redactor != nil {
+		msg = w.redactor.Replace(msg)
+	}
+
 	if w.isStdErr {
-		w.logger.Error(nil, string(p))
+		w.logger.Error(nil, msg)
 	} else {
-		w.logger.Info(string(p))
+		w.logger.Info(msg)
+	}
+	return len(p), nil
+}
+
+// internalLogLine matches the lines of the internal logs of Terraform, which it writes to stderr along with its errors.
+var internalLogLine = regexp.MustCompile(`^\S+ \[(TRACE|DEBUG|INFO|WARN|ERROR)\] `)
+
+// tfOutputFilter writes the lines of the Terraform output to the underlying writer, except the lines of the internal
+// logs of Terraform, which are too verbose for the operation log.
+type tfOutputFilter struct {
+	w io.Writer
+
+	mu      sync.Mutex
+	pending bytes.Buffer
+}
+
+// Write implements the io.Writer interface. Incomplete lines are kept until their end is written.
+func (f *tfOutputFilter) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.pending.Write(p)
+	for {
+		i := bytes.IndexByte(f.pending.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+
+		line := f.pending.Next(i + 1)
+		if internalLogLine.Match(line) {
+			continue
+		}
+		if _, err := f.w.Write(line); err != nil {
+			return len(p), err
+		}
 	}
+
 	return len(p), nil
 }
 
-// configureTerraformLogs configures the Terraform logs to be streamed to the Radius logs.
-func configureTerraformLogs(ctx context.Context, tf *tfexec.Terraform) {
+// configureTerraformLogs configures the Terraform logs to be streamed to the Radius logs. sensitiveValues are
+// redacted from the logs.
+func configureTerraformLogs(ctx context.Context, tf *tfexec.Terraform, sensitiveValues ...string) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	err := tf.SetLog("TRACE")
@@ -94,6 +153,25 @@ func configureTerraformLogs(ctx context.Context, tf *tfexec.Terraform) {
 		return
 	}
 
-	tf.SetStdout(&tfLogWrapper{logger: logger})
-	tf.SetStderr(&tfLogWrapper{logger: logger, isStdErr: true})
+	var redactor *strings.Replacer
+	if len(sensitiveValues) > 0 {
+		oldnew := []string{}
+		for _, value := range sensitiveValues {
+			if value != "" {
+				oldnew = append(oldnew, value, redactedValue)
+			}
+		}
+		redactor = strings.NewReplacer(oldnew...)
+	}
+
+	// The output of Terraform is also recorded in the log of the operation, without the internal logs of Terraform.
+	// The sensitive values are registered with the recorder when they are added to the environment of Terraform.
+	recorder := oplog.FromContext(ctx)
+
+	tf.SetStdout(io.MultiWriter(
+		&tfLogWrapper{logger: logger, redactor: redactor},
+		recorder.Writer(oplog.LevelInfo, recipes.TemplateKindTerraform)))
+	tf.SetStderr(io.MultiWriter(
+		&tfLogWrapper{logger: logger, isStdErr: true, redactor: redactor},
+		&tfOutputFilter{w: recorder.Writer(oplog.LevelError, recipes.TemplateKindTerraform)}))
 }
@@ -0,0 +1,45 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTFOutputFilter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	f := &tfOutputFilter{w: buf}
+
+	lines := []string{
+		"2023-10-18T10:00:00.000Z [TRACE] provider: starting plugin\n",
+		"Error: creating S3 Bucket: AccessDenied\n",
+		"2023-10-18T10:00:00.000Z [DEBUG] ",
+		"provider: plugin exited\n",
+		"  with aws_s3_bucket.b,\n",
+		"incomplete",
+	}
+	for _, line := range lines {
+		n, err := f.Write([]byte(line))
+		require.NoError(t, err)
+		require.Equal(t, len(line), n)
+	}
+
+	require.Equal(t, "Error: creating S3 Bucket: AccessDenied\n  with aws_s3_bucket.b,\n", buf.String())
+}
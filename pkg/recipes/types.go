@@ -31,6 +31,8 @@ type Configuration struct {
 	Providers datamodel.Providers
 	// Simulated represents whether the environment is simulated or not.
 	Simulated bool
+	// RecipeConfig represents the configuration for the recipes of the environment.
+	RecipeConfig datamodel.RecipeConfigProperties
 }
 
 // RuntimeConfiguration represents Kubernetes Runtime configuration for the environment.
@@ -77,15 +79,17 @@ type ResourceMetadata struct {
 }
 
 const (
-	TemplateKindBicep     = "bicep"
-	TemplateKindTerraform = "terraform"
+	TemplateKindBicep      = "bicep"
+	TemplateKindTerraform  = "terraform"
+	TemplateKindHelm       = "helm"
+	TemplateKindKubernetes = "kubernetes"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
 )
 
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindHelm, TemplateKindKubernetes}
 )
 
 // RecipeOutput represents recipe deployment output.
@@ -129,3 +133,51 @@ func (ro *RecipeOutput) PrepareRecipeResponse(resultValue map[string]any) error
 
 	return nil
 }
+
+const (
+	// PlanRecipeAction is the name of the action on portable resources that plans the changes of the recipe of the
+	// resource. It is served by POST {resourceId}/planRecipe.
+	PlanRecipeAction = "planRecipe"
+)
+
+// ChangeType represents the type of change a recipe deployment makes to a resource.
+type ChangeType string
+
+const (
+	// ChangeTypeCreate means the resource does not exist and will be created.
+	ChangeTypeCreate ChangeType = "Create"
+
+	// ChangeTypeModify means the resource exists and will be modified.
+	ChangeTypeModify ChangeType = "Modify"
+
+	// ChangeTypeReplace means the resource exists and will be deleted and created again.
+	ChangeTypeReplace ChangeType = "Replace"
+
+	// ChangeTypeDelete means the resource exists and will be deleted.
+	ChangeTypeDelete ChangeType = "Delete"
+
+	// ChangeTypeNoChange means the resource exists and will not change.
+	ChangeTypeNoChange ChangeType = "NoChange"
+
+	// ChangeTypeUnsupported means the change to the resource could not be determined.
+	ChangeTypeUnsupported ChangeType = "Unsupported"
+)
+
+// RecipePlan represents the changes that deploying a recipe would make, without making them.
+type RecipePlan struct {
+	// Changes is the list of changes to the resources deployed by the recipe.
+	Changes []ResourceChange `json:"changes"`
+}
+
+// ResourceChange represents the change to a single resource deployed by a recipe.
+type ResourceChange struct {
+	// ID is the ID of the resource. Resources that are not created yet and whose ID is not known before deployment
+	// are identified by their address in the recipe template instead, for example a Terraform resource address.
+	ID string `json:"id"`
+
+	// Type is the type of the resource.
+	Type string `json:"type,omitempty"`
+
+	// ChangeType is the type of change to the resource.
+	ChangeType ChangeType `json:"changeType"`
+}
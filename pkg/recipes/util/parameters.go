@@ -0,0 +1,47 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+// ParametersFromDefaults returns the recipe parameters for templates whose parameters are declared as a map of default
+// values, such as the values of a Helm chart. Each parameter has the type and the default value of the entry.
+func ParametersFromDefaults(defaults map[string]any) map[string]any {
+	parameters := map[string]any{}
+	for name, value := range defaults {
+		parameters[name] = map[string]any{
+			"type":         valueType(value),
+			"defaultValue": value,
+		}
+	}
+	return parameters
+}
+
+func valueType(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int, int32, int64, float32, float64:
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return "any"
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParametersFromDefaults(t *testing.T) {
+	parameters := ParametersFromDefaults(map[string]any{
+		"replicas": float64(1),
+		"image":    "redis:7",
+		"tls":      false,
+		"auth":     map[string]any{"enabled": true},
+		"hosts":    []any{"a"},
+		"extra":    nil,
+	})
+
+	require.Equal(t, map[string]any{
+		"replicas": map[string]any{"type": "number", "defaultValue": float64(1)},
+		"image":    map[string]any{"type": "string", "defaultValue": "redis:7"},
+		"tls":      map[string]any{"type": "bool", "defaultValue": false},
+		"auth":     map[string]any{"type": "object", "defaultValue": map[string]any{"enabled": true}},
+		"hosts":    map[string]any{"type": "array", "defaultValue": []any{"a"}},
+		"extra":    map[string]any{"type": "any", "defaultValue": nil},
+	}, parameters)
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// recipeContextParameter is the name of the parameter used to pass the recipe context to the recipe. It is set
+	// by Radius, so it is never validated.
+	recipeContextParameter = "context"
+
+	parameterTypeString = "string"
+	parameterTypeNumber = "number"
+	parameterTypeInt    = "int"
+	parameterTypeBool   = "bool"
+	parameterTypeArray  = "array"
+	parameterTypeObject = "object"
+	parameterTypeAny    = "any"
+)
+
+// declaredParameter is a parameter declared by a recipe template.
+type declaredParameter struct {
+	// declaredType is the type as declared by the template, for example "secureString" or "list(string)".
+	declaredType string
+	// kind is the type of the values accepted for the parameter, for example "string" or "array".
+	kind string
+	// allowedValues are the values allowed for the parameter. All values are allowed if it is empty.
+	allowedValues []any
+	// required is true if the parameter doesn't have a default value.
+	required bool
+}
+
+// ValidateParameters validates parameters against the parameters declared by the recipe template in metadata, as returned
+// by the GetRecipeMetadata function of the recipe drivers. It returns an error for each parameter which isn't declared,
+// doesn't have the declared type or isn't one of the allowed values, with the path of the parameter under target as the
+// error target. If convertPrimitives is true, a primitive value is accepted for a parameter of another primitive type if
+// it can be converted to that type, as Terraform does.
+func ValidateParameters(metadata map[string]any, parameters map[string]any, target string, convertPrimitives bool) []v1.ErrorDetails {
+	declared := getDeclaredParameters(metadata)
+
+	errs := []v1.ErrorDetails{}
+	for _, name := range sortedKeys(parameters) {
+		if name == recipeContextParameter {
+			continue
+		}
+
+		parameterTarget := fmt.Sprintf("%s.%s", target, name)
+		parameter, ok := declared[name]
+		if !ok {
+			errs = append(errs, newParameterError(parameterTarget, fmt.Sprintf("parameter %q is not declared by the recipe", name)))
+			continue
+		}
+
+		value := parameters[name]
+		if value == nil {
+			continue
+		}
+
+		if !isKind(value, parameter.kind, convertPrimitives) {
+			errs = append(errs, newParameterError(parameterTarget,
+				fmt.Sprintf("parameter %q must be of type %q, but the value is of type %q", name, parameter.declaredType, valueType(value))))
+			continue
+		}
+
+		if !isAllowed(value, parameter) {
+			allowed, _ := json.Marshal(parameter.allowedValues)
+			errs = append(errs, newParameterError(parameterTarget, fmt.Sprintf("parameter %q must be one of %s", name, string(allowed))))
+		}
+	}
+
+	return errs
+}
+
+// ValidateRequiredParameters returns an error for each parameter declared as required by the recipe template in metadata
+// which is in none of parameters, with target as the error target.
+func ValidateRequiredParameters(metadata map[string]any, target string, parameters ...map[string]any) []v1.ErrorDetails {
+	declared := getDeclaredParameters(metadata)
+
+	errs := []v1.ErrorDetails{}
+	for _, name := range sortedKeys(declared) {
+		if name == recipeContextParameter || !declared[name].required {
+			continue
+		}
+
+		found := false
+		for _, p := range parameters {
+			if _, ok := p[name]; ok {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			errs = append(errs, newParameterError(target, fmt.Sprintf("parameter %q is required by the recipe", name)))
+		}
+	}
+
+	return errs
+}
+
+// getDeclaredParameters reads the declared parameters from the recipe metadata. Parameters which are not in the
+// expected format are ignored.
+func getDeclaredParameters(metadata map[string]any) map[string]declaredParameter {
+	declared := map[string]declaredParameter{}
+
+	parameters, ok := metadata["parameters"].(map[string]any)
+	if !ok {
+		return declared
+	}
+
+	for name, value := range parameters {
+		details, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		declaredType, _ := details["type"].(string)
+		allowedValues, _ := details["allowedValues"].([]any)
+
+		// Terraform recipes declare whether a parameter is required, and Bicep recipes require the parameters
+		// without a default value.
+		required, ok := details["required"].(bool)
+		if !ok {
+			_, hasDefault := details["defaultValue"]
+			required = !hasDefault
+		}
+
+		declared[name] = declaredParameter{
+			declaredType:  declaredType,
+			kind:          parameterKind(declaredType),
+			allowedValues: allowedValues,
+			required:      required,
+		}
+	}
+
+	return declared
+}
+
+// parameterKind returns the type of the values accepted for a parameter of the declared Bicep or Terraform type.
+func parameterKind(declaredType string) string {
+	t := strings.ToLower(strings.TrimSpace(declaredType))
+	switch {
+	case t == "string" || t == "securestring":
+		return parameterTypeString
+	case t == "number":
+		return parameterTypeNumber
+	case t == "int":
+		return parameterTypeInt
+	case t == "bool":
+		return parameterTypeBool
+	case t == "array" || t == "list" || t == "set" ||
+		strings.HasPrefix(t, "list(") || strings.HasPrefix(t, "set(") || strings.HasPrefix(t, "tuple("):
+		return parameterTypeArray
+	case t == "object" || t == "secureobject" || t == "map" ||
+		strings.HasPrefix(t, "map(") || strings.HasPrefix(t, "object("):
+		return parameterTypeObject
+	default:
+		// Parameters without a type or with an unknown type accept any value.
+		return parameterTypeAny
+	}
+}
+
+// isKind returns true if value is accepted for a parameter of the given kind.
+func isKind(value any, kind string, convertPrimitives bool) bool {
+	actual := valueType(value)
+	switch kind {
+	case parameterTypeAny:
+		return true
+	case parameterTypeInt:
+		f, ok := toFloat(value)
+		return ok && f == math.Trunc(f)
+	case actual:
+		return true
+	}
+
+	if !convertPrimitives {
+		return false
+	}
+
+	// Terraform converts between the primitive types when the value can be represented in the target type.
+	// https://developer.hashicorp.com/terraform/language/expressions/type-constraints#conversion-of-primitive-types
+	switch kind {
+	case parameterTypeString:
+		return actual == parameterTypeNumber || actual == parameterTypeBool
+	case parameterTypeNumber:
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		_, err := strconv.ParseFloat(s, 64)
+		return err == nil
+	case parameterTypeBool:
+		return value == "true" || value == "false"
+	default:
+		return false
+	}
+}
+
+// isAllowed returns true if value is one of the allowed values of the parameter. The elements of array values
+// must each be one of the allowed values.
+func isAllowed(value any, parameter declaredParameter) bool {
+	if len(parameter.allowedValues) == 0 {
+		return true
+	}
+
+	values := []any{value}
+	if array, ok := value.([]any); ok && parameter.kind == parameterTypeArray {
+		values = array
+	}
+
+	for _, v := range values {
+		allowed := false
+		for _, a := range parameter.allowedValues {
+			if reflect.DeepEqual(normalizeValue(v), normalizeValue(a)) {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeValue converts the numbers in value to float64, so that values can be compared regardless of the numeric
+// types they were decoded to.
+func normalizeValue(value any) any {
+	if f, ok := toFloat(value); ok {
+		return f
+	}
+
+	switch v := value.(type) {
+	case []any:
+		normalized := make([]any, len(v))
+		for i, e := range v {
+			normalized[i] = normalizeValue(e)
+		}
+		return normalized
+	case map[string]any:
+		normalized := make(map[string]any, len(v))
+		for k, e := range v {
+			normalized[k] = normalizeValue(e)
+		}
+		return normalized
+	default:
+		return value
+	}
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func newParameterError(target, message string) v1.ErrorDetails {
+	return v1.ErrorDetails{
+		Code:    v1.CodeInvalidRequestContent,
+		Target:  target,
+		Message: message,
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
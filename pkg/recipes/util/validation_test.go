@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+const testTarget = "$.properties.recipe.parameters"
+
+func newTestParameterError(target, message string) v1.ErrorDetails {
+	return v1.ErrorDetails{Code: v1.CodeInvalidRequestContent, Target: target, Message: message}
+}
+
+func Test_ValidateParameters_Bicep(t *testing.T) {
+	metadata := map[string]any{
+		"parameters": map[string]any{
+			"context":  map[string]any{"type": "object"},
+			"name":     map[string]any{"type": "string"},
+			"password": map[string]any{"type": "secureString", "defaultValue": ""},
+			"replicas": map[string]any{"type": "int", "defaultValue": float64(1)},
+			"sku":      map[string]any{"type": "string", "defaultValue": "Basic", "allowedValues": []any{"Basic", "Standard"}},
+			"zones":    map[string]any{"type": "array", "defaultValue": []any{}, "allowedValues": []any{"1", "2", "3"}},
+			"tags":     map[string]any{"type": "object", "defaultValue": map[string]any{}},
+			"enabled":  map[string]any{"type": "bool", "defaultValue": true},
+		},
+	}
+
+	tests := []struct {
+		desc       string
+		parameters map[string]any
+		expected   []v1.ErrorDetails
+	}{
+		{
+			desc: "valid",
+			parameters: map[string]any{
+				"context":  map[string]any{"resource": "redis"},
+				"name":     "redis",
+				"password": "secret",
+				"replicas": float64(3),
+				"sku":      "Standard",
+				"zones":    []any{"1", "3"},
+				"tags":     map[string]any{"team": "a"},
+				"enabled":  false,
+			},
+			expected: []v1.ErrorDetails{},
+		},
+		{
+			desc: "invalid",
+			parameters: map[string]any{
+				"name":     float64(1),
+				"replicas": 1.5,
+				"sku":      "Premium",
+				"zones":    []any{"1", "4"},
+				"enabled":  "true",
+				"size":     "large",
+			},
+			expected: []v1.ErrorDetails{
+				newTestParameterError(testTarget+".enabled", `parameter "enabled" must be of type "bool", but the value is of type "string"`),
+				newTestParameterError(testTarget+".name", `parameter "name" must be of type "string", but the value is of type "number"`),
+				newTestParameterError(testTarget+".replicas", `parameter "replicas" must be of type "int", but the value is of type "number"`),
+				newTestParameterError(testTarget+".size", `parameter "size" is not declared by the recipe`),
+				newTestParameterError(testTarget+".sku", `parameter "sku" must be one of ["Basic","Standard"]`),
+				newTestParameterError(testTarget+".zones", `parameter "zones" must be one of ["1","2","3"]`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			errs := ValidateParameters(metadata, tt.parameters, testTarget, false)
+			require.Equal(t, tt.expected, errs)
+		})
+	}
+}
+
+func Test_ValidateParameters_Terraform(t *testing.T) {
+	metadata := map[string]any{
+		"parameters": map[string]any{
+			"name":     map[string]any{"type": "string", "required": true},
+			"port":     map[string]any{"type": "number", "required": false},
+			"tls":      map[string]any{"type": "bool", "required": false},
+			"hosts":    map[string]any{"type": "list(string)", "required": false},
+			"settings": map[string]any{"type": "map(string)", "required": false},
+			"extra":    map[string]any{"required": false},
+		},
+	}
+
+	errs := ValidateParameters(metadata, map[string]any{
+		"name":     float64(1),
+		"port":     "6379",
+		"tls":      "true",
+		"hosts":    []any{"a"},
+		"settings": map[string]any{"a": "b"},
+		"extra":    []any{float64(1)},
+	}, testTarget, true)
+	require.Empty(t, errs)
+
+	errs = ValidateParameters(metadata, map[string]any{
+		"port":     "default",
+		"tls":      "yes",
+		"hosts":    "a",
+		"settings": []any{"a"},
+	}, testTarget, true)
+	require.Equal(t, []v1.ErrorDetails{
+		newTestParameterError(testTarget+".hosts", `parameter "hosts" must be of type "list(string)", but the value is of type "string"`),
+		newTestParameterError(testTarget+".port", `parameter "port" must be of type "number", but the value is of type "string"`),
+		newTestParameterError(testTarget+".settings", `parameter "settings" must be of type "map(string)", but the value is of type "array"`),
+		newTestParameterError(testTarget+".tls", `parameter "tls" must be of type "bool", but the value is of type "string"`),
+	}, errs)
+}
+
+func Test_ValidateRequiredParameters(t *testing.T) {
+	metadata := map[string]any{
+		"parameters": map[string]any{
+			"context":  map[string]any{"type": "object"},
+			"name":     map[string]any{"type": "string"},
+			"location": map[string]any{"type": "string"},
+			"port":     map[string]any{"type": "number", "required": true},
+			"sku":      map[string]any{"type": "string", "defaultValue": "Basic"},
+			"tls":      map[string]any{"type": "bool", "required": false},
+		},
+	}
+
+	errs := ValidateRequiredParameters(metadata, testTarget, map[string]any{"name": "redis"}, map[string]any{"port": float64(6379)})
+	require.Equal(t, []v1.ErrorDetails{
+		newTestParameterError(testTarget, `parameter "location" is required by the recipe`),
+	}, errs)
+
+	errs = ValidateRequiredParameters(map[string]any{}, testTarget)
+	require.Empty(t, errs)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// PlanRecipe is the controller implementation to plan the changes that the recipe of a portable resource would make
+// without deploying the recipe. The plan is computed by the backend in an async operation.
+type PlanRecipe[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+}
+
+// NewPlanRecipe creates a new PlanRecipe controller.
+func NewPlanRecipe[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T]) (ctrl.Controller, error) {
+	return &PlanRecipe[P, T]{ctrl.NewOperation[P](opts, resourceOpts)}, nil
+}
+
+// Run queues a read-only async operation to plan the recipe of the resource in the request body, or of the existing
+// resource if the request body is empty, and returns an async response. The planned changes are returned as the result
+// of the operation. The resource is not changed.
+func (e *PlanRecipe[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	old, _, err := e.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := sm.QueueOperationOptions{
+		OperationTimeout: e.AsyncOperationTimeout(),
+		RetryAfter:       e.AsyncOperationRetryAfter(),
+		ReadOnly:         true,
+	}
+
+	resource := old
+	if req.ContentLength != 0 {
+		content, err := ctrl.ReadJSONBody(req)
+		if err != nil {
+			return nil, err
+		}
+
+		resource, err = e.RequestConverter()(content, serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		P(resource).GetBaseResource().ID = serviceCtx.ResourceID.String()
+		options.Input = resource
+	}
+
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	recipeDataModel, supportsRecipes := any(resource).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.Recipe() == nil {
+		return rest.NewBadRequestResponse("The resource does not use a recipe."), nil
+	}
+
+	if err := e.StatusManager().QueueAsyncOperation(ctx, serviceCtx, options); err != nil {
+		return nil, err
+	}
+
+	response := rest.NewAsyncOperationResponse(map[string]any{}, serviceCtx.Location, http.StatusAccepted, serviceCtx.ResourceID, serviceCtx.OperationID, serviceCtx.APIVersion, "", "")
+	response.RetryAfter = options.RetryAfter
+	return response, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	"github.com/radius-project/radius/pkg/datastoresrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/portableresources"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRedisID       = "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Datastores/redisCaches/redis0"
+	testEnvironmentID = "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/environments/env0"
+	testOutputID      = "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis0"
+	testTimeout       = 10 * time.Minute
+)
+
+func newTestRedisCache(provisioning portableresources.ResourceProvisioning) *datamodel.RedisCache {
+	return &datamodel.RedisCache{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: testRedisID}},
+		Properties: datamodel.RedisCacheProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Environment: testEnvironmentID,
+				Status: rpv1.ResourceStatus{
+					OutputResources: []rpv1.OutputResource{{ID: resources.MustParse(testOutputID)}},
+				},
+			},
+			ResourceProvisioning: provisioning,
+			Recipe:               portableresources.ResourceRecipe{Name: "default"},
+		},
+	}
+}
+
+func TestPlanRecipe_Run(t *testing.T) {
+	tests := []struct {
+		desc          string
+		body          string
+		existing      *datamodel.RedisCache
+		expectedInput string
+		queued        bool
+		code          int
+	}{
+		{
+			desc:     "plan-existing-resource",
+			existing: newTestRedisCache(portableresources.ResourceProvisioningRecipe),
+			queued:   true,
+			code:     http.StatusAccepted,
+		},
+		{
+			desc:          "plan-resource-in-request",
+			body:          `{"location":"global","properties":{"environment":"` + testEnvironmentID + `","recipe":{"name":"custom"}}}`,
+			expectedInput: "custom",
+			queued:        true,
+			code:          http.StatusAccepted,
+		},
+		{
+			desc: "resource-not-found",
+			code: http.StatusNotFound,
+		},
+		{
+			desc:     "manual-provisioning",
+			existing: newTestRedisCache(portableresources.ResourceProvisioningManual),
+			code:     http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mStatusManager := statusmanager.NewMockStatusManager(mctrl)
+
+			if tt.existing != nil {
+				mStorageClient.EXPECT().
+					Get(gomock.Any(), testRedisID).
+					Return(&store.Object{Metadata: store.Metadata{ID: testRedisID}, Data: tt.existing}, nil)
+			} else {
+				mStorageClient.EXPECT().
+					Get(gomock.Any(), testRedisID).
+					Return(nil, &store.ErrNotFound{ID: testRedisID})
+			}
+
+			if tt.queued {
+				mStatusManager.EXPECT().
+					QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+						require.True(t, options.ReadOnly)
+						require.Empty(t, options.ResourceOps)
+						require.Equal(t, testTimeout, options.OperationTimeout)
+						if tt.expectedInput == "" {
+							require.Nil(t, options.Input)
+						} else {
+							input, ok := options.Input.(*datamodel.RedisCache)
+							require.True(t, ok)
+							require.Equal(t, testRedisID, input.ID)
+							require.Equal(t, tt.expectedInput, input.Properties.Recipe.Name)
+						}
+						return nil
+					})
+			}
+
+			req := httptest.NewRequest(http.MethodPost, testRedisID+"/planRecipe?api-version="+testAPIVersion, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+				ResourceID:  resources.MustParse(testRedisID),
+				APIVersion:  testAPIVersion,
+				OperationID: uuid.New(),
+				Location:    v1.LocationGlobal,
+			})
+
+			ctl, err := NewPlanRecipe(ctrl.Options{StorageClient: mStorageClient, StatusManager: mStatusManager}, ctrl.ResourceOptions[datamodel.RedisCache]{
+				RequestConverter:      converter.RedisCacheDataModelFromVersioned,
+				ResponseConverter:     converter.RedisCacheDataModelToVersioned,
+				AsyncOperationTimeout: testTimeout,
+			})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Apply(ctx, w, req))
+			require.Equal(t, tt.code, w.Result().StatusCode)
+
+			if tt.code == http.StatusAccepted {
+				require.NotEmpty(t, w.Header().Get("Location"))
+				require.NotEmpty(t, w.Header().Get("Azure-AsyncOperation"))
+			}
+		})
+	}
+}
@@ -18,12 +18,18 @@ package frontend
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 // PrepareRadiusResource validates the Radius resource and prepare new resource data.
@@ -64,3 +70,58 @@ func PrepareDaprResource[P interface {
 
 	return nil, nil
 }
+
+// ValidateRecipeParameters returns a filter which validates the recipe parameters of the resource against the parameters
+// declared by the recipe template of the environment. The parameters are only validated when the recipe of the resource
+// is new or has changed, and they are not validated if the recipe can't be loaded, since that is reported when the recipe
+// is deployed.
+func ValidateRecipeParameters[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](eng engine.Engine) controller.UpdateFilter[T] {
+	return func(ctx context.Context, newResource *T, oldResource *T, options *controller.Options) (rest.Response, error) {
+		recipeDataModel, supportsRecipes := any(newResource).(pr_dm.RecipeDataModel)
+		if !supportsRecipes || recipeDataModel.Recipe() == nil {
+			return nil, nil
+		}
+
+		input := recipeDataModel.Recipe()
+		if oldResource != nil {
+			if old := any(oldResource).(pr_dm.RecipeDataModel).Recipe(); old != nil && old.Name == input.Name &&
+				reflect.DeepEqual(old.Parameters, input.Parameters) &&
+				P(oldResource).ResourceMetadata().Environment == P(newResource).ResourceMetadata().Environment {
+				return nil, nil
+			}
+		}
+
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		errs, err := eng.ValidateParameters(ctx, engine.ValidateOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          input.Name,
+					Parameters:    input.Parameters,
+					EnvironmentID: P(newResource).ResourceMetadata().Environment,
+					ApplicationID: P(newResource).ResourceMetadata().Application,
+					ResourceID:    serviceCtx.ResourceID.String(),
+				},
+			},
+		})
+		if err != nil {
+			logger := ucplog.FromContextOrDiscard(ctx)
+			logger.Info(fmt.Sprintf("Skipping the validation of the parameters of recipe %q: %s", input.Name, err.Error()))
+			return nil, nil
+		}
+
+		if len(errs) == 0 {
+			return nil, nil
+		}
+
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Message: "The recipe parameters are invalid.",
+				Details: errs,
+			},
+		}), nil
+	}
+}
@@ -18,12 +18,18 @@ package frontend
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
+	ds_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
@@ -128,3 +134,111 @@ func TestPrepareDaprResource(t *testing.T) {
 	require.Equal(t, expectedResp, resp)
 
 }
+
+func TestValidateRecipeParameters(t *testing.T) {
+	invalid := []v1.ErrorDetails{
+		{
+			Code:    v1.CodeInvalidRequestContent,
+			Message: "parameter \"size\" is not declared by the recipe",
+			Target:  "$.properties.recipe.parameters.size",
+		},
+	}
+
+	tests := []struct {
+		desc          string
+		provisioning  portableresources.ResourceProvisioning
+		parameters    map[string]any
+		oldParameters map[string]any
+		existing      bool
+		validate      bool
+		errs          []v1.ErrorDetails
+		validateErr   error
+		code          int
+	}{
+		{
+			desc:         "valid-parameters",
+			provisioning: portableresources.ResourceProvisioningRecipe,
+			parameters:   map[string]any{"port": float64(6379)},
+			validate:     true,
+		},
+		{
+			desc:         "invalid-parameters",
+			provisioning: portableresources.ResourceProvisioningRecipe,
+			parameters:   map[string]any{"size": "large"},
+			validate:     true,
+			errs:         invalid,
+			code:         400,
+		},
+		{
+			desc:         "recipe-not-loaded",
+			provisioning: portableresources.ResourceProvisioningRecipe,
+			parameters:   map[string]any{"size": "large"},
+			validate:     true,
+			validateErr:  errors.New("recipe not found"),
+		},
+		{
+			desc:          "unchanged-recipe",
+			provisioning:  portableresources.ResourceProvisioningRecipe,
+			parameters:    map[string]any{"size": "large"},
+			oldParameters: map[string]any{"size": "large"},
+			existing:      true,
+		},
+		{
+			desc:          "changed-recipe",
+			provisioning:  portableresources.ResourceProvisioningRecipe,
+			parameters:    map[string]any{"size": "large"},
+			oldParameters: map[string]any{"size": "small"},
+			existing:      true,
+			validate:      true,
+			errs:          invalid,
+			code:          400,
+		},
+		{
+			desc:         "manual-provisioning",
+			provisioning: portableresources.ResourceProvisioningManual,
+			parameters:   map[string]any{"size": "large"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mEngine := engine.NewMockEngine(mctrl)
+
+			newResource := newTestRedisCache(tt.provisioning)
+			newResource.Properties.Recipe.Parameters = tt.parameters
+
+			var oldResource *ds_dm.RedisCache
+			if tt.existing {
+				oldResource = newTestRedisCache(tt.provisioning)
+				oldResource.Properties.Recipe.Parameters = tt.oldParameters
+			}
+
+			if tt.validate {
+				mEngine.EXPECT().
+					ValidateParameters(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, opts engine.ValidateOptions) ([]v1.ErrorDetails, error) {
+						require.Nil(t, opts.Definition)
+						require.Equal(t, "default", opts.Recipe.Name)
+						require.Equal(t, testEnvironmentID, opts.Recipe.EnvironmentID)
+						require.Equal(t, tt.parameters, opts.Recipe.Parameters)
+						return tt.errs, tt.validateErr
+					})
+			}
+
+			filter := ValidateRecipeParameters[*ds_dm.RedisCache](mEngine)
+			resp, err := filter(newTestARMContext(), newResource, oldResource, &controller.Options{})
+			require.NoError(t, err)
+			if tt.code == 0 {
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NotNil(t, resp)
+			badRequest, ok := resp.(*rest.BadRequestResponse)
+			require.True(t, ok)
+			require.Equal(t, v1.CodeInvalidRequestContent, badRequest.Body.Error.Code)
+			require.Equal(t, tt.errs, badRequest.Body.Error.Details)
+		})
+	}
+}
@@ -27,10 +27,11 @@ import (
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
-// FindNamespaceByEnvID finds the environment-scope Kubernetes namespace. If the environment ID is invalid or the environment is not a Kubernetes
-// environment, an error is returned.
+// FindNamespaceByEnvID finds the environment-scope Kubernetes namespace. If the environment ID is invalid, the environment is soft-deleted
+// or it is not a Kubernetes environment, an error is returned.
 func FindNamespaceByEnvID(ctx context.Context, sp dataprovider.DataStorageProvider, envID string) (namespace string, err error) {
 	id, err := resources.ParseResource(envID)
 	if err != nil {
@@ -48,7 +49,7 @@ func FindNamespaceByEnvID(ctx context.Context, sp dataprovider.DataStorageProvid
 		return
 	}
 
-	res, err := client.Get(ctx, id.String())
+	res, err := store.GetLiveObject(ctx, client, id.String())
 	if err != nil {
 		return
 	}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -99,6 +100,27 @@ func TestFindNamespaceByEnvID(t *testing.T) {
 	}
 }
 
+func TestFindNamespaceByEnvID_Deleted(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	deletedTime := time.Now()
+	envdm := &datamodel.Environment{
+		Properties: datamodel.EnvironmentProperties{
+			Compute: rpv1.EnvironmentCompute{Kind: rpv1.KubernetesComputeKind},
+		},
+	}
+	envdm.DeletedTime = &deletedTime
+
+	mockSP := dataprovider.NewMockDataStorageProvider(mctrl)
+	mockSC := store.NewMockStorageClient(mctrl)
+
+	mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(mockSC), nil).Times(1)
+	mockSC.EXPECT().Get(gomock.Any(), testEnvID, gomock.Any()).Return(fakeStoreObject(envdm), nil).Times(1)
+
+	_, err := FindNamespaceByEnvID(context.Background(), mockSP, testEnvID)
+	require.ErrorIs(t, err, &store.ErrNotFound{})
+}
+
 func TestFetchNameSpaceFromEnvironmentResource(t *testing.T) {
 	envResource := model.EnvironmentResource{
 		Properties: &model.EnvironmentProperties{
@@ -29,7 +29,7 @@ import (
 )
 
 // FetchScopeResource checks if the given scopeID is a valid resource ID for the given resource type, fetches the resource
-// from the storage client and returns an error if the resource does not exist.
+// from the storage client and returns an error if the resource does not exist or is soft-deleted.
 func FetchScopeResource(ctx context.Context, sp dataprovider.DataStorageProvider, scopeID string, resource v1.DataModelInterface) error {
 	id, err := resources.ParseResource(scopeID)
 	if err != nil {
@@ -44,7 +44,7 @@ func FetchScopeResource(ctx context.Context, sp dataprovider.DataStorageProvider
 		return err
 	}
 
-	res, err := sc.Get(ctx, id.String())
+	res, err := store.GetLiveObject(ctx, sc, id.String())
 	if errors.Is(&store.ErrNotFound{ID: id.String()}, err) {
 		return v1.NewClientErrInvalidRequest(fmt.Sprintf("linked resource %s does not exist", scopeID))
 	}
@@ -53,7 +53,11 @@ const (
 	LocalIDDaprPubSubBrokerKafka        = "DaprPubSubBrokerKafka"
 	LocalIDDeployment                   = "Deployment"
 	LocalIDGateway                      = "Gateway"
+	LocalIDGatewayHTTPRoute             = "GatewayHTTPRoute"
+	LocalIDGatewayGRPCRoute             = "GatewayGRPCRoute"
+	LocalIDGatewayReferenceGrant        = "GatewayReferenceGrant"
 	LocalIDHttpRoute                    = "HttpRoute"
+	LocalIDHorizontalPodAutoscaler      = "HorizontalPodAutoscaler"
 	LocalIDKeyVault                     = "KeyVault"
 	LocalIDSecret                       = "Secret"
 	LocalIDConfigMap                    = "ConfigMap"
@@ -65,6 +69,9 @@ const (
 	LocalIDUserAssignedManagedIdentity  = "UserAssignedManagedIdentity"
 	LocalIDFederatedIdentity            = "FederatedIdentity"
 	LocalIDRoleAssignmentPrefix         = "RoleAssignment"
+	LocalIDScaledObject                 = "ScaledObject"
+	LocalIDScaledObjectSecret           = "ScaledObjectSecret"
+	LocalIDTriggerAuthenticationPrefix  = "TriggerAuthentication"
 
 	// Obsolete when we remove AppModelV1
 	LocalIDRoleAssignmentKVKeys = "RoleAssignment-KVKeys"
@@ -18,6 +18,7 @@ package v1
 
 import (
 	"strings"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 )
@@ -62,12 +63,55 @@ type ResourceStatus struct {
 
 	// OutputResources represents the output resources associated with the radius resource.
 	OutputResources []OutputResource `json:"outputResources,omitempty"`
+
+	// DriftStatus represents the result of the last drift check of the resources deployed by the recipe.
+	DriftStatus *DriftStatus `json:"driftStatus,omitempty"`
 }
 
 // DeepCopy copies the contents of the ResourceStatus struct from in to out.
 func (in *ResourceStatus) DeepCopy(out *ResourceStatus) {
 	in.Compute = out.Compute
 	in.OutputResources = out.OutputResources
+	in.DriftStatus = out.DriftStatus
+}
+
+// DriftState is the state of the resources deployed by a recipe compared to the recipe.
+type DriftState string
+
+const (
+	// DriftStateInSync means the deployed resources match the recipe.
+	DriftStateInSync DriftState = "InSync"
+	// DriftStateDrifted means the deployed resources were changed outside of Radius and no longer match the recipe.
+	DriftStateDrifted DriftState = "Drifted"
+	// DriftStateUnknown means the drift check failed and the state of the deployed resources is unknown.
+	DriftStateUnknown DriftState = "Unknown"
+)
+
+// DriftStatus represents the result of a drift check of the resources deployed by a recipe.
+type DriftStatus struct {
+	// State is the drift state of the deployed resources.
+	State DriftState `json:"state"`
+
+	// Changes is the summary of the changes that re-deploying the recipe would make to bring the resources back in sync.
+	Changes []DriftChange `json:"changes,omitempty"`
+
+	// Message describes why the drift check failed when the state is unknown.
+	Message string `json:"message,omitempty"`
+
+	// LastCheckedTime is the time of the drift check.
+	LastCheckedTime time.Time `json:"lastCheckedTime"`
+}
+
+// DriftChange represents a drifted resource deployed by a recipe.
+type DriftChange struct {
+	// ID is the ID of the drifted resource.
+	ID string `json:"id"`
+
+	// Type is the type of the drifted resource.
+	Type string `json:"type,omitempty"`
+
+	// ChangeType is the change that re-deploying the recipe would make to the resource, for example "Modify".
+	ChangeType string `json:"changeType"`
 }
 
 // EnvironmentCompute represents the compute resource of Environment.
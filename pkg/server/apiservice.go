@@ -69,6 +69,7 @@ func (s *APIService) Run(ctx context.Context) error {
 					DataProvider:  s.StorageProvider,
 					KubeClient:    s.KubeClient,
 					StatusManager: s.OperationStatusManager,
+					QueueClient:   s.QueueClient,
 				}
 
 				validator, err := builder.NewOpenAPIValidator(ctx, opts.PathBase, b.Namespace())
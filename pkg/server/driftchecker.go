@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/portableresources/backend/drift"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// driftCheckerLeaseName is the name of the lease held by the replica which checks for drift.
+const driftCheckerLeaseName = "applications-rp-drift-checker"
+
+// DriftCheckerService is a service to check the resources deployed by the recipes of portable resources for drift.
+type DriftCheckerService struct {
+	options hostoptions.HostOptions
+}
+
+// NewDriftCheckerService creates a new DriftCheckerService instance.
+func NewDriftCheckerService(options hostoptions.HostOptions) *DriftCheckerService {
+	return &DriftCheckerService{
+		options: options,
+	}
+}
+
+// Name returns the name of the service.
+func (s *DriftCheckerService) Name() string {
+	return "radiusdriftchecker"
+}
+
+// Run starts the service. The drift checker runs only in the replica which holds the drift checker lease, so that
+// every resource is checked once per interval no matter how many replicas are running.
+func (s *DriftCheckerService) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	recipeControllerConfig, err := controllerconfig.New(s.options)
+	if err != nil {
+		return err
+	}
+
+	c := drift.New(drift.Options{
+		StorageProvider: dataprovider.NewStorageProvider(s.options.Config.StorageProvider),
+		Engine:          recipeControllerConfig.Engine,
+		Interval:        s.options.Config.DriftDetection.Interval,
+	})
+
+	return runWithLease(ctx, s.options.K8sConfig, driftCheckerLeaseName, func(ctx context.Context) {
+		if err := c.Run(ctx); err != nil {
+			logger.Error(err, "failed to run drift checker")
+		}
+	})
+}
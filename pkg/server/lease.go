@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	// leaseNamespace is the namespace of the leases held by the replicas which run the background services.
+	leaseNamespace = "radius-system"
+
+	leaseDuration = 30 * time.Second
+	renewDeadline = 20 * time.Second
+	retryPeriod   = 5 * time.Second
+)
+
+// runWithLease runs run only while this replica holds the lease with the given name, so that a single replica runs
+// it no matter how many replicas are running. The context passed to run is canceled when the lease is lost. runWithLease
+// keeps campaigning for the lease until ctx is canceled.
+func runWithLease(ctx context.Context, k8sConfig *rest.Config, name string, run func(ctx context.Context)) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	lock, err := newLeaseLock(k8sConfig, name)
+	if err != nil {
+		return err
+	}
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            name,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				logger.Info(fmt.Sprintf("Acquired the lease %s.", name), "identity", lock.Identity())
+				run(ctx)
+			},
+			OnStoppedLeading: func() {
+				logger.Info(fmt.Sprintf("Released the lease %s.", name), "identity", lock.Identity())
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Run returns when the lease is lost. Keep campaigning for the lease until the service is stopped.
+	for ctx.Err() == nil {
+		elector.Run(ctx)
+	}
+
+	return nil
+}
+
+// newLeaseLock creates the lock on the lease with the given name. The identity is unique to this process.
+func newLeaseLock(k8sConfig *rest.Config, name string) (*resourcelock.LeaseLock, error) {
+	client, err := kubernetes.NewForConfig(k8sConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: leaseNamespace,
+		},
+		Client: client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: fmt.Sprintf("%s_%s", hostname, uuid.NewString()),
+		},
+	}, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/corerp/backend/purger"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// purgerLeaseName is the name of the lease held by the replica which purges the soft-deleted resources.
+const purgerLeaseName = "applications-rp-purger"
+
+// PurgerService is a service to purge the soft-deleted applications and environments.
+type PurgerService struct {
+	options        hostoptions.HostOptions
+	resourceClient processors.ResourceClient
+}
+
+// NewPurgerService creates a new PurgerService instance.
+func NewPurgerService(options hostoptions.HostOptions, resourceClient processors.ResourceClient) *PurgerService {
+	return &PurgerService{
+		options:        options,
+		resourceClient: resourceClient,
+	}
+}
+
+// Name returns the name of the service.
+func (s *PurgerService) Name() string {
+	return "radiuspurger"
+}
+
+// Run starts the service. The purger runs only in the replica which holds the purger lease, so that replicas don't
+// race to purge the same tombstones.
+func (s *PurgerService) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	p := purger.New(purger.Options{
+		StorageProvider: dataprovider.NewStorageProvider(s.options.Config.StorageProvider),
+		ResourceClient:  s.resourceClient,
+		RetentionPeriod: s.options.Config.SoftDelete.RetentionPeriod,
+		PurgeInterval:   s.options.Config.SoftDelete.PurgeInterval,
+	})
+
+	return runWithLease(ctx, s.options.K8sConfig, purgerLeaseName, func(ctx context.Context) {
+		if err := p.Run(ctx); err != nil {
+			logger.Error(err, "failed to run purger")
+		}
+	})
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// RetentionPeriod is the duration for which audit records are kept.
+	RetentionPeriod = 30 * 24 * time.Hour
+
+	// MaxRecords is the maximum number of audit records kept for a resource. The oldest records are removed first.
+	MaxRecords = 100
+
+	// SweepInterval is the minimum interval between two sweeps of the expired records of a resource type.
+	SweepInterval = time.Hour
+)
+
+// lastSweeps records the time of the last sweep of the expired records of each resource type by this process.
+var lastSweeps = struct {
+	sync.Mutex
+	times map[string]time.Time
+}{times: map[string]time.Time{}}
+
+// Client appends and lists the audit records of resources. Records are stored with the storage client of the
+// resource type, nested under the resource: {resourceID}/operations/{operationID}.
+type Client struct {
+	storageClient store.StorageClient
+}
+
+// NewClient creates a new audit Client.
+func NewClient(storageClient store.StorageClient) *Client {
+	return &Client{storageClient: storageClient}
+}
+
+// RecordID returns the ID of the audit record of the operation on the resource.
+func RecordID(resourceID resources.ID, operationID string) resources.ID {
+	return resourceID.Append(resources.TypeSegment{Type: OperationsTypeSegment, Name: operationID})
+}
+
+// Append appends the record to the operation history of its resource. Records are never overwritten, so Append returns
+// store.ErrConcurrency if a record with the same operation ID already exists. The records which are older than
+// RetentionPeriod or exceed MaxRecords are removed in the background by Sweep.
+func (c *Client) Append(ctx context.Context, record *Record) error {
+	resourceID, err := resources.ParseResource(record.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	record.ID = RecordID(resourceID, record.OperationID).String()
+	record.Type = recordType(resourceID.Type())
+	return c.storageClient.Save(ctx, &store.Object{Metadata: store.Metadata{ID: record.ID}, Data: record}, store.WithCreateOnly())
+}
+
+// Complete records the outcome of the async operation in the record of the operation, which was appended with the
+// Accepted result when the operation was queued. Complete returns nil if the operation has no record.
+func (c *Client) Complete(ctx context.Context, resourceID resources.ID, operationID string, result string, endTime time.Time, errorMessage string, afterHash string) error {
+	id := RecordID(resourceID, operationID).String()
+	obj, err := c.storageClient.Get(ctx, id)
+	if errors.Is(&store.ErrNotFound{ID: id}, err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	record := &Record{}
+	if err := obj.As(record); err != nil {
+		return err
+	}
+
+	record.Result = result
+	record.ErrorMessage = errorMessage
+	record.AfterHash = afterHash
+	record.EndTime = &endTime
+	record.DurationMilliseconds = endTime.Sub(record.StartTime).Milliseconds()
+
+	return c.storageClient.Save(ctx, &store.Object{Metadata: store.Metadata{ID: id}, Data: record}, store.WithETag(obj.ETag))
+}
+
+// Sweep removes the records of every resource of the resource type under the root scope that are older than
+// RetentionPeriod or exceed MaxRecords for their resource, including the records of deleted resources. The sweep is
+// skipped if this process has swept the resource type within SweepInterval.
+func (c *Client) Sweep(ctx context.Context, rootScope string, resourceType string) error {
+	now := time.Now()
+	key := strings.ToLower(rootScope + "|" + resourceType)
+
+	lastSweeps.Lock()
+	if last, ok := lastSweeps.times[key]; ok && now.Sub(last) < SweepInterval {
+		lastSweeps.Unlock()
+		return nil
+	}
+	lastSweeps.times[key] = now
+	lastSweeps.Unlock()
+
+	records, err := c.query(ctx, store.Query{
+		RootScope:      rootScope,
+		ScopeRecursive: true,
+		ResourceType:   recordType(resourceType),
+	})
+	if err != nil {
+		return err
+	}
+
+	history := map[string][]*Record{}
+	for _, record := range records {
+		key := strings.ToLower(record.ResourceID)
+		history[key] = append(history[key], record)
+	}
+
+	pruned := []*Record{}
+	for _, records := range history {
+		sortRecords(records)
+
+		// Records are ordered by the start time, so the oldest records are removed first.
+		for i, record := range records {
+			if len(records)-i > MaxRecords || record.StartTime.Before(now.Add(-RetentionPeriod)) {
+				pruned = append(pruned, record)
+			}
+		}
+	}
+
+	return c.delete(ctx, pruned)
+}
+
+// delete deletes the records. Records that were already deleted are ignored.
+func (c *Client) delete(ctx context.Context, records []*Record) error {
+	for _, record := range records {
+		err := c.storageClient.Delete(ctx, record.ID)
+		if err != nil && !errors.Is(&store.ErrNotFound{ID: record.ID}, err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// List returns the operation history of the resource ordered by the start time. The history of a deleted resource
+// is returned until a resource with the same ID is created again, which continues the history.
+//
+// The records are queried by resource type and filtered by resource ID, because not every storage provider supports
+// querying by routing scope.
+func (c *Client) List(ctx context.Context, resourceID resources.ID) ([]*Record, error) {
+	records, err := c.query(ctx, store.Query{
+		RootScope:    resourceID.RootScope(),
+		ResourceType: recordType(resourceID.Type()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	history := []*Record{}
+	for _, record := range records {
+		if strings.EqualFold(record.ResourceID, resourceID.String()) {
+			history = append(history, record)
+		}
+	}
+
+	sortRecords(history)
+	return history, nil
+}
+
+// recordType returns the resource type of the audit records of the resource type.
+func recordType(resourceType string) string {
+	return resourceType + "/" + OperationsTypeSegment
+}
+
+// sortRecords sorts the records by their start time.
+func sortRecords(records []*Record) {
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].StartTime.Before(records[j].StartTime)
+	})
+}
+
+// query returns the records matching the query.
+func (c *Client) query(ctx context.Context, query store.Query) ([]*Record, error) {
+	records := []*Record{}
+	token := ""
+	for {
+		result, err := c.storageClient.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			record := &Record{}
+			if err := item.As(record); err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+
+		token = result.PaginationToken
+		if token == "" {
+			break
+		}
+	}
+
+	return records, nil
+}
+
+// Hash returns the hash of the stored resource data, or empty string if obj is nil.
+func Hash(obj *store.Object) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(obj.Data)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceID  = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+	otherResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container-2"
+)
+
+func newTestClient(t *testing.T) (*Client, store.StorageClient) {
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	sc, err := boltstore.NewBoltClient(db)
+	require.NoError(t, err)
+	return NewClient(sc), sc
+}
+
+func newTestRecord(resourceID string, startTime time.Time) *Record {
+	return &Record{
+		ResourceID:    resourceID,
+		OperationID:   uuid.NewString(),
+		OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT",
+		Caller:        Caller{PrincipalName: "test-user"},
+		StatusCode:    http.StatusOK,
+		Result:        ResultSucceeded,
+		StartTime:     startTime.UTC(),
+	}
+}
+
+func TestClient(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, sc := newTestClient(t)
+
+	now := time.Now()
+	second := newTestRecord(testResourceID, now)
+	first := newTestRecord(testResourceID, now.Add(-time.Minute))
+	other := newTestRecord(otherResourceID, now)
+
+	for _, record := range []*Record{second, first, other} {
+		require.NoError(t, client.Append(ctx, record))
+	}
+	require.Equal(t, testResourceID+"/operations/"+first.OperationID, first.ID)
+	require.Equal(t, "Applications.Core/containers/operations", first.Type)
+
+	// The resource itself is stored alongside its records and must not be listed.
+	err := sc.Save(ctx, &store.Object{Metadata: store.Metadata{ID: testResourceID}, Data: map[string]any{"name": "test-container"}})
+	require.NoError(t, err)
+
+	t.Run("list", func(t *testing.T) {
+		records, err := client.List(ctx, resources.MustParse(testResourceID))
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		require.Equal(t, first.OperationID, records[0].OperationID)
+		require.Equal(t, second.OperationID, records[1].OperationID)
+		require.Equal(t, "test-user", records[0].Caller.PrincipalName)
+	})
+
+	t.Run("list empty", func(t *testing.T) {
+		records, err := client.List(ctx, resources.MustParse(testResourceID+"-3"))
+		require.NoError(t, err)
+		require.Empty(t, records)
+	})
+
+	t.Run("append is create only", func(t *testing.T) {
+		err := client.Append(ctx, first)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+	})
+}
+
+func TestClient_Complete(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, _ := newTestClient(t)
+
+	record := newTestRecord(testResourceID, time.Now().Add(-time.Minute))
+	record.Result = ResultAccepted
+	require.NoError(t, client.Append(ctx, record))
+
+	endTime := time.Now().UTC()
+	err := client.Complete(ctx, resources.MustParse(testResourceID), record.OperationID, ResultCanceled, endTime, "canceled by the user", "hash")
+	require.NoError(t, err)
+
+	records, err := client.List(ctx, resources.MustParse(testResourceID))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, ResultCanceled, records[0].Result)
+	require.Equal(t, "canceled by the user", records[0].ErrorMessage)
+	require.Equal(t, "hash", records[0].AfterHash)
+	require.True(t, endTime.Equal(*records[0].EndTime))
+	require.Equal(t, endTime.Sub(record.StartTime).Milliseconds(), records[0].DurationMilliseconds)
+
+	t.Run("operation without record", func(t *testing.T) {
+		err := client.Complete(ctx, resources.MustParse(testResourceID), uuid.NewString(), ResultSucceeded, endTime, "", "")
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_Sweep(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	sweep := func(t *testing.T, client *Client) {
+		// Each test sweeps the resource type once, regardless of the sweeps of the previous tests.
+		lastSweeps.Lock()
+		lastSweeps.times = map[string]time.Time{}
+		lastSweeps.Unlock()
+
+		err := client.Sweep(ctx, "/planes/radius/local", "Applications.Core/containers")
+		require.NoError(t, err)
+	}
+
+	t.Run("max records", func(t *testing.T) {
+		client, _ := newTestClient(t)
+
+		now := time.Now()
+		first := newTestRecord(testResourceID, now.Add(-time.Hour))
+		require.NoError(t, client.Append(ctx, first))
+		for i := 1; i <= MaxRecords; i++ {
+			require.NoError(t, client.Append(ctx, newTestRecord(testResourceID, now.Add(time.Duration(i)*time.Second))))
+		}
+		other := newTestRecord(otherResourceID, now.Add(-time.Hour))
+		require.NoError(t, client.Append(ctx, other))
+
+		// Append doesn't prune the history in the request path.
+		records, err := client.List(ctx, resources.MustParse(testResourceID))
+		require.NoError(t, err)
+		require.Len(t, records, MaxRecords+1)
+
+		sweep(t, client)
+
+		records, err = client.List(ctx, resources.MustParse(testResourceID))
+		require.NoError(t, err)
+		require.Len(t, records, MaxRecords)
+		for _, record := range records {
+			require.NotEqual(t, first.OperationID, record.OperationID, "the oldest record is pruned")
+		}
+
+		// The maximum number of records applies to each resource.
+		records, err = client.List(ctx, resources.MustParse(otherResourceID))
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+	})
+
+	t.Run("retention period", func(t *testing.T) {
+		client, sc := newTestClient(t)
+
+		expired := newTestRecord(testResourceID, time.Now().Add(-RetentionPeriod-time.Hour))
+		require.NoError(t, client.Append(ctx, expired))
+		recent := newTestRecord(testResourceID, time.Now())
+		require.NoError(t, client.Append(ctx, recent))
+
+		// The records of a deleted resource are removed too.
+		deleted := newTestRecord(otherResourceID, time.Now().Add(-RetentionPeriod-time.Hour))
+		require.NoError(t, client.Append(ctx, deleted))
+
+		sweep(t, client)
+
+		records, err := client.List(ctx, resources.MustParse(testResourceID))
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, recent.OperationID, records[0].OperationID)
+
+		records, err = client.List(ctx, resources.MustParse(otherResourceID))
+		require.NoError(t, err)
+		require.Empty(t, records)
+
+		t.Run("sweep is skipped within the sweep interval", func(t *testing.T) {
+			err := sc.Save(ctx, &store.Object{Metadata: store.Metadata{ID: deleted.ID}, Data: deleted})
+			require.NoError(t, err)
+
+			err = client.Sweep(ctx, "/planes/radius/local", "Applications.Core/containers")
+			require.NoError(t, err)
+
+			records, err := client.List(ctx, resources.MustParse(otherResourceID))
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+		})
+	})
+}
+
+func TestClient_List_Query(t *testing.T) {
+	ctx := testcontext.New(t)
+	sc := store.NewMockStorageClient(gomock.NewController(t))
+
+	record := newTestRecord(testResourceID, time.Now())
+	other := newTestRecord(otherResourceID, time.Now())
+
+	// The query is supported by every storage provider: CosmosDB doesn't support querying by routing scope, and filters
+	// the records by their type field.
+	sc.EXPECT().
+		Query(gomock.Any(), store.Query{
+			RootScope:    "/planes/radius/local/resourceGroups/test-rg",
+			ResourceType: "Applications.Core/containers/operations",
+		}, gomock.Any()).
+		Return(&store.ObjectQueryResult{Items: []store.Object{{Data: other}, {Data: record}}}, nil)
+
+	records, err := NewClient(sc).List(ctx, resources.MustParse(testResourceID))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, record.OperationID, records[0].OperationID)
+}
+
+func TestHash(t *testing.T) {
+	h, err := Hash(nil)
+	require.NoError(t, err)
+	require.Empty(t, h)
+
+	a, err := Hash(&store.Object{Data: map[string]any{"a": "1", "b": "2"}})
+	require.NoError(t, err)
+	b, err := Hash(&store.Object{Data: map[string]any{"b": "2", "a": "1"}})
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+
+	c, err := Hash(&store.Object{Data: map[string]any{"a": "1", "b": "3"}})
+	require.NoError(t, err)
+	require.NotEqual(t, a, c)
+}
+
+func TestResultFromResponse(t *testing.T) {
+	async := http.Header{}
+	async.Set(asyncOperationHeader, "http://localhost/operationStatuses/1")
+
+	require.Equal(t, ResultSucceeded, ResultFromResponse(http.StatusOK, http.Header{}))
+	require.Equal(t, ResultSucceeded, ResultFromResponse(http.StatusNoContent, http.Header{}))
+	require.Equal(t, ResultAccepted, ResultFromResponse(http.StatusAccepted, http.Header{}))
+	require.Equal(t, ResultAccepted, ResultFromResponse(http.StatusCreated, async))
+	require.Equal(t, ResultFailed, ResultFromResponse(http.StatusConflict, http.Header{}))
+	require.Equal(t, ResultFailed, ResultFromResponse(http.StatusInternalServerError, async))
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit implements the append-only operation history of resources. A record is appended for every mutating
+// request on a resource and is kept after the resource is deleted, unlike the operation statuses of async operations
+// which are overwritten and eventually deleted.
+package audit
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	// OperationsTypeSegment is the type segment of the audit records nested under a resource.
+	OperationsTypeSegment = "operations"
+
+	// ResultSucceeded is the result of a request that completed successfully.
+	ResultSucceeded = "Succeeded"
+
+	// ResultAccepted is the result of a request that started an async operation which has not completed yet. The
+	// result is replaced by the outcome of the operation when it completes.
+	ResultAccepted = "Accepted"
+
+	// ResultFailed is the result of a request that returned an error or of an async operation that failed.
+	ResultFailed = "Failed"
+
+	// ResultCanceled is the result of an async operation that was canceled.
+	ResultCanceled = "Canceled"
+)
+
+// Record is the audit record of a mutating request on a resource.
+type Record struct {
+	// ID is the ID of the record.
+	ID string `json:"id"`
+
+	// Type is the resource type of the record, for example Applications.Core/containers/operations. Storage
+	// providers which can't filter records by their ID, such as CosmosDB, use it to query the records of a resource type.
+	Type string `json:"type"`
+
+	// ResourceID is the ID of the resource the request was made on.
+	ResourceID string `json:"resourceId"`
+
+	// OperationID is the ID of the request. It matches the name of the operation status of async operations.
+	OperationID string `json:"operationId"`
+
+	// OperationType is the type of the operation, for example APPLICATIONS.CORE/CONTAINERS|PUT.
+	OperationType string `json:"operationType"`
+
+	// Caller is the identity of the caller.
+	Caller Caller `json:"caller"`
+
+	// BeforeHash is the hash of the resource before the request. It is empty if the resource did not exist.
+	BeforeHash string `json:"beforeHash,omitempty"`
+
+	// AfterHash is the hash of the resource after the request. It is empty if the resource does not exist.
+	AfterHash string `json:"afterHash,omitempty"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+
+	// Result is the result of the request: Succeeded, Accepted or Failed. The result of an async operation is Accepted
+	// until the operation completes, and then Succeeded, Failed or Canceled.
+	Result string `json:"result"`
+
+	// ErrorMessage is the error message of a failed async operation.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+
+	// StartTime is the time when the request was received.
+	StartTime time.Time `json:"startTime"`
+
+	// EndTime is the time when the async operation completed. It is empty for synchronous requests and for async
+	// operations that have not completed.
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// DurationMilliseconds is the time taken to process the request in milliseconds. For async operations it is the
+	// time taken to complete the operation once it has completed.
+	DurationMilliseconds int64 `json:"durationMilliseconds"`
+}
+
+// IsAsync returns true if the request started an async operation. The result of an async operation is Accepted until
+// it completes, and its end time is set once it has completed.
+func (r *Record) IsAsync() bool {
+	return r.Result == ResultAccepted || r.EndTime != nil
+}
+
+// Caller is the identity of the caller of a request as forwarded by ARM or UCP.
+type Caller struct {
+	// PrincipalName is the principal name of the caller.
+	PrincipalName string `json:"principalName,omitempty"`
+
+	// PrincipalID is the principal ID of the caller.
+	PrincipalID string `json:"principalId,omitempty"`
+
+	// ObjectID is the object ID of the caller.
+	ObjectID string `json:"objectId,omitempty"`
+
+	// TenantID is the home tenant ID of the caller.
+	TenantID string `json:"tenantId,omitempty"`
+
+	// UserAgent is the user agent of the caller.
+	UserAgent string `json:"userAgent,omitempty"`
+}
+
+// RecordList is the response of the operation history of a resource.
+type RecordList struct {
+	// Value is the list of records ordered by the start time.
+	Value []*Record `json:"value"`
+}
+
+// asyncOperationHeader is the response header of the requests that started an async operation.
+const asyncOperationHeader = "Azure-AsyncOperation"
+
+// ResultFromResponse returns the result of a request from the status code and headers of its response.
+func ResultFromResponse(statusCode int, header http.Header) string {
+	switch {
+	case statusCode >= http.StatusBadRequest:
+		return ResultFailed
+	case statusCode == http.StatusAccepted || header.Get(asyncOperationHeader) != "":
+		return ResultAccepted
+	case statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices:
+		return ResultSucceeded
+	default:
+		return ResultFailed
+	}
+}
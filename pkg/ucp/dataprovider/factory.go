@@ -18,6 +18,7 @@ package dataprovider
 
 import (
 	context "context"
+	"database/sql"
 	"errors"
 	"fmt"
 
@@ -25,19 +26,26 @@ import (
 	store "github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/store/apiserverstore"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/radius-project/radius/pkg/ucp/store/cosmosdb"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
+	"github.com/radius-project/radius/pkg/ucp/store/postgresstore"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	// The PostgreSQL store uses the lib/pq driver through database/sql.
+	_ "github.com/lib/pq"
+
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type storageFactoryFunc func(context.Context, StorageProviderOptions, string) (store.StorageClient, error)
 
 var storageClientFactory = map[StorageProviderType]storageFactoryFunc{
-	TypeAPIServer: initAPIServerClient,
-	TypeCosmosDB:  initCosmosDBClient,
-	TypeETCD:      InitETCDClient,
+	TypeAPIServer:  initAPIServerClient,
+	TypeCosmosDB:   initCosmosDBClient,
+	TypeETCD:       InitETCDClient,
+	TypePostgreSQL: initPostgreSQLClient,
+	TypeBolt:       InitBoltClient,
 }
 
 func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
@@ -69,7 +77,8 @@ func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ stri
 		},
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	// The client needs to support watches so that the store can implement store.Watcher.
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -117,3 +126,41 @@ func InitETCDClient(ctx context.Context, opt StorageProviderOptions, _ string) (
 	etcdClient := etcdstore.NewETCDClient(client)
 	return etcdClient, nil
 }
+
+func initPostgreSQLClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	if opt.PostgreSQL.URL == "" {
+		return nil, errors.New("failed to initialize PostgreSQL client: url is required")
+	}
+
+	db, err := sql.Open("postgres", opt.PostgreSQL.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client: %w", err)
+	}
+
+	client := postgresstore.NewPostgreSQLClient(db)
+	if err = client.Init(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client - configuration may be invalid: %w", err)
+	}
+
+	return client, nil
+}
+
+// InitBoltClient opens the bolt database file configured in the options and returns a BoltClient for it.
+func InitBoltClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	if opt.Bolt.Path == "" {
+		return nil, errors.New("failed to initialize bolt client: path is required")
+	}
+
+	db, err := boltstore.Open(opt.Bolt.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	client, err := boltstore.NewBoltClient(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	return client, nil
+}
@@ -32,6 +32,18 @@ var (
 
 var _ DataStorageProvider = (*storageProvider)(nil)
 
+// sharedClientProviders are the storage providers that store every resource type in the same place. A single client
+// is shared by all resource types so that writes to different resource types can be committed in one transaction.
+//
+// CosmosDB stores each resource type in its own collection and its transactions are scoped to a single collection, so
+// writes to different resource types are committed one at a time.
+var sharedClientProviders = map[StorageProviderType]bool{
+	TypeAPIServer:  true,
+	TypeETCD:       true,
+	TypePostgreSQL: true,
+	TypeBolt:       true,
+}
+
 type storageProvider struct {
 	clients   map[string]store.StorageClient
 	clientsMu sync.RWMutex
@@ -49,9 +61,13 @@ func NewStorageProvider(opts StorageProviderOptions) DataStorageProvider {
 
 // GetStorageClient checks if a StorageClient for the given resourceType already exists in the map, and
 // if so, returns it. If not, it creates a new StorageClient using the storageClientFactory and adds it to the map,
-// returning it. If an error occurs, it returns an error.
+// returning it. Providers that don't partition storage by resource type return the same StorageClient for every
+// resourceType. If an error occurs, it returns an error.
 func (p *storageProvider) GetStorageClient(ctx context.Context, resourceType string) (store.StorageClient, error) {
 	cn := util.NormalizeStringToLower(resourceType)
+	if sharedClientProviders[p.options.Provider] {
+		cn = ""
+	}
 
 	p.clientsMu.RLock()
 	c, ok := p.clients[cn]
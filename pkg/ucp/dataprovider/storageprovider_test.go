@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetStorageClient_SharedClient(t *testing.T) {
+	providers := []StorageProviderType{TypeAPIServer, TypeETCD, TypePostgreSQL, TypeBolt}
+	for _, provider := range providers {
+		t.Run(string(provider), func(t *testing.T) {
+			ctx := testcontext.New(t)
+			ctrl := gomock.NewController(t)
+
+			original := storageClientFactory[provider]
+			t.Cleanup(func() { storageClientFactory[provider] = original })
+
+			names := []string{}
+			storageClientFactory[provider] = func(_ context.Context, _ StorageProviderOptions, name string) (store.StorageClient, error) {
+				names = append(names, name)
+				return store.NewMockStorageClient(ctrl), nil
+			}
+
+			p := NewStorageProvider(StorageProviderOptions{Provider: provider})
+			containers, err := p.GetStorageClient(ctx, "Applications.Core/containers")
+			require.NoError(t, err)
+			statuses, err := p.GetStorageClient(ctx, "Applications.Core/operationStatuses")
+			require.NoError(t, err)
+
+			// The resource and its operation statuses must share a client so that they can be saved in one transaction.
+			require.Same(t, containers, statuses)
+			require.Equal(t, []string{""}, names)
+		})
+	}
+}
+
+func Test_GetStorageClient_CosmosDB(t *testing.T) {
+	ctx := testcontext.New(t)
+	ctrl := gomock.NewController(t)
+
+	original := storageClientFactory[TypeCosmosDB]
+	t.Cleanup(func() { storageClientFactory[TypeCosmosDB] = original })
+
+	names := []string{}
+	storageClientFactory[TypeCosmosDB] = func(_ context.Context, _ StorageProviderOptions, name string) (store.StorageClient, error) {
+		names = append(names, name)
+		return store.NewMockStorageClient(ctrl), nil
+	}
+
+	p := NewStorageProvider(StorageProviderOptions{Provider: TypeCosmosDB})
+	containers, err := p.GetStorageClient(ctx, "Applications.Core/containers")
+	require.NoError(t, err)
+	statuses, err := p.GetStorageClient(ctx, "Applications.Core/operationStatuses")
+	require.NoError(t, err)
+	again, err := p.GetStorageClient(ctx, "Applications.Core/containers")
+	require.NoError(t, err)
+
+	// CosmosDB keeps a collection per resource type, so the resource and its operation statuses are not saved in one
+	// transaction.
+	require.NotSame(t, containers, statuses)
+	require.Same(t, containers, again)
+	require.Equal(t, []string{"applicationscore-containers", "applicationscore-operationstatuses"}, names)
+}
@@ -34,6 +34,12 @@ const (
 
 	// TypeETCD represents the etcd provider.
 	TypeETCD StorageProviderType = "etcd"
+
+	// TypePostgreSQL represents the PostgreSQL provider.
+	TypePostgreSQL StorageProviderType = "postgresql"
+
+	// TypeBolt represents the embedded bolt database provider.
+	TypeBolt StorageProviderType = "bolt"
 )
 
 //go:generate mockgen -destination=./mock_datastorage_provider.go -package=dataprovider -self_package github.com/radius-project/radius/pkg/ucp/dataprovider github.com/radius-project/radius/pkg/ucp/dataprovider DataStorageProvider
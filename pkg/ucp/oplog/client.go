@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oplog
+
+import (
+	"context"
+	"sort"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// maxLogsPerResource is the maximum number of operation logs kept for a resource. The logs of the oldest operations
+	// are deleted when an operation starts recording.
+	maxLogsPerResource = 10
+)
+
+// Client saves and gets the operation logs of resources. Logs are stored with the storage client of the resource type,
+// nested under the resource: {resourceID}/operationlogs/{operationID}.
+type Client struct {
+	storageClient store.StorageClient
+}
+
+// NewClient creates a new operation logs Client.
+func NewClient(storageClient store.StorageClient) *Client {
+	return &Client{storageClient: storageClient}
+}
+
+// LogID returns the ID of the log of the operation on the resource.
+func LogID(resourceID resources.ID, operationID string) resources.ID {
+	return resourceID.Append(resources.TypeSegment{Type: LogsTypeSegment, Name: operationID})
+}
+
+// Save saves the log, replacing the previously saved log of the same operation.
+func (c *Client) Save(ctx context.Context, log *Log) error {
+	resourceID, err := resources.ParseResource(log.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	log.ID = LogID(resourceID, log.OperationID).String()
+	return c.storageClient.Save(ctx, &store.Object{Metadata: store.Metadata{ID: log.ID}, Data: log})
+}
+
+// Get returns the log of the operation on the resource. It returns store.ErrNotFound if the operation has no log.
+func (c *Client) Get(ctx context.Context, resourceID resources.ID, operationID string) (*Log, error) {
+	obj, err := c.storageClient.Get(ctx, LogID(resourceID, operationID).String())
+	if err != nil {
+		return nil, err
+	}
+
+	log := &Log{}
+	if err := obj.As(log); err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+// Delete deletes all the logs of the operations on the resource. It is called when the resource is deleted.
+func (c *Client) Delete(ctx context.Context, resourceID resources.ID) error {
+	return c.Prune(ctx, resourceID, 0)
+}
+
+// Prune deletes the logs of the oldest operations on the resource so that at most keep logs remain.
+func (c *Client) Prune(ctx context.Context, resourceID resources.ID, keep int) error {
+	query := store.Query{
+		RootScope:          resourceID.RootScope(),
+		RoutingScopePrefix: resourceID.RoutingScope(),
+		ResourceType:       resourceID.Type() + "/" + LogsTypeSegment,
+	}
+
+	logs := []*Log{}
+	token := ""
+	for {
+		result, err := c.storageClient.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range result.Items {
+			log := &Log{}
+			if err := item.As(log); err != nil {
+				return err
+			}
+			logs = append(logs, log)
+		}
+
+		token = result.PaginationToken
+		if token == "" {
+			break
+		}
+	}
+
+	if len(logs) <= keep {
+		return nil
+	}
+
+	sort.SliceStable(logs, func(i, j int) bool {
+		return logs[i].LastUpdatedTime.Before(logs[j].LastUpdatedTime)
+	})
+
+	for _, log := range logs[:len(logs)-keep] {
+		if err := c.storageClient.Delete(ctx, log.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oplog
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceID  = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/test-redis"
+	otherResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/test-redis-2"
+)
+
+func newTestClient(t *testing.T) (*Client, store.StorageClient) {
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	sc, err := boltstore.NewBoltClient(db)
+	require.NoError(t, err)
+	return NewClient(sc), sc
+}
+
+func TestClient(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, _ := newTestClient(t)
+
+	operationID := uuid.NewString()
+	log := &Log{
+		ResourceID:  testResourceID,
+		OperationID: operationID,
+		Entries:     []Entry{{Sequence: 1, Level: LevelInfo, Message: "Deploying recipe"}},
+	}
+	require.NoError(t, client.Save(ctx, log))
+	require.Equal(t, testResourceID+"/operationlogs/"+operationID, log.ID)
+
+	t.Run("get", func(t *testing.T) {
+		actual, err := client.Get(ctx, resources.MustParse(testResourceID), operationID)
+		require.NoError(t, err)
+		require.Equal(t, log.Entries, actual.Entries)
+	})
+
+	t.Run("get-not-found", func(t *testing.T) {
+		_, err := client.Get(ctx, resources.MustParse(otherResourceID), operationID)
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: LogID(resources.MustParse(otherResourceID), operationID).String()})
+	})
+}
+
+func TestClient_Prune(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, _ := newTestClient(t)
+
+	now := time.Now()
+	operationIDs := []string{}
+	for i := 0; i < 4; i++ {
+		operationID := uuid.NewString()
+		operationIDs = append(operationIDs, operationID)
+		err := client.Save(ctx, &Log{ResourceID: testResourceID, OperationID: operationID, LastUpdatedTime: now.Add(time.Duration(i) * time.Minute)})
+		require.NoError(t, err)
+	}
+	require.NoError(t, client.Save(ctx, &Log{ResourceID: otherResourceID, OperationID: uuid.NewString(), LastUpdatedTime: now.Add(-time.Hour)}))
+
+	require.NoError(t, client.Prune(ctx, resources.MustParse(testResourceID), 2))
+
+	for i, operationID := range operationIDs {
+		_, err := client.Get(ctx, resources.MustParse(testResourceID), operationID)
+		if i < 2 {
+			require.Error(t, err, "log %d should be deleted", i)
+		} else {
+			require.NoError(t, err, "log %d should be kept", i)
+		}
+	}
+
+	// The logs of the other resources are kept.
+	require.NoError(t, client.Prune(ctx, resources.MustParse(otherResourceID), 1))
+}
+
+func TestClient_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	client, _ := newTestClient(t)
+
+	operationID := uuid.NewString()
+	require.NoError(t, client.Save(ctx, &Log{ResourceID: testResourceID, OperationID: operationID}))
+	otherOperationID := uuid.NewString()
+	require.NoError(t, client.Save(ctx, &Log{ResourceID: otherResourceID, OperationID: otherOperationID}))
+
+	require.NoError(t, client.Delete(ctx, resources.MustParse(testResourceID)))
+
+	_, err := client.Get(ctx, resources.MustParse(testResourceID), operationID)
+	require.ErrorIs(t, err, &store.ErrNotFound{ID: LogID(resources.MustParse(testResourceID), operationID).String()})
+
+	// The logs of the other resources are kept.
+	_, err = client.Get(ctx, resources.MustParse(otherResourceID), otherOperationID)
+	require.NoError(t, err)
+
+	// Deleting a resource without logs succeeds.
+	require.NoError(t, client.Delete(ctx, resources.MustParse(testResourceID)))
+}
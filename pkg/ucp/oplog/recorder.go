@@ -0,0 +1,315 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oplog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// defaultMaxEntries is the maximum number of entries kept in a log. The oldest entries are dropped when it is exceeded.
+	defaultMaxEntries = 1000
+
+	// maxMessageLength is the maximum length of the message of an entry. Longer messages are truncated.
+	maxMessageLength = 4096
+
+	// maxLogSize is the maximum serialized size of a log. The oldest entries are dropped when it is exceeded.
+	maxLogSize = 1024 * 1024
+
+	// maxLogHeaderSize is the size reserved in a log for the fields other than its entries, such as its IDs.
+	maxLogHeaderSize = 4096
+
+	// redactedValue replaces the sensitive values in the log.
+	redactedValue = "***"
+)
+
+// saveInterval is the interval between the saves of the log while the operation is running.
+var saveInterval = 5 * time.Second
+
+type recorderContextKey struct{}
+
+// Recorder records the entries of an operation log in memory. It keeps at most a maximum number of entries, so that
+// the serialized log does not exceed maxLogSize, and redacts the sensitive values from the messages. A Recorder is safe for concurrent use, and a nil Recorder discards
+// the entries, so that code which records entries does not need to check whether the operation is being recorded.
+type Recorder struct {
+	mu              sync.Mutex
+	maxEntries      int
+	maxSize         int
+	entries         []Entry
+	size            int
+	sequence        int64
+	dropped         int64
+	sensitiveValues []string
+	redactor        *strings.Replacer
+	changed         bool
+}
+
+// NewRecorder creates a new Recorder which keeps at most maxEntries entries.
+func NewRecorder(maxEntries int) *Recorder {
+	if maxEntries <= 0 {
+		maxEntries = defaultMaxEntries
+	}
+
+	return &Recorder{maxEntries: maxEntries, maxSize: maxLogSize - maxLogHeaderSize}
+}
+
+// WithRecorder returns a new context with the recorder.
+func WithRecorder(ctx context.Context, recorder *Recorder) context.Context {
+	return context.WithValue(ctx, recorderContextKey{}, recorder)
+}
+
+// FromContext returns the recorder of the context, or nil if the operation is not being recorded.
+func FromContext(ctx context.Context) *Recorder {
+	recorder, _ := ctx.Value(recorderContextKey{}).(*Recorder)
+	return recorder
+}
+
+// AddSensitiveValues adds values to redact from the entries. They are redacted from the entries already recorded too.
+func (r *Recorder) AddSensitiveValues(values ...string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	added := false
+	for _, value := range values {
+		if value != "" {
+			r.sensitiveValues = append(r.sensitiveValues, value)
+			added = true
+		}
+	}
+	if !added {
+		return
+	}
+
+	oldnew := []string{}
+	for _, value := range r.sensitiveValues {
+		oldnew = append(oldnew, value, redactedValue)
+	}
+	r.redactor = strings.NewReplacer(oldnew...)
+
+	r.size = 0
+	for i := range r.entries {
+		r.entries[i].Message = r.redactor.Replace(r.entries[i].Message)
+		r.size += entrySize(r.entries[i])
+	}
+	r.changed = true
+
+	r.dropOldest()
+}
+
+// Info records the message as an Info entry. Messages with multiple lines are recorded as one entry per line.
+func (r *Recorder) Info(source string, message string) {
+	r.record(LevelInfo, source, message)
+}
+
+// Error records the message as an Error entry. Messages with multiple lines are recorded as one entry per line.
+func (r *Recorder) Error(source string, message string) {
+	r.record(LevelError, source, message)
+}
+
+// Writer returns a writer which records each line written to it as an entry with the given level and source. A line
+// is recorded once its end is written.
+func (r *Recorder) Writer(level string, source string) io.Writer {
+	return &lineWriter{recorder: r, level: level, source: source}
+}
+
+func (r *Recorder) record(level string, source string, message string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+	for _, line := range strings.Split(message, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if r.redactor != nil {
+			line = r.redactor.Replace(line)
+		}
+		if len(line) > maxMessageLength {
+			line = line[:maxMessageLength] + "..."
+		}
+
+		r.sequence++
+		entry := Entry{
+			Sequence:  r.sequence,
+			Timestamp: now,
+			Level:     level,
+			Source:    source,
+			Message:   line,
+		}
+		r.entries = append(r.entries, entry)
+		r.size += entrySize(entry)
+		r.changed = true
+	}
+
+	r.dropOldest()
+}
+
+// dropOldest drops the oldest entries while the log has more than the maximum number of entries or its entries exceed
+// the maximum serialized size.
+func (r *Recorder) dropOldest() {
+	excess := 0
+	for excess < len(r.entries) && (len(r.entries)-excess > r.maxEntries || r.size > r.maxSize) {
+		r.size -= entrySize(r.entries[excess])
+		excess++
+	}
+
+	if excess > 0 {
+		r.entries = append([]Entry{}, r.entries[excess:]...)
+		r.dropped += int64(excess)
+	}
+}
+
+// entrySize returns the serialized size of the entry in a log, including the separator from the previous entry.
+func entrySize(entry Entry) int {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return 0
+	}
+
+	return len(b) + 1
+}
+
+// Entries returns a copy of the recorded entries. It returns nil for a nil Recorder.
+func (r *Recorder) Entries() []Entry {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Entry{}, r.entries...)
+}
+
+// snapshot copies the entries to log and returns true if entries were recorded since the last snapshot.
+func (r *Recorder) snapshot(log *Log) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	log.Entries = append([]Entry{}, r.entries...)
+	log.DroppedEntries = r.dropped
+
+	changed := r.changed
+	r.changed = false
+	return changed
+}
+
+// lineWriter records the lines written to it as entries.
+type lineWriter struct {
+	recorder *Recorder
+	level    string
+	source   string
+
+	mu      sync.Mutex
+	pending bytes.Buffer
+}
+
+// Write implements the io.Writer interface. Incomplete lines are kept until their end is written.
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pending.Write(p)
+	if i := bytes.LastIndexByte(w.pending.Bytes(), '\n'); i >= 0 {
+		lines := string(w.pending.Next(i + 1))
+		w.recorder.record(w.level, w.source, lines)
+	}
+
+	return len(p), nil
+}
+
+// Start starts recording the log of the operation on the resource, and returns a context with the recorder and a
+// function to call when the operation completes. The log is saved periodically while the operation is running, so
+// that it can be followed, and saved a last time marked as complete by the returned function. The logs of the oldest
+// operations on the resource are deleted so that only the most recent ones are kept. Failures to save the log are
+// logged and do not fail the operation.
+func (c *Client) Start(ctx context.Context, resourceID resources.ID, operationID string) (context.Context, func()) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if err := c.Prune(ctx, resourceID, maxLogsPerResource-1); err != nil {
+		logger.Error(err, "failed to delete the logs of the previous operations")
+	}
+
+	recorder := NewRecorder(defaultMaxEntries)
+	log := &Log{ResourceID: resourceID.String(), OperationID: operationID}
+
+	save := func(ctx context.Context, complete bool) {
+		if !recorder.snapshot(log) && !complete {
+			return
+		}
+
+		log.Complete = complete
+		log.LastUpdatedTime = time.Now().UTC()
+		if err := c.Save(ctx, log); err != nil {
+			logger.Error(err, "failed to save the operation log")
+		}
+	}
+
+	// Save the empty log so that it can be followed as soon as the operation starts.
+	recorder.changed = true
+	save(ctx, false)
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(saveInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				save(ctx, false)
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	finish := func() {
+		close(done)
+		<-stopped
+
+		// The operation context is canceled when the operation times out, but the entries recorded until then are
+		// the most useful to understand why.
+		save(context.WithoutCancel(ctx), true)
+	}
+
+	return WithRecorder(ctx, recorder), finish
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oplog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Run("record", func(t *testing.T) {
+		recorder := NewRecorder(10)
+		recorder.Info("bicep", "first line\n\nsecond line\r\n")
+		recorder.Error("terraform", "failed")
+
+		log := &Log{}
+		require.True(t, recorder.snapshot(log))
+		require.Len(t, log.Entries, 3)
+		require.Equal(t, int64(1), log.Entries[0].Sequence)
+		require.Equal(t, "first line", log.Entries[0].Message)
+		require.Equal(t, "second line", log.Entries[1].Message)
+		require.Equal(t, LevelError, log.Entries[2].Level)
+		require.Equal(t, "terraform", log.Entries[2].Source)
+
+		require.False(t, recorder.snapshot(log))
+		require.Equal(t, log.Entries, recorder.Entries())
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		recorder := NewRecorder(3)
+		for i := 1; i <= 5; i++ {
+			recorder.Info("", fmt.Sprintf("line %d", i))
+		}
+		recorder.Info("", string(make([]byte, maxMessageLength+10)))
+
+		log := &Log{}
+		recorder.snapshot(log)
+		require.Len(t, log.Entries, 3)
+		require.Equal(t, int64(3), log.DroppedEntries)
+		require.Equal(t, "line 4", log.Entries[0].Message)
+		require.Equal(t, int64(6), log.Entries[2].Sequence)
+		require.Len(t, log.Entries[2].Message, maxMessageLength+3)
+	})
+
+	t.Run("size limit", func(t *testing.T) {
+		recorder := NewRecorder(10 * defaultMaxEntries)
+		for i := 1; i <= 400; i++ {
+			recorder.Info("terraform", fmt.Sprintf("%04d %s", i, strings.Repeat("x", maxMessageLength-5)))
+		}
+
+		log := &Log{
+			ID:          "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis/operationlogs/" + uuid.NewString(),
+			ResourceID:  "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+			OperationID: uuid.NewString(),
+		}
+		recorder.snapshot(log)
+		b, err := json.Marshal(log)
+		require.NoError(t, err)
+
+		// The log is filled up to the limit: it would exceed it with one more entry.
+		require.LessOrEqual(t, len(b), maxLogSize)
+		require.Greater(t, len(b)+entrySize(log.Entries[0]), maxLogSize-maxLogHeaderSize)
+		require.Equal(t, int64(400), log.DroppedEntries+int64(len(log.Entries)))
+		require.Greater(t, log.DroppedEntries, int64(0))
+		require.Equal(t, log.DroppedEntries+1, log.Entries[0].Sequence)
+		require.Equal(t, int64(400), log.Entries[len(log.Entries)-1].Sequence)
+
+		// Redacting the sensitive values can make the entries longer.
+		recorder.AddSensitiveValues("x")
+		recorder.snapshot(log)
+		b, err = json.Marshal(log)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(b), maxLogSize)
+		require.Equal(t, int64(400), log.Entries[len(log.Entries)-1].Sequence)
+	})
+
+	t.Run("redacted", func(t *testing.T) {
+		recorder := NewRecorder(10)
+		recorder.Info("", "token: secret-token")
+		recorder.AddSensitiveValues("secret-token", "")
+		recorder.Info("", "password: secret-password")
+		recorder.AddSensitiveValues("secret-password")
+
+		log := &Log{}
+		recorder.snapshot(log)
+		require.Equal(t, "token: ***", log.Entries[0].Message)
+		require.Equal(t, "password: ***", log.Entries[1].Message)
+	})
+
+	t.Run("redacted-before-truncation", func(t *testing.T) {
+		recorder := NewRecorder(10)
+		recorder.AddSensitiveValues("secret-token")
+		recorder.Info("", strings.Repeat("a", maxMessageLength-5)+"secret-token")
+
+		log := &Log{}
+		recorder.snapshot(log)
+		require.Equal(t, strings.Repeat("a", maxMessageLength-5)+"***", log.Entries[0].Message)
+	})
+
+	t.Run("writer", func(t *testing.T) {
+		recorder := NewRecorder(10)
+		w := recorder.Writer(LevelInfo, "terraform")
+		_, _ = w.Write([]byte("Apply "))
+		_, _ = w.Write([]byte("complete!\nResources: 1 added"))
+
+		log := &Log{}
+		recorder.snapshot(log)
+		require.Len(t, log.Entries, 1)
+		require.Equal(t, "Apply complete!", log.Entries[0].Message)
+
+		_, _ = w.Write([]byte("\n"))
+		recorder.snapshot(log)
+		require.Len(t, log.Entries, 2)
+		require.Equal(t, "Resources: 1 added", log.Entries[1].Message)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var recorder *Recorder
+		recorder.Info("", "discarded")
+		recorder.AddSensitiveValues("secret")
+		_, err := recorder.Writer(LevelInfo, "").Write([]byte("discarded\n"))
+		require.NoError(t, err)
+		require.Nil(t, recorder.Entries())
+	})
+}
+
+func TestClient_Start(t *testing.T) {
+	saveInterval = 10 * time.Millisecond
+	t.Cleanup(func() { saveInterval = 5 * time.Second })
+
+	ctx := testcontext.New(t)
+	client, _ := newTestClient(t)
+	resourceID := resources.MustParse(testResourceID)
+	operationID := uuid.NewString()
+
+	recordingCtx, finish := client.Start(ctx, resourceID, operationID)
+	recorder := FromContext(recordingCtx)
+	require.NotNil(t, recorder)
+
+	log, err := client.Get(ctx, resourceID, operationID)
+	require.NoError(t, err)
+	require.Empty(t, log.Entries)
+	require.False(t, log.Complete)
+
+	recorder.Info("bicep", "Deploying recipe")
+	require.Eventually(t, func() bool {
+		log, err := client.Get(ctx, resourceID, operationID)
+		return err == nil && len(log.Entries) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	recorder.Info("bicep", "Deployed recipe")
+	finish()
+
+	log, err = client.Get(ctx, resourceID, operationID)
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 2)
+	require.True(t, log.Complete)
+
+	require.Nil(t, FromContext(ctx))
+}
+
+func TestClient_Start_Redacted(t *testing.T) {
+	saveInterval = 10 * time.Millisecond
+	t.Cleanup(func() { saveInterval = 5 * time.Second })
+
+	ctx := testcontext.New(t)
+	client, _ := newTestClient(t)
+	resourceID := resources.MustParse(testResourceID)
+	operationID := uuid.NewString()
+
+	recordingCtx, finish := client.Start(ctx, resourceID, operationID)
+	recorder := FromContext(recordingCtx)
+
+	// The secret is saved before it is known to be sensitive.
+	recorder.Info("terraform", "token: secret-token")
+	require.Eventually(t, func() bool {
+		log, err := client.Get(ctx, resourceID, operationID)
+		return err == nil && len(log.Entries) == 1 && log.Entries[0].Message == "token: secret-token"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The next periodic save overwrites the stored log with the redacted entries.
+	recorder.AddSensitiveValues("secret-token")
+	require.Eventually(t, func() bool {
+		log, err := client.Get(ctx, resourceID, operationID)
+		return err == nil && len(log.Entries) == 1 && log.Entries[0].Message == "token: ***"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	finish()
+
+	log, err := client.Get(ctx, resourceID, operationID)
+	require.NoError(t, err)
+	require.True(t, log.Complete)
+	b, err := json.Marshal(log)
+	require.NoError(t, err)
+	require.NotContains(t, string(b), "secret-token")
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oplog implements the execution logs of async operations, such as the output of the recipes executed to
+// create or delete a resource. Logs are bounded, redacted and stored nested under the resource:
+// {resourceID}/operationlogs/{operationID}. They are kept after the operation completes, so that the cause of a
+// failure can be investigated without access to the cluster.
+package oplog
+
+import "time"
+
+const (
+	// LogsTypeSegment is the type segment of the operation logs nested under a resource.
+	LogsTypeSegment = "operationlogs"
+
+	// LogsAction is the name of the action of operation statuses to get the logs of the operation.
+	LogsAction = "logs"
+
+	// SinceQueryParameter is the query parameter to get only the entries with a sequence number greater than its value.
+	SinceQueryParameter = "since"
+
+	// LevelInfo is the level of the entries which report the progress of the operation.
+	LevelInfo = "Info"
+
+	// LevelError is the level of the entries which report errors.
+	LevelError = "Error"
+)
+
+// Log is the execution log of an async operation.
+type Log struct {
+	// ID is the ID of the log.
+	ID string `json:"id"`
+
+	// ResourceID is the ID of the resource the operation was made on.
+	ResourceID string `json:"resourceId"`
+
+	// OperationID is the ID of the operation. It matches the name of its operation status.
+	OperationID string `json:"operationId"`
+
+	// Entries are the entries of the log ordered by their sequence number.
+	Entries []Entry `json:"entries"`
+
+	// DroppedEntries is the number of the oldest entries that were dropped because the log exceeded its maximum size.
+	DroppedEntries int64 `json:"droppedEntries,omitempty"`
+
+	// Complete is true when the operation has completed and no more entries will be added to the log.
+	Complete bool `json:"complete"`
+
+	// LastUpdatedTime is the time when the log was last saved.
+	LastUpdatedTime time.Time `json:"lastUpdatedTime"`
+}
+
+// Entry is an entry of an operation log.
+type Entry struct {
+	// Sequence is the sequence number of the entry in the log, starting at 1.
+	Sequence int64 `json:"sequence"`
+
+	// Timestamp is the time when the entry was recorded.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Level is the level of the entry: Info or Error.
+	Level string `json:"level"`
+
+	// Source is the component which wrote the entry, for example the recipe driver.
+	Source string `json:"source,omitempty"`
+
+	// Message is the message of the entry with the sensitive values redacted.
+	Message string `json:"message"`
+}
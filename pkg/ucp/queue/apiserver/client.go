@@ -170,12 +170,15 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 		return err
 	}
 
+	// The APIServer queue does not support priority, but the visibility of the message can be delayed.
+	cfg := client.NewEnqueueConfig(options...)
+
 	resource := &v1alpha1.QueueMessage{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      id,
 			Namespace: c.opts.Namespace,
 			Labels: map[string]string{
-				LabelNextVisibleAt: int64toa(now.UnixNano()),
+				LabelNextVisibleAt: int64toa(now.Add(cfg.Delay).UnixNano()),
 				LabelQueueName:     c.opts.Name,
 			},
 		},
@@ -0,0 +1,368 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bolt implements a durable queue client backed by an embedded bolt database file. It is
+// intended for local development and test harnesses where the processes run without Kubernetes.
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// bucketPrefix is the prefix of the bucket name used for each queue.
+	bucketPrefix = "queue|"
+
+	// deadLetterBucketPrefix is the prefix of the bucket name used for the dead-letter queue of each queue.
+	deadLetterBucketPrefix = "deadletter|"
+
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+)
+
+var _ client.Client = (*Client)(nil)
+var _ client.DeadLetterClient = (*Client)(nil)
+
+// Client is the queue client backed by a bolt database.
+type Client struct {
+	db         *bbolt.DB
+	bucket     []byte
+	deadLetter []byte
+
+	opts Options
+}
+
+// Options represents the options of the bolt queue client.
+type Options struct {
+	// Name is the name of the queue. Each queue is stored in its own bucket.
+	Name string
+
+	// MessageLockDuration is the duration for which a dequeued message is invisible to other consumers.
+	MessageLockDuration time.Duration
+
+	// ExpiryDuration is the duration after which an unprocessed message is discarded.
+	ExpiryDuration time.Duration
+}
+
+// New creates the bolt queue Client instance, creating the bucket for the queue if it does not already exist.
+func New(db *bbolt.DB, options Options) (*Client, error) {
+	if options.Name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	bucket := []byte(bucketPrefix + options.Name)
+	deadLetter := []byte(deadLetterBucketPrefix + options.Name)
+	err := db.Update(func(tx *bbolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(deadLetter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{db: db, bucket: bucket, deadLetter: deadLetter, opts: options}, nil
+}
+
+// Enqueue enqueues message to the bolt queue. Messages with a higher priority are dequeued first, and messages with
+// the same priority are dequeued in the order they were enqueued.
+func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...client.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return client.ErrEmptyMessage
+	}
+
+	if msg.ContentType != client.JSONContentType {
+		return client.ErrUnsupportedContentType
+	}
+
+	cfg := client.NewEnqueueConfig(options...)
+	now := time.Now()
+	item := &client.Message{
+		Metadata: client.Metadata{
+			ID:            uuid.NewString(),
+			DequeueCount:  0,
+			EnqueueAt:     now.UTC(),
+			ExpireAt:      now.Add(c.opts.ExpiryDuration).UTC(),
+			NextVisibleAt: now.Add(cfg.Delay),
+			Priority:      cfg.Priority,
+		},
+		ContentType: msg.ContentType,
+		Data:        msg.Data,
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		return put(tx.Bucket(c.bucket), item)
+	})
+}
+
+// Dequeue dequeues the visible message with the highest priority from the bolt queue and locks it for
+// MessageLockDuration. Expired messages are discarded while scanning.
+func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*client.Message, error) {
+	var result *client.Message
+	now := time.Now()
+
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucket)
+		cursor := bucket.Cursor()
+
+		var key []byte
+		// Deleting through the cursor while iterating skips the following key, so expired keys are
+		// collected and deleted once the scan is complete.
+		expired := [][]byte{}
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			msg := &client.Message{}
+			if err := json.Unmarshal(v, msg); err != nil {
+				return err
+			}
+
+			if msg.ExpireAt.Before(now) {
+				expired = append(expired, append([]byte{}, k...))
+				continue
+			}
+
+			if msg.NextVisibleAt.After(now) {
+				continue
+			}
+
+			// Keys are iterated in enqueue order, so the first message wins when priorities are equal.
+			if result == nil || msg.Priority > result.Priority {
+				result = msg
+				// Copy the key since the bucket may be modified while scanning.
+				key = append([]byte{}, k...)
+			}
+		}
+
+		for _, k := range expired {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		// Returning an error would roll back the deletes above, so a miss is reported after the commit.
+		if result == nil {
+			return nil
+		}
+
+		result.DequeueCount++
+		result.NextVisibleAt = now.Add(c.opts.MessageLockDuration)
+
+		b, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, b)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, client.ErrMessageNotFound
+	}
+
+	return result, nil
+}
+
+// FinishMessage finishes or deletes the message in the queue.
+func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		k, stored, err := find(tx.Bucket(c.bucket), msg.ID)
+		if err != nil {
+			return err
+		}
+
+		if stored.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		return tx.Bucket(c.bucket).Delete(k)
+	})
+}
+
+// ExtendMessage extends the message lock. It returns ErrInvalidMessage if the lock has already expired and
+// ErrDequeuedMessage if the message has been dequeued again by another consumer.
+func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	now := time.Now()
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		k, stored, err := find(tx.Bucket(c.bucket), msg.ID)
+		if err != nil {
+			return err
+		}
+
+		if stored.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		if stored.NextVisibleAt.Before(now) {
+			return client.ErrInvalidMessage
+		}
+
+		stored.NextVisibleAt = now.Add(c.opts.MessageLockDuration)
+		b, err := json.Marshal(stored)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(c.bucket).Put(k, b); err != nil {
+			return err
+		}
+
+		msg.Metadata = stored.Metadata
+		return nil
+	})
+}
+
+// DeadLetterMessage moves the dequeued message to the dead-letter queue. It returns ErrDequeuedMessage if the message
+// has been dequeued again by another consumer.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		k, stored, err := find(tx.Bucket(c.bucket), msg.ID)
+		if err != nil {
+			return err
+		}
+
+		if stored.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		if err := tx.Bucket(c.bucket).Delete(k); err != nil {
+			return err
+		}
+
+		stored.DeadLetterReason = reason
+		return put(tx.Bucket(c.deadLetter), stored)
+	})
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter queue in the order they were dead-lettered.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*client.Message, error) {
+	result := []*client.Message{}
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(c.deadLetter).ForEach(func(k, v []byte) error {
+			msg := &client.Message{}
+			if err := json.Unmarshal(v, msg); err != nil {
+				return err
+			}
+			result = append(result, msg)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ReplayDeadLetterMessage moves the dead-lettered message back to the queue. The message is visible immediately and
+// its dequeue count and expiry are reset.
+func (c *Client) ReplayDeadLetterMessage(ctx context.Context, id string) error {
+	now := time.Now()
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		k, stored, err := find(tx.Bucket(c.deadLetter), id)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(c.deadLetter).Delete(k); err != nil {
+			return err
+		}
+
+		stored.DequeueCount = 0
+		stored.DeadLetterReason = ""
+		stored.ExpireAt = now.Add(c.opts.ExpiryDuration).UTC()
+		stored.NextVisibleAt = now
+		return put(tx.Bucket(c.bucket), stored)
+	})
+}
+
+// DeleteDeadLetterMessage deletes the dead-lettered message.
+func (c *Client) DeleteDeadLetterMessage(ctx context.Context, id string) error {
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		k, _, err := find(tx.Bucket(c.deadLetter), id)
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(c.deadLetter).Delete(k)
+	})
+}
+
+// put stores the message in the bucket using the next key of the bucket.
+func put(bucket *bbolt.Bucket, msg *client.Message) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	// Keys are the big-endian bucket sequence so that iteration order is enqueue order.
+	seq, err := bucket.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return bucket.Put(key, b)
+}
+
+// find returns the key and value of the message with the given id.
+func find(bucket *bbolt.Bucket, id string) ([]byte, *client.Message, error) {
+	cursor := bucket.Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		msg := &client.Message{}
+		if err := json.Unmarshal(v, msg); err != nil {
+			return nil, nil, err
+		}
+
+		if msg.ID == id {
+			return k, msg, nil
+		}
+	}
+
+	return nil, nil, client.ErrInvalidMessage
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bolt
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestClient(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "queue.db"))
+	require.NoError(t, err)
+
+	cli, err := New(db, Options{Name: "applications.core", MessageLockDuration: sharedtest.TestMessageLockTime})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		err := db.Update(func(tx *bbolt.Tx) error {
+			for _, bucket := range [][]byte{cli.bucket, cli.deadLetter} {
+				if err := tx.DeleteBucket(bucket); err != nil {
+					return err
+				}
+				if _, err := tx.CreateBucket(bucket); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	sharedtest.RunTest(t, cli, clear)
+	sharedtest.RunEnqueueOptionsTest(t, cli, clear)
+	sharedtest.RunDeadLetterTest(t, cli, clear)
+
+	t.Run("messages survive reopening the client", func(t *testing.T) {
+		clear(t)
+
+		err := cli.Enqueue(ctx, client.NewMessage("{}"))
+		require.NoError(t, err)
+
+		other, err := New(db, Options{Name: "applications.core"})
+		require.NoError(t, err)
+
+		msg, err := other.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, 1, msg.DequeueCount)
+	})
+
+	t.Run("queues are isolated by name", func(t *testing.T) {
+		clear(t)
+
+		other, err := New(db, Options{Name: "ucp"})
+		require.NoError(t, err)
+
+		err = other.Enqueue(ctx, client.NewMessage("{}"))
+		require.NoError(t, err)
+
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+	})
+
+	t.Run("expired messages are discarded", func(t *testing.T) {
+		clear(t)
+
+		expiring, err := New(db, Options{Name: "applications.core", ExpiryDuration: time.Millisecond})
+		require.NoError(t, err)
+
+		err = expiring.Enqueue(ctx, client.NewMessage("{}"))
+		require.NoError(t, err)
+
+		time.Sleep(10 * time.Millisecond)
+
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+	})
+
+	t.Run("consecutive expired messages are all discarded", func(t *testing.T) {
+		clear(t)
+
+		expiring, err := New(db, Options{Name: "applications.core", ExpiryDuration: time.Millisecond})
+		require.NoError(t, err)
+
+		for i := 0; i < 4; i++ {
+			err = expiring.Enqueue(ctx, client.NewMessage("{}"))
+			require.NoError(t, err)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		err = db.View(func(tx *bbolt.Tx) error {
+			require.Equal(t, 0, tx.Bucket(cli.bucket).Stats().KeyN)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+}
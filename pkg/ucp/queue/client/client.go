@@ -58,6 +58,34 @@ type Client interface {
 	ExtendMessage(ctx context.Context, msg *Message) error
 }
 
+// DeadLetterClient is implemented by queue clients that can move messages to a dead-letter subqueue instead of
+// discarding them. Dead-lettered messages are never dequeued, and are kept until they are replayed or deleted.
+type DeadLetterClient interface {
+	// DeadLetterMessage moves the dequeued message to the dead-letter queue with the given reason.
+	DeadLetterMessage(ctx context.Context, msg *Message, reason string) error
+
+	// ListDeadLetterMessages lists the messages in the dead-letter queue.
+	ListDeadLetterMessages(ctx context.Context) ([]*Message, error)
+
+	// ReplayDeadLetterMessage moves the dead-lettered message with the given id back to the queue and resets
+	// its dequeue count. It returns ErrInvalidMessage if the message is not in the dead-letter queue.
+	ReplayDeadLetterMessage(ctx context.Context, id string) error
+
+	// DeleteDeadLetterMessage deletes the dead-lettered message with the given id. It returns ErrInvalidMessage
+	// if the message is not in the dead-letter queue.
+	DeleteDeadLetterMessage(ctx context.Context, id string) error
+}
+
+// DeadLetter moves the dequeued message to the dead-letter queue if the client implements DeadLetterClient.
+// Otherwise the message is finished and discarded.
+func DeadLetter(ctx context.Context, cli Client, msg *Message, reason string) error {
+	if dl, ok := cli.(DeadLetterClient); ok {
+		return dl.DeadLetterMessage(ctx, msg, reason)
+	}
+
+	return cli.FinishMessage(ctx, msg)
+}
+
 // StartDequeuer starts a dequeuer to consume the message from the queue and return the output channel.
 func StartDequeuer(ctx context.Context, cli Client, opts ...DequeueOptions) (<-chan *Message, error) {
 	log := ucplog.FromContextOrDiscard(ctx)
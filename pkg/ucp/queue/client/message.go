@@ -46,6 +46,10 @@ type Metadata struct {
 	ExpireAt time.Time
 	// NextVisibleAt represents the next visible time after dequeuing the message.
 	NextVisibleAt time.Time
+	// Priority represents the priority of the message. Higher priority messages are dequeued first.
+	Priority int
+	// DeadLetterReason represents the reason why the message was moved to the dead-letter queue.
+	DeadLetterReason string
 }
 
 // NewMessage creates Message.
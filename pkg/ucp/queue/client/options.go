@@ -21,6 +21,8 @@ import "time"
 type (
 	// EnqueueOptions applies an option to Enqueue().
 	EnqueueOptions interface {
+		// ApplyEnqueueOption applies EnqueueOptions to EnqueueConfig.
+		ApplyEnqueueOption(EnqueueConfig) EnqueueConfig
 		// A private method to prevent users implementing the
 		// interface and so future additions to it will not
 		// violate compatibility.
@@ -43,6 +45,56 @@ type QueueClientConfig struct {
 	DequeueIntervalDuration time.Duration
 }
 
+// EnqueueConfig is a configuration for Enqueue().
+type EnqueueConfig struct {
+	// Priority is the priority of the message. Messages with a higher priority are dequeued first by the queue
+	// clients that support priority. The default priority is 0.
+	Priority int
+
+	// Delay is the duration for which the message is invisible after it is enqueued.
+	Delay time.Duration
+}
+
+type enqueueOptions struct {
+	fn func(EnqueueConfig) EnqueueConfig
+}
+
+// ApplyEnqueueOption applies the configuration to Enqueue().
+func (q *enqueueOptions) ApplyEnqueueOption(cfg EnqueueConfig) EnqueueConfig {
+	return q.fn(cfg)
+}
+
+func (q enqueueOptions) private() {}
+
+// WithPriority sets the priority of the enqueued message.
+func WithPriority(priority int) EnqueueOptions {
+	return &enqueueOptions{
+		fn: func(cfg EnqueueConfig) EnqueueConfig {
+			cfg.Priority = priority
+			return cfg
+		},
+	}
+}
+
+// WithDelay delays the visibility of the enqueued message by the given duration.
+func WithDelay(delay time.Duration) EnqueueOptions {
+	return &enqueueOptions{
+		fn: func(cfg EnqueueConfig) EnqueueConfig {
+			cfg.Delay = delay
+			return cfg
+		},
+	}
+}
+
+// NewEnqueueConfig returns new enqueue config for Enqueue().
+func NewEnqueueConfig(opts ...EnqueueOptions) EnqueueConfig {
+	cfg := EnqueueConfig{}
+	for _, opt := range opts {
+		cfg = opt.ApplyEnqueueOption(cfg)
+	}
+	return cfg
+}
+
 type dequeueOptions struct {
 	fn func(QueueClientConfig) QueueClientConfig
 }
@@ -55,7 +55,7 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
 		return client.ErrEmptyMessage
 	}
-	c.queue.Enqueue(msg)
+	c.queue.Enqueue(msg, options...)
 	return nil
 }
 
@@ -65,18 +65,22 @@ func (q *InmemQueue) DeleteAll() {
 	_ = q.v.Init()
 }
 
-func (q *InmemQueue) Enqueue(msg *client.Message) {
+// Enqueue enqueues the message. The message is invisible until the delay in the options has passed. Priority is not
+// supported.
+func (q *InmemQueue) Enqueue(msg *client.Message, options ...client.EnqueueOptions) {
 	q.updateQueue()
 
 	q.vMu.Lock()
 	defer q.vMu.Unlock()
 
+	cfg := client.NewEnqueueConfig(options...)
 	msg.Metadata.ID = uuid.NewString()
 	msg.Metadata.DequeueCount = 0
 	msg.Metadata.EnqueueAt = time.Now().UTC()
 	msg.Metadata.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
+	msg.Metadata.NextVisibleAt = time.Now().Add(cfg.Delay)
 
-	q.v.PushBack(&element{val: msg, visible: true})
+	q.v.PushBack(&element{val: msg, visible: cfg.Delay <= 0})
 }
 
 func (q *InmemQueue) Dequeue() *client.Message {
@@ -64,6 +64,24 @@ func TestMessageLock(t *testing.T) {
 	require.Equal(t, 2, msg2.DequeueCount)
 }
 
+func TestDelay(t *testing.T) {
+	q := NewInMemQueue(messageLockDuration)
+
+	q.Enqueue(&client.Message{
+		Data: []byte("test"),
+	}, client.WithDelay(5*time.Millisecond))
+
+	msg := q.Dequeue()
+	require.Nil(t, msg)
+
+	// Message is delayed for 5 ms, after 10 ms, message will be visible on the client.
+	time.Sleep(10 * time.Millisecond)
+
+	msg2 := q.Dequeue()
+	require.NotNil(t, msg2)
+	require.Equal(t, 1, msg2.DequeueCount)
+}
+
 func TestExpiry(t *testing.T) {
 	q := NewInMemQueue(messageLockDuration)
 
@@ -0,0 +1,330 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package postgres implements a durable queue client backed by PostgreSQL. All queues share a single table and
+// are distinguished by name. Messages are leased using SELECT ... FOR UPDATE SKIP LOCKED so that any number of
+// consumers can dequeue from the same queue concurrently.
+//
+// Messages that cannot be processed can be moved to the dead-letter queue, where they are kept until they are
+// replayed or deleted. All timestamps are taken from the database clock to avoid clock skew between consumers.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+)
+
+const (
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+
+	// columns is the list of columns that is read into client.Message.
+	columns = "id, dequeue_count, enqueue_at, expire_at, next_visible_at, priority, dead_letter_reason, content_type, data"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS queue_messages (
+	seq                BIGSERIAL PRIMARY KEY,
+	id                 TEXT NOT NULL UNIQUE,
+	queue              TEXT NOT NULL,
+	priority           INTEGER NOT NULL DEFAULT 0,
+	dequeue_count      INTEGER NOT NULL DEFAULT 0,
+	enqueue_at         TIMESTAMPTZ NOT NULL,
+	expire_at          TIMESTAMPTZ NOT NULL,
+	next_visible_at    TIMESTAMPTZ NOT NULL,
+	dead_letter        BOOLEAN NOT NULL DEFAULT FALSE,
+	dead_letter_reason TEXT NOT NULL DEFAULT '',
+	content_type       TEXT NOT NULL,
+	data               BYTEA NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS queue_messages_dequeue_idx ON queue_messages (queue, dead_letter, priority DESC, seq);
+`
+
+var _ client.Client = (*Client)(nil)
+var _ client.DeadLetterClient = (*Client)(nil)
+
+// Client is the queue client backed by PostgreSQL.
+type Client struct {
+	db   *sql.DB
+	opts Options
+}
+
+// Options represents the options of the PostgreSQL queue client.
+type Options struct {
+	// Name is the name of the queue.
+	Name string
+
+	// MessageLockDuration is the duration for which a dequeued message is invisible to other consumers.
+	MessageLockDuration time.Duration
+
+	// ExpiryDuration is the duration after which an unprocessed message is discarded. Dead-lettered messages
+	// do not expire.
+	ExpiryDuration time.Duration
+}
+
+// New creates the PostgreSQL queue Client instance. Init must be called before the client is used.
+func New(db *sql.DB, options Options) (*Client, error) {
+	if options.Name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	return &Client{db: db, opts: options}, nil
+}
+
+// Init creates the table and indexes used by the queue if they do not already exist.
+func (c *Client) Init(ctx context.Context) error {
+	_, err := c.db.ExecContext(ctx, schema)
+	return err
+}
+
+// Enqueue enqueues message to the PostgreSQL queue. Messages with a higher priority are dequeued first, and messages
+// with the same priority are dequeued in the order they were enqueued.
+func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...client.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return client.ErrEmptyMessage
+	}
+
+	if msg.ContentType != client.JSONContentType {
+		return client.ErrUnsupportedContentType
+	}
+
+	cfg := client.NewEnqueueConfig(options...)
+	_, err := c.db.ExecContext(ctx,
+		`INSERT INTO queue_messages (id, queue, priority, enqueue_at, expire_at, next_visible_at, content_type, data)
+		VALUES ($1, $2, $3, now(), now() + $4 * interval '1 microsecond', now() + $5 * interval '1 microsecond', $6, $7)`,
+		uuid.NewString(), c.opts.Name, cfg.Priority, c.opts.ExpiryDuration.Microseconds(), cfg.Delay.Microseconds(), msg.ContentType, msg.Data)
+	return err
+}
+
+// Dequeue dequeues the visible message with the highest priority from the PostgreSQL queue and locks it for
+// MessageLockDuration. Expired messages are discarded before dequeueing.
+func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*client.Message, error) {
+	_, err := c.db.ExecContext(ctx,
+		`DELETE FROM queue_messages WHERE queue = $1 AND NOT dead_letter AND expire_at <= now()`, c.opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rows that are locked by another consumer are skipped rather than waited on.
+	row := c.db.QueryRowContext(ctx,
+		`WITH next AS (
+			SELECT seq FROM queue_messages
+			WHERE queue = $1 AND NOT dead_letter AND next_visible_at <= now()
+			ORDER BY priority DESC, seq
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE queue_messages m
+		SET dequeue_count = m.dequeue_count + 1, next_visible_at = now() + $2 * interval '1 microsecond'
+		FROM next WHERE m.seq = next.seq
+		RETURNING `+columns,
+		c.opts.Name, c.opts.MessageLockDuration.Microseconds())
+
+	msg, err := scanMessage(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, client.ErrMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// FinishMessage finishes or deletes the message in the queue.
+func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	result, err := c.db.ExecContext(ctx,
+		`DELETE FROM queue_messages WHERE queue = $1 AND id = $2 AND NOT dead_letter AND dequeue_count = $3`,
+		c.opts.Name, msg.ID, msg.DequeueCount)
+	if err != nil {
+		return err
+	}
+
+	return c.checkAffected(ctx, result, msg)
+}
+
+// ExtendMessage extends the message lock. It returns ErrInvalidMessage if the lock has already expired and
+// ErrDequeuedMessage if the message has been dequeued again by another consumer.
+func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	var nextVisibleAt time.Time
+	err := c.db.QueryRowContext(ctx,
+		`UPDATE queue_messages SET next_visible_at = now() + $4 * interval '1 microsecond'
+		WHERE queue = $1 AND id = $2 AND NOT dead_letter AND dequeue_count = $3 AND next_visible_at >= now()
+		RETURNING next_visible_at`,
+		c.opts.Name, msg.ID, msg.DequeueCount, c.opts.MessageLockDuration.Microseconds()).Scan(&nextVisibleAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return c.missError(ctx, msg)
+	} else if err != nil {
+		return err
+	}
+
+	msg.NextVisibleAt = nextVisibleAt
+	return nil
+}
+
+// DeadLetterMessage moves the dequeued message to the dead-letter queue. It returns ErrDequeuedMessage if the message
+// has been dequeued again by another consumer.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *client.Message, reason string) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	result, err := c.db.ExecContext(ctx,
+		`UPDATE queue_messages SET dead_letter = TRUE, dead_letter_reason = $4
+		WHERE queue = $1 AND id = $2 AND NOT dead_letter AND dequeue_count = $3`,
+		c.opts.Name, msg.ID, msg.DequeueCount, reason)
+	if err != nil {
+		return err
+	}
+
+	return c.checkAffected(ctx, result, msg)
+}
+
+// ListDeadLetterMessages lists the messages in the dead-letter queue in the order they were enqueued.
+func (c *Client) ListDeadLetterMessages(ctx context.Context) ([]*client.Message, error) {
+	rows, err := c.db.QueryContext(ctx,
+		`SELECT `+columns+` FROM queue_messages WHERE queue = $1 AND dead_letter ORDER BY seq`, c.opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []*client.Message{}
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ReplayDeadLetterMessage moves the dead-lettered message back to the queue. The message is visible immediately and
+// its dequeue count and expiry are reset.
+func (c *Client) ReplayDeadLetterMessage(ctx context.Context, id string) error {
+	result, err := c.db.ExecContext(ctx,
+		`UPDATE queue_messages
+		SET dead_letter = FALSE, dead_letter_reason = '', dequeue_count = 0, next_visible_at = now(),
+			expire_at = now() + $3 * interval '1 microsecond'
+		WHERE queue = $1 AND id = $2 AND dead_letter`,
+		c.opts.Name, id, c.opts.ExpiryDuration.Microseconds())
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result)
+}
+
+// DeleteDeadLetterMessage deletes the dead-lettered message.
+func (c *Client) DeleteDeadLetterMessage(ctx context.Context, id string) error {
+	result, err := c.db.ExecContext(ctx,
+		`DELETE FROM queue_messages WHERE queue = $1 AND id = $2 AND dead_letter`, c.opts.Name, id)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result)
+}
+
+// checkAffected returns the reason the message was not updated if the statement did not affect any rows.
+func (c *Client) checkAffected(ctx context.Context, result sql.Result, msg *client.Message) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return c.missError(ctx, msg)
+	}
+
+	return nil
+}
+
+// missError returns ErrDequeuedMessage if the message has been dequeued again by another consumer and
+// ErrInvalidMessage otherwise.
+func (c *Client) missError(ctx context.Context, msg *client.Message) error {
+	var dequeueCount int
+	err := c.db.QueryRowContext(ctx,
+		`SELECT dequeue_count FROM queue_messages WHERE queue = $1 AND id = $2 AND NOT dead_letter`,
+		c.opts.Name, msg.ID).Scan(&dequeueCount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return client.ErrInvalidMessage
+	} else if err != nil {
+		return err
+	}
+
+	if dequeueCount != msg.DequeueCount {
+		return client.ErrDequeuedMessage
+	}
+
+	return client.ErrInvalidMessage
+}
+
+// requireAffected returns ErrInvalidMessage if the statement did not affect any rows.
+func requireAffected(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return client.ErrInvalidMessage
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMessage(row scanner) (*client.Message, error) {
+	msg := &client.Message{}
+	err := row.Scan(&msg.ID, &msg.DequeueCount, &msg.EnqueueAt, &msg.ExpireAt, &msg.NextVisibleAt,
+		&msg.Priority, &msg.DeadLetterReason, &msg.ContentType, &msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
@@ -18,23 +18,33 @@ package provider
 
 import (
 	context "context"
+	"database/sql"
 	"errors"
 	"fmt"
 
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/ucp/queue/apiserver"
+	qbolt "github.com/radius-project/radius/pkg/ucp/queue/bolt"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	qinmem "github.com/radius-project/radius/pkg/ucp/queue/inmemory"
+	qpostgres "github.com/radius-project/radius/pkg/ucp/queue/postgres"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	// The PostgreSQL queue uses the lib/pq driver through database/sql.
+	_ "github.com/lib/pq"
+
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type factoryFunc func(context.Context, QueueProviderOptions) (queue.Client, error)
 
 var clientFactory = map[QueueProviderType]factoryFunc{
-	TypeInmemory:  initInMemory,
-	TypeAPIServer: initAPIServer,
+	TypeInmemory:   initInMemory,
+	TypeAPIServer:  initAPIServer,
+	TypeBolt:       initBolt,
+	TypePostgreSQL: initPostgreSQL,
 }
 
 func initInMemory(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
@@ -80,3 +90,38 @@ func initAPIServer(ctx context.Context, opt QueueProviderOptions) (queue.Client,
 		Namespace: opt.APIServer.Namespace,
 	})
 }
+
+func initBolt(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
+	if opt.Bolt.Path == "" {
+		return nil, errors.New("failed to initialize bolt queue client: path is required")
+	}
+
+	db, err := boltstore.Open(opt.Bolt.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt queue client: %w", err)
+	}
+
+	return qbolt.New(db, qbolt.Options{Name: opt.Name})
+}
+
+func initPostgreSQL(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
+	if opt.PostgreSQL.URL == "" {
+		return nil, errors.New("failed to initialize PostgreSQL queue client: url is required")
+	}
+
+	db, err := sql.Open("postgres", opt.PostgreSQL.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize PostgreSQL queue client: %w", err)
+	}
+
+	cli, err := qpostgres.New(db, qpostgres.Options{Name: opt.Name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize PostgreSQL queue client: %w", err)
+	}
+
+	if err := cli.Init(ctx); err != nil {
+		return nil, fmt.Errorf("failed to initialize PostgreSQL queue client: %w", err)
+	}
+
+	return cli, nil
+}
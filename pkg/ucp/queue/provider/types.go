@@ -25,4 +25,10 @@ const (
 
 	// TypeAPIServer represents the Kubernetes APIServer provider.
 	TypeAPIServer QueueProviderType = "apiserver"
+
+	// TypeBolt represents the embedded bolt database provider.
+	TypeBolt QueueProviderType = "bolt"
+
+	// TypePostgreSQL represents the PostgreSQL provider.
+	TypePostgreSQL QueueProviderType = "postgresql"
 )
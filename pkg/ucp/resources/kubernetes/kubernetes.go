@@ -41,14 +41,21 @@ const (
 
 // Lookup map to get the group/Kind information from kubernetes resource kind.
 var providerLookup map[string]string = map[string]string{
-	strings.ToLower(KindDeployment):          ResourceTypeDeployment,
-	strings.ToLower(KindService):             ResourceTypeService,
-	strings.ToLower(KindSecret):              ResourceTypeSecret,
-	strings.ToLower(KindServiceAccount):      ResourceTypeServiceAccount,
-	strings.ToLower(KindRole):                ResourceTypeRole,
-	strings.ToLower(KindRoleBinding):         ResourceTypeRoleBinding,
-	strings.ToLower(KindSecretProviderClass): ResourceTypeSecretProviderClass,
-	strings.ToLower(KindContourHTTPProxy):    ResourceTypeContourHTTPProxy,
+	strings.ToLower(KindDeployment):               ResourceTypeDeployment,
+	strings.ToLower(KindService):                  ResourceTypeService,
+	strings.ToLower(KindSecret):                   ResourceTypeSecret,
+	strings.ToLower(KindServiceAccount):           ResourceTypeServiceAccount,
+	strings.ToLower(KindRole):                     ResourceTypeRole,
+	strings.ToLower(KindRoleBinding):              ResourceTypeRoleBinding,
+	strings.ToLower(KindSecretProviderClass):      ResourceTypeSecretProviderClass,
+	strings.ToLower(KindContourHTTPProxy):         ResourceTypeContourHTTPProxy,
+	strings.ToLower(KindHorizontalPodAutoscaler):  ResourceTypeHorizontalPodAutoscaler,
+	strings.ToLower(KindScaledObject):             ResourceTypeScaledObject,
+	strings.ToLower(KindTriggerAuthentication):    ResourceTypeTriggerAuthentication,
+	strings.ToLower(KindGatewayAPIGateway):        ResourceTypeGatewayAPIGateway,
+	strings.ToLower(KindGatewayAPIHTTPRoute):      ResourceTypeGatewayAPIHTTPRoute,
+	strings.ToLower(KindGatewayAPIGRPCRoute):      ResourceTypeGatewayAPIGRPCRoute,
+	strings.ToLower(KindGatewayAPIReferenceGrant): ResourceTypeGatewayAPIReferenceGrant,
 }
 
 // ToParts returns the component parts of the given UCP resource ID.
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecretName = "azure-azurecloud-default"
+)
+
+func Test_Bolt(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := boltstore.Open(filepath.Join(t.TempDir(), "secrets.db"))
+	require.NoError(t, err)
+
+	client, err := NewClient(db)
+	require.NoError(t, err)
+
+	testSecret, err := json.Marshal("test_secret")
+	require.NoError(t, err)
+	tests := []struct {
+		testName   string
+		secretName string
+		secret     []byte
+		response   []byte
+		save       bool
+		get        bool
+		delete     bool
+		err        error
+	}{
+		{"save-get-delete-secret-success", testSecretName, testSecret, []byte("test_secret"), true, true, true, nil},
+		{"save-secret-empty-name", "", testSecret, nil, true, false, false, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}},
+		{"save-secret-empty-secret", testSecretName, nil, nil, true, false, false, &secret.ErrInvalid{Message: "invalid argument. 'value' is required"}},
+		{"delete-secret-without-save", testSecretName, nil, nil, false, false, true, &secret.ErrNotFound{}},
+		{"get-secret-without-save", testSecretName, nil, nil, false, true, false, &secret.ErrNotFound{}},
+		{"get-secret-with-empty-name", "", nil, nil, false, true, false, &secret.ErrInvalid{Message: "invalid argument. 'name' is required"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if tt.save {
+				err := client.Save(ctx, tt.secretName, tt.secret)
+				if tt.err == nil {
+					require.NoError(t, err)
+				} else {
+					require.Equal(t, err, tt.err)
+				}
+			}
+			if tt.get {
+				response, err := client.Get(ctx, tt.secretName)
+				if tt.err == nil {
+					require.NoError(t, err)
+					value, err := strconv.Unquote(string(response))
+					require.NoError(t, err)
+					require.Equal(t, string(value), string(tt.response))
+				} else {
+					require.Equal(t, err, tt.err)
+				}
+			}
+			if tt.delete {
+				err := client.Delete(ctx, tt.secretName)
+				if tt.err == nil {
+					require.NoError(t, err)
+				} else {
+					require.Equal(t, err, tt.err)
+				}
+			}
+		})
+	}
+}
@@ -25,4 +25,7 @@ type SecretProviderOptions struct {
 
 	// ETCD configures options for the etcd secret store.
 	ETCD dataprovider.ETCDOptions `yaml:"etcd,omitempty"`
+
+	// Bolt configures options for the embedded bolt secret store.
+	Bolt dataprovider.BoltOptions `yaml:"bolt,omitempty"`
 }
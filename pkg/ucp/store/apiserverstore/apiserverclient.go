@@ -273,6 +273,7 @@ func (c *APIServerClient) Delete(ctx context.Context, id string, options ...stor
 }
 
 // Save saves an object to the store, or updates an existing object if it already exists, and returns an error if the operation fails.
+// If CreateOnly is set, ErrConcurrency is returned if the object already exists.
 func (c *APIServerClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
 	if ctx == nil {
 		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
@@ -313,7 +314,9 @@ func (c *APIServerClient) Save(ctx context.Context, obj *store.Object, options .
 		obj.ETag = converted.ETag
 
 		index := findIndex(&resource, id)
-		if index == nil && config.ETag != "" {
+		if index != nil && config.CreateOnly {
+			return false, &store.ErrConcurrency{}
+		} else if index == nil && config.ETag != "" && !config.CreateOnly {
 			// The ETag is only meaning for a replace/update operation not a create. We treat
 			// the absence of the resource as a match failure.
 			return false, &store.ErrConcurrency{}
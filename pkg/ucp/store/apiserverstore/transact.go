@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserverstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ store.Transactor = (*APIServerClient)(nil)
+
+// batch is the set of changes made by a transaction to a single Kubernetes object.
+type batch struct {
+	// resource is the Kubernetes object with the changes applied.
+	resource *ucpv1alpha1.Resource
+
+	// original is the Kubernetes object before the changes were applied, or nil if it did not exist.
+	original *ucpv1alpha1.Resource
+}
+
+// Transact commits the operations as a batch of writes to the Kubernetes objects that hold them.
+//
+// The Kubernetes API Server does not support transactions across objects. All of the operations that map to the same
+// Kubernetes object are applied in a single write, and every precondition is checked before anything is written. When
+// the operations span multiple Kubernetes objects and a later write fails, the objects that were already written are
+// restored to their original state before the error is returned.
+func (c *APIServerClient) Transact(ctx context.Context, ops []store.Op) error {
+	if err := store.ValidateOps(ctx, ops); err != nil {
+		return err
+	}
+
+	if len(ops) == 0 {
+		return nil
+	}
+
+	ids := make([]resources.ID, len(ops))
+	entries := make([]*ucpv1alpha1.ResourceEntry, len(ops))
+	for i, op := range ops {
+		parsed, err := resources.Parse(op.ResourceID())
+		if err != nil {
+			return err
+		}
+		ids[i] = parsed
+
+		if op.Type == store.OpSave {
+			converted, err := convert(op.Object)
+			if err != nil {
+				return err
+			}
+			entries[i] = converted
+		}
+	}
+
+	err := c.doWithRetry(ctx, func() (bool, error) {
+		batches := map[string]*batch{}
+		order := []string{}
+		for i, op := range ops {
+			name := resourceName(ids[i])
+			b, ok := batches[name]
+			if !ok {
+				var err error
+				b, err = c.readBatch(ctx, name)
+				if err != nil {
+					return false, err
+				}
+				batches[name] = b
+				order = append(order, name)
+			}
+
+			if err := applyOp(b.resource, ids[i], op, entries[i]); err != nil {
+				return false, err
+			}
+		}
+
+		c.synchronize()
+
+		written := []*batch{}
+		for _, name := range order {
+			b := batches[name]
+			err := c.writeBatch(ctx, b)
+			if err != nil {
+				if rbErr := c.restoreBatches(ctx, written); rbErr != nil {
+					return false, fmt.Errorf("failed to restore resources after transaction failure: %w", rbErr)
+				}
+
+				if apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err) {
+					return true, err // Retry this!
+				}
+				return false, err
+			}
+			written = append(written, b)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, op := range ops {
+		if op.Type == store.OpSave {
+			op.Object.ETag = entries[i].ETag
+		}
+	}
+
+	return nil
+}
+
+// readBatch reads the Kubernetes object with the given name so that changes can be applied to it.
+func (c *APIServerClient) readBatch(ctx context.Context, name string) (*batch, error) {
+	resource := ucpv1alpha1.Resource{}
+	err := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.namespace, Name: name}, &resource)
+	if err != nil && apierrors.IsNotFound(err) {
+		resource.Name = name
+		resource.Namespace = c.namespace
+		return &batch{resource: &resource}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &batch{resource: &resource, original: resource.DeepCopy()}, nil
+}
+
+// applyOp applies a single operation to the entries of a Kubernetes object in memory, checking its preconditions.
+func applyOp(resource *ucpv1alpha1.Resource, id resources.ID, op store.Op, entry *ucpv1alpha1.ResourceEntry) error {
+	index := findIndex(resource, id)
+	if index == nil && op.ETag != "" {
+		// The ETag is only meaningful for a replace/update/delete operation. We treat the absence of the
+		// resource as a match failure.
+		return &store.ErrConcurrency{}
+	} else if index != nil && op.ETag != "" && op.ETag != resource.Entries[*index].ETag {
+		return &store.ErrConcurrency{}
+	}
+
+	switch op.Type {
+	case store.OpSave:
+		if index == nil {
+			resource.Entries = append(resource.Entries, *entry)
+		} else {
+			resource.Entries[*index] = *entry
+		}
+	case store.OpDelete:
+		if index == nil {
+			return &store.ErrNotFound{ID: op.ID}
+		}
+		resource.Entries = append(resource.Entries[:*index], resource.Entries[*index+1:]...)
+	}
+
+	resource.Labels = assignLabels(resource)
+	return nil
+}
+
+// writeBatch writes the changes to a single Kubernetes object using optimistic concurrency.
+func (c *APIServerClient) writeBatch(ctx context.Context, b *batch) error {
+	switch {
+	case b.original == nil && len(b.resource.Entries) == 0:
+		// Nothing was created.
+		return nil
+	case b.original == nil:
+		return c.client.Create(ctx, b.resource)
+	case len(b.resource.Entries) == 0:
+		options := runtimeclient.DeleteOptions{
+			Preconditions: &v1.Preconditions{
+				UID:             &b.resource.UID,
+				ResourceVersion: &b.resource.ResourceVersion,
+			},
+		}
+		return c.client.Delete(ctx, b.resource, &options)
+	default:
+		return c.client.Update(ctx, b.resource)
+	}
+}
+
+// restoreBatches restores the Kubernetes objects that were written by a failed transaction to their original state.
+func (c *APIServerClient) restoreBatches(ctx context.Context, written []*batch) error {
+	for _, b := range written {
+		var err error
+		switch {
+		case b.original == nil && len(b.resource.Entries) == 0:
+			// Nothing was written.
+		case b.original == nil:
+			err = c.client.Delete(ctx, b.resource)
+		case len(b.resource.Entries) == 0:
+			restored := b.original.DeepCopy()
+			restored.ResourceVersion = ""
+			restored.UID = ""
+			err = c.client.Create(ctx, restored)
+		default:
+			b.resource.Entries = b.original.Entries
+			b.resource.Labels = b.original.Labels
+			err = c.client.Update(ctx, b.resource)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
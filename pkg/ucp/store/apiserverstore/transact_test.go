@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserverstore
+
+import (
+	"testing"
+
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/test/testcontext"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_APIServer_Transact_Fake(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, ucpv1alpha1.AddToScheme(scheme))
+
+	rc := fake.NewClientBuilder().WithScheme(scheme).Build()
+	client := NewAPIServerClient(rc, "radius-test")
+
+	clear := func(t *testing.T) {
+		err := rc.DeleteAllOf(ctx, &ucpv1alpha1.Resource{}, runtimeclient.InNamespace("radius-test"))
+		require.NoError(t, err)
+	}
+
+	// The fake client doesn't require envtest, so this covers Transact in environments where Test_APIServer_Client
+	// cannot run.
+	shared.RunTransactTest(t, client, clear)
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserverstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ store.Watcher = (*APIServerClient)(nil)
+
+// Watch streams the changes to objects that match the query using a Kubernetes watch on the Resource objects.
+// The resume token of each event is the Kubernetes resource version of the change.
+//
+// Since each Kubernetes object can hold multiple UCP resources, the client keeps track of the entries of each
+// object and reports the difference when the object changes. When a watch is resumed the previous entries are not
+// known, so the entries of a modified object are reported as updated.
+//
+// If the underlying client does not support watching then the client is polled instead.
+func (c *APIServerClient) Watch(ctx context.Context, query store.Query, options ...store.WatchOptions) (<-chan store.WatchEvent, error) {
+	if err := store.ValidateWatchQuery(ctx, query); err != nil {
+		return nil, err
+	}
+
+	watcher, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return store.NewPollingWatcher(c).Watch(ctx, query, options...)
+	}
+
+	selector, err := createLabelSelector(query)
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := []runtimeclient.ListOption{
+		runtimeclient.InNamespace(c.namespace),
+		runtimeclient.MatchingLabelsSelector{Selector: selector},
+	}
+
+	// known tracks the matching entries of each Kubernetes object so we can compute the changes.
+	known := map[string]map[string]store.Object{}
+
+	config := store.NewWatchConfig(options...)
+	resourceVersion := config.ResumeToken
+	if resourceVersion == "" {
+		// Take an initial snapshot so we know the existing entries, and start watching from that point.
+		rs := ucpv1alpha1.ResourceList{}
+		err = c.client.List(ctx, &rs, listOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range rs.Items {
+			entries, err := matchingEntries(&rs.Items[i], query)
+			if err != nil {
+				return nil, err
+			}
+			known[rs.Items[i].Name] = entries
+		}
+
+		resourceVersion = rs.ResourceVersion
+	}
+
+	listOptions = append(listOptions, &runtimeclient.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: resourceVersion}})
+	w, err := watcher.Watch(ctx, &ucpv1alpha1.ResourceList{}, listOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan store.WatchEvent)
+	go func() {
+		defer close(ch)
+		defer w.Stop()
+
+		for {
+			var event watch.Event
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-w.ResultChan():
+				if !ok {
+					store.SendWatchError(ctx, ch, fmt.Errorf("the watch was closed by the server"))
+					return
+				}
+				event = e
+			}
+
+			if event.Type == watch.Error {
+				store.SendWatchError(ctx, ch, apierrors.FromObject(event.Object))
+				return
+			}
+
+			resource, ok := event.Object.(*ucpv1alpha1.Resource)
+			if !ok {
+				continue
+			}
+
+			current := map[string]store.Object{}
+			if event.Type != watch.Deleted {
+				entries, err := matchingEntries(resource, query)
+				if err != nil {
+					store.SendWatchError(ctx, ch, err)
+					return
+				}
+				current = entries
+			}
+
+			previous, resumed := known[resource.Name], false
+			if previous == nil && event.Type == watch.Deleted {
+				// We don't know about this object (the watch was resumed), use the last state of the object instead.
+				entries, err := matchingEntries(resource, query)
+				if err != nil {
+					store.SendWatchError(ctx, ch, err)
+					return
+				}
+				previous = entries
+			} else if previous == nil && event.Type == watch.Modified {
+				resumed = true
+			}
+
+			for _, change := range diffEntries(previous, current, resumed, resource.ResourceVersion) {
+				if !store.SendWatchEvent(ctx, ch, change) {
+					return
+				}
+			}
+
+			if event.Type == watch.Deleted {
+				delete(known, resource.Name)
+			} else {
+				known[resource.Name] = current
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// matchingEntries returns the entries of the resource that match the query keyed by their case-insensitive id.
+func matchingEntries(resource *ucpv1alpha1.Resource, query store.Query) (map[string]store.Object, error) {
+	results := map[string]store.Object{}
+	for i := range resource.Entries {
+		id, err := resources.Parse(resource.Entries[i].ID)
+		if err != nil || !storeutil.IDMatchesQuery(id, query) {
+			continue
+		}
+
+		obj, err := readEntry(&resource.Entries[i])
+		if err != nil {
+			return nil, err
+		}
+
+		match, err := obj.MatchesFilters(query.Filters)
+		if err != nil {
+			return nil, err
+		} else if !match {
+			continue
+		}
+
+		results[strings.ToLower(obj.ID)] = *obj
+	}
+
+	return results, nil
+}
+
+// diffEntries returns the events for the change between the previous and current entries of a Kubernetes object.
+func diffEntries(previous map[string]store.Object, current map[string]store.Object, resumed bool, resourceVersion string) []store.WatchEvent {
+	events := []store.WatchEvent{}
+	for _, key := range sortedKeys(current) {
+		obj := current[key]
+		old, ok := previous[key]
+		if !ok && !resumed {
+			events = append(events, store.WatchEvent{Type: store.WatchEventCreated, Object: obj, ResumeToken: resourceVersion})
+		} else if !ok || old.ETag != obj.ETag {
+			events = append(events, store.WatchEvent{Type: store.WatchEventUpdated, Object: obj, ResumeToken: resourceVersion})
+		}
+	}
+
+	for _, key := range sortedKeys(previous) {
+		if _, ok := current[key]; !ok {
+			events = append(events, store.WatchEvent{Type: store.WatchEventDeleted, Object: previous[key], ResumeToken: resourceVersion})
+		}
+	}
+
+	return events
+}
+
+func sortedKeys(objects map[string]store.Object) []string {
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
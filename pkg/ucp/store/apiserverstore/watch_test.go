@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserverstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/test/testcontext"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func receive(t *testing.T, events <-chan store.WatchEvent) store.WatchEvent {
+	select {
+	case event := <-events:
+		require.NoError(t, event.Err)
+		return event
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "timed out waiting for watch event")
+		return store.WatchEvent{}
+	}
+}
+
+func Test_APIServer_Watch_Fake(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, ucpv1alpha1.AddToScheme(scheme))
+
+	rc := fake.NewClientBuilder().WithScheme(scheme).Build()
+	client := NewAPIServerClient(rc, "radius-test")
+
+	events, err := client.Watch(ctx, store.Query{RootScope: shared.ResourceGroup1Scope})
+	require.NoError(t, err)
+
+	obj := store.Object{Metadata: store.Metadata{ID: shared.Resource1ID.String()}, Data: shared.Data1}
+	err = client.Save(ctx, &obj)
+	require.NoError(t, err)
+
+	event := receive(t, events)
+	require.Equal(t, store.WatchEventCreated, event.Type)
+	require.Equal(t, shared.Resource1ID.String(), event.Object.ID)
+	require.NotEmpty(t, event.ResumeToken)
+
+	obj.Data = shared.Data2
+	err = client.Save(ctx, &obj)
+	require.NoError(t, err)
+
+	event = receive(t, events)
+	require.Equal(t, store.WatchEventUpdated, event.Type)
+	require.Equal(t, shared.Data2["value"], event.Object.Data.(map[string]any)["value"])
+
+	err = client.Delete(ctx, shared.Resource1ID.String())
+	require.NoError(t, err)
+
+	event = receive(t, events)
+	require.Equal(t, store.WatchEventDeleted, event.Type)
+	require.Equal(t, shared.Resource1ID.String(), event.Object.ID)
+}
+
+func Test_DiffEntries(t *testing.T) {
+	obj1 := store.Object{Metadata: store.Metadata{ID: "a", ETag: "1"}}
+	obj1Updated := store.Object{Metadata: store.Metadata{ID: "a", ETag: "2"}}
+	obj2 := store.Object{Metadata: store.Metadata{ID: "b", ETag: "1"}}
+
+	events := diffEntries(map[string]store.Object{"a": obj1, "b": obj2}, map[string]store.Object{"a": obj1Updated}, false, "10")
+	require.Equal(t, []store.WatchEvent{
+		{Type: store.WatchEventUpdated, Object: obj1Updated, ResumeToken: "10"},
+		{Type: store.WatchEventDeleted, Object: obj2, ResumeToken: "10"},
+	}, events)
+
+	events = diffEntries(nil, map[string]store.Object{"a": obj1}, false, "11")
+	require.Equal(t, []store.WatchEvent{{Type: store.WatchEventCreated, Object: obj1, ResumeToken: "11"}}, events)
+
+	// When resuming we don't know whether the entry existed before.
+	events = diffEntries(nil, map[string]store.Object{"a": obj1}, true, "12")
+	require.Equal(t, []store.WatchEvent{{Type: store.WatchEventUpdated, Object: obj1, ResumeToken: "12"}}, events)
+
+	events = diffEntries(map[string]store.Object{"a": obj1}, map[string]store.Object{"a": obj1}, false, "13")
+	require.Empty(t, events)
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package boltstore stores resources in an embedded bolt database file. This allows UCP and the
+// resource providers to run as plain processes without a Kubernetes API server or etcd, which is
+// useful for local development and test harnesses. It is not suitable for production use.
+//
+// The key scheme is the same as the etcd store: keys are built from the storage parts of the
+// resource id using '|' as a separator, and bolt keeps keys sorted so that prefix scans can be
+// used for queries.
+//
+//	scope|/planes/radius/local/|/resourcegroups/cool-group/
+//	resource|/planes/radius/local/resourcegroups/cool-group/|/applications.core/applications/cool-app/
+//
+// ETags are derived from the sequence of the resources bucket, which is advanced on every write.
+package boltstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// SectionSeparator is the separator used between the sections of a storage key.
+	SectionSeparator = "|"
+)
+
+var (
+	// ResourcesBucket is the name of the bucket used to store resources.
+	ResourcesBucket = []byte("resources")
+)
+
+// entry is the value stored for each key in the resources bucket.
+type entry struct {
+	Revision int64           `json:"revision"`
+	Object   json.RawMessage `json:"object"`
+}
+
+// NewBoltClient creates a new BoltClient instance with the given database, creating the resources bucket
+// if it does not already exist.
+func NewBoltClient(db *bbolt.DB) (*BoltClient, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(ResourcesBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoltClient{db: db}, nil
+}
+
+var _ store.StorageClient = (*BoltClient)(nil)
+
+// BoltClient implements store.StorageClient using an embedded bolt database.
+type BoltClient struct {
+	db *bbolt.DB
+}
+
+// Query retrieves objects from the store that match the given query and filters, and returns them in a store.ObjectQueryResult.
+// When MaxQueryItemCount is specified the result will contain at most that many items and a pagination token that can be used
+// to retrieve the next page.
+func (c *BoltClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	config := store.NewQueryConfig(options...)
+
+	var after []byte
+	if config.PaginationToken != "" {
+		b, err := base64.StdEncoding.DecodeString(config.PaginationToken)
+		if err != nil {
+			return nil, &store.ErrInvalid{Message: "invalid argument. 'PaginationToken' is invalid"}
+		}
+		after = b
+	}
+
+	prefix := []byte(keyFromQuery(query))
+	results := store.ObjectQueryResult{}
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(ResourcesBucket).Cursor()
+
+		start := prefix
+		if after != nil {
+			start = after
+		}
+
+		var last []byte
+		for k, v := cursor.Seek(start); k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+			if after != nil && bytes.Equal(k, after) {
+				continue
+			}
+
+			value, revision, err := decode(v)
+			if err != nil {
+				return err
+			}
+
+			// A recursive query or a routing scope prefix can match keys that belong to a different
+			// scope, so we need to filter on the resource id as well as the key.
+			id, err := resources.Parse(value.ID)
+			if err != nil || !storeutil.IDMatchesQuery(id, query) {
+				continue
+			}
+
+			match, err := value.MatchesFilters(query.Filters)
+			if err != nil {
+				return err
+			} else if !match {
+				continue
+			}
+
+			// We have found a matching item beyond the requested page size, so there is at least one more page.
+			if config.MaxQueryItemCount > 0 && len(results.Items) == config.MaxQueryItemCount {
+				results.PaginationToken = base64.StdEncoding.EncodeToString(last)
+				break
+			}
+
+			value.ETag = etag.NewFromRevision(revision)
+			results.Items = append(results.Items, *value)
+
+			// k is only valid for the life of the transaction, so copy it.
+			last = append([]byte{}, k...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+// Get checks if the provided context, id and options are valid, then retrieves the corresponding object from
+// the store and returns it, or an error if the object is not found or an error occurs.
+func (c *BoltClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var value *store.Object
+	err = c.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(ResourcesBucket).Get([]byte(keyFromID(parsed)))
+		if v == nil {
+			return &store.ErrNotFound{ID: id}
+		}
+
+		obj, revision, err := decode(v)
+		if err != nil {
+			return err
+		}
+
+		obj.ETag = etag.NewFromRevision(revision)
+		value = obj
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Delete checks if the given resource ID is valid, and if so, deletes it from the store, returning an error if the
+// resource does not exist or if an ETag is provided and does not match.
+func (c *BoltClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	key := []byte(keyFromID(parsed))
+	config := store.NewDeleteConfig(options...)
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(ResourcesBucket)
+		v := bucket.Get(key)
+
+		if config.ETag != "" {
+			if err := checkETag(v, config.ETag); err != nil {
+				return err
+			}
+		} else if v == nil {
+			return &store.ErrNotFound{ID: id}
+		}
+
+		return bucket.Delete(key)
+	})
+}
+
+// Save checks the context and object parameters, parses the object ID, marshals the object into JSON, saves the object to
+// the store, and sets the object's ETag. If an ETag is provided, the write only succeeds when the stored revision matches.
+// If CreateOnly is set, the write only succeeds when the object does not exist.
+func (c *BoltClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	parsed, err := resources.Parse(obj.Metadata.ID)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	key := []byte(keyFromID(parsed))
+	config := store.NewSaveConfig(options...)
+
+	var revision int64
+	err = c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(ResourcesBucket)
+
+		if config.CreateOnly {
+			if bucket.Get(key) != nil {
+				return &store.ErrConcurrency{}
+			}
+		} else if config.ETag != "" {
+			if err := checkETag(bucket.Get(key), config.ETag); err != nil {
+				return err
+			}
+		}
+
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		revision = int64(seq)
+
+		value, err := json.Marshal(entry{Revision: revision, Object: b})
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = etag.NewFromRevision(revision)
+	return nil
+}
+
+// checkETag returns ErrConcurrency if the stored value does not match the given ETag. A missing value never matches.
+func checkETag(v []byte, expected store.ETag) error {
+	revision, err := etag.ParseRevision(expected)
+	if err != nil {
+		// Treat an invalid ETag as a concurrency failure, since it will never match.
+		return &store.ErrConcurrency{}
+	}
+
+	if v == nil {
+		return &store.ErrConcurrency{}
+	}
+
+	e := entry{}
+	if err := json.Unmarshal(v, &e); err != nil {
+		return err
+	}
+
+	if e.Revision != revision {
+		return &store.ErrConcurrency{}
+	}
+
+	return nil
+}
+
+func decode(v []byte) (*store.Object, int64, error) {
+	e := entry{}
+	if err := json.Unmarshal(v, &e); err != nil {
+		return nil, 0, err
+	}
+
+	value := store.Object{}
+	if err := json.Unmarshal(e.Object, &value); err != nil {
+		return nil, 0, err
+	}
+
+	return &value, e.Revision, nil
+}
+
+func parseID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// keyFromID returns the key to use for an ID. They key should be used as an exact match.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+}
+
+// keyFromQuery returns the key to use for an for executing a query. The key should be used as a prefix.
+func keyFromQuery(query store.Query) string {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	if query.ScopeRecursive {
+		return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope)
+	}
+
+	return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope) + SectionSeparator + storeutil.NormalizePart(query.RoutingScopePrefix)
+}
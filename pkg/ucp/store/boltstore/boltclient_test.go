@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+)
+
+func Test_BoltClient(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	db, err := Open(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+
+	client, err := NewBoltClient(db)
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		err := db.Update(func(tx *bbolt.Tx) error {
+			if err := tx.DeleteBucket(ResourcesBucket); err != nil {
+				return err
+			}
+			_, err := tx.CreateBucket(ResourcesBucket)
+			return err
+		})
+		require.NoError(t, err)
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	shared.RunTest(t, client, clear)
+	shared.RunWatchTest(t, client, clear)
+	shared.RunTransactTest(t, client, clear)
+
+	t.Run("query_with_pagination", func(t *testing.T) {
+		clear(t)
+
+		for _, id := range []string{shared.Resource1ID.String(), shared.NestedResource1ID.String()} {
+			obj := store.Object{Metadata: store.Metadata{ID: id}, Data: shared.Data1}
+			err := client.Save(ctx, &obj)
+			require.NoError(t, err)
+		}
+
+		query := store.Query{RootScope: shared.ResourceGroup1Scope}
+		page1, err := client.Query(ctx, query, store.WithMaxQueryItemCount(1))
+		require.NoError(t, err)
+		require.Len(t, page1.Items, 1)
+		require.NotEmpty(t, page1.PaginationToken)
+
+		page2, err := client.Query(ctx, query, store.WithMaxQueryItemCount(1), store.WithPaginationToken(page1.PaginationToken))
+		require.NoError(t, err)
+		require.Len(t, page2.Items, 1)
+		require.Empty(t, page2.PaginationToken)
+		require.NotEqual(t, page1.Items[0].ID, page2.Items[0].ID)
+	})
+
+	t.Run("open_shares_database", func(t *testing.T) {
+		other, err := Open(db.Path())
+		require.NoError(t, err)
+		require.Same(t, db, other)
+	})
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// openTimeout is the time to wait for the file lock on the database. Another process holding the
+	// database open will cause Open to fail after this duration rather than block forever.
+	openTimeout = 5 * time.Second
+)
+
+var (
+	databases   = map[string]*bbolt.DB{}
+	databasesMu sync.Mutex
+)
+
+// Open opens (or creates) the bolt database file at the given path.
+//
+// bolt only allows a single handle per file, so the storage, queue and secret providers share the handle
+// for a path within the process. The returned database is never closed before the process exits.
+func Open(path string) (*bbolt.DB, error) {
+	if path == "" {
+		return nil, fmt.Errorf("failed to open bolt database: path is required")
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt database: %w", err)
+	}
+
+	databasesMu.Lock()
+	defer databasesMu.Unlock()
+
+	if db, ok := databases[abs]; ok {
+		return db, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(abs), 0700); err != nil {
+		return nil, fmt.Errorf("failed to open bolt database: %w", err)
+	}
+
+	db, err := bbolt.Open(abs, 0600, &bbolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt database %q: %w", abs, err)
+	}
+
+	databases[abs] = db
+	return db, nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	"go.etcd.io/bbolt"
+)
+
+var _ store.Transactor = (*BoltClient)(nil)
+
+// Transact commits the operations in a single bolt transaction. All of the saved objects share the same revision.
+func (c *BoltClient) Transact(ctx context.Context, ops []store.Op) error {
+	if err := store.ValidateOps(ctx, ops); err != nil {
+		return err
+	}
+
+	if len(ops) == 0 {
+		return nil
+	}
+
+	keys := make([][]byte, len(ops))
+	values := make([][]byte, len(ops))
+	for i, op := range ops {
+		parsed, err := resources.Parse(op.ResourceID())
+		if err != nil {
+			return err
+		}
+		keys[i] = []byte(keyFromID(parsed))
+
+		if op.Type == store.OpSave {
+			b, err := json.Marshal(op.Object)
+			if err != nil {
+				return err
+			}
+			values[i] = b
+		}
+	}
+
+	var revision int64
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(ResourcesBucket)
+
+		// Check all of the preconditions before writing anything. Returning an error rolls back the transaction
+		// anyway, but this keeps the sequence from advancing for a transaction that fails.
+		for i, op := range ops {
+			v := bucket.Get(keys[i])
+			if op.ETag != "" {
+				if err := checkETag(v, op.ETag); err != nil {
+					return err
+				}
+			} else if op.Type == store.OpDelete && v == nil {
+				return &store.ErrNotFound{ID: op.ID}
+			}
+		}
+
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		revision = int64(seq)
+
+		for i, op := range ops {
+			if op.Type == store.OpDelete {
+				if err := bucket.Delete(keys[i]); err != nil {
+					return err
+				}
+				continue
+			}
+
+			value, err := json.Marshal(entry{Revision: revision, Object: values[i]})
+			if err != nil {
+				return err
+			}
+
+			if err := bucket.Put(keys[i], value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		if op.Type == store.OpSave {
+			op.Object.ETag = etag.NewFromRevision(revision)
+		}
+	}
+
+	return nil
+}
@@ -93,8 +93,8 @@ func NewCosmosDBStorageClient(options *ConnectionOptions) (*CosmosDBStorageClien
 	}, nil
 }
 
-// Init checks if the database and collection exist, and if not, creates them. It returns an error if
-// either of the checks or creations fail.
+// Init checks if the database and collection exist, and if not, creates them. It also installs the stored procedures
+// used by Transact. It returns an error if any of the checks or creations fail.
 func (c *CosmosDBStorageClient) Init(ctx context.Context) error {
 	if err := c.createDatabaseIfNotExists(ctx); err != nil {
 		return err
@@ -102,6 +102,9 @@ func (c *CosmosDBStorageClient) Init(ctx context.Context) error {
 	if err := c.createCollectionIfNotExists(ctx); err != nil {
 		return err
 	}
+	if err := c.createStoredProcedures(ctx); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -377,6 +380,7 @@ func (c *CosmosDBStorageClient) Delete(ctx context.Context, id string, opts ...s
 
 // Save saves an object to the CosmosDB storage, returning an error if one occurs. If an ETag is provided, an error is
 // returned if the ETag does not match the existing ETag.
+// If CreateOnly is set, an error is returned if the object already exists.
 func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
 	if ctx == nil {
 		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
@@ -387,28 +391,11 @@ func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opt
 
 	cfg := store.NewSaveConfig(opts...)
 
-	parsed, err := resources.Parse(obj.ID)
-	if err != nil {
-		return err
-	}
-
-	docID, err := GenerateCosmosDBKey(parsed)
-	if err != nil {
-		return err
-	}
-
-	partitionKey, err := GetPartitionKey(parsed)
+	entity, err := newResourceEntity(obj)
 	if err != nil {
 		return err
 	}
-
-	entity := &ResourceEntity{
-		ID:           docID,
-		ResourceID:   strings.ToLower(parsed.String()),
-		RootScope:    strings.ToLower(parsed.RootScope()),
-		PartitionKey: partitionKey,
-		Entity:       obj.Data,
-	}
+	partitionKey := entity.PartitionKey
 
 	ifMatch := cfg.ETag
 	if ifMatch == "" && obj.ETag != "" {
@@ -416,7 +403,15 @@ func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opt
 	}
 
 	var resp *cosmosapi.Resource
-	if ifMatch == "" {
+	if cfg.CreateOnly {
+		op := cosmosapi.CreateDocumentOptions{
+			PartitionKeyValue: partitionKey,
+		}
+		resp, _, err = c.client.CreateDocument(ctx, c.options.DatabaseName, c.options.CollectionName, entity, op)
+		if err != nil && strings.EqualFold(err.Error(), errIDConflictMsg) {
+			return &store.ErrConcurrency{}
+		}
+	} else if ifMatch == "" {
 		op := cosmosapi.CreateDocumentOptions{
 			PartitionKeyValue: partitionKey,
 			IsUpsert:          true,
@@ -444,6 +439,32 @@ func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opt
 	return nil
 }
 
+// newResourceEntity creates the document to store for the object.
+func newResourceEntity(obj *store.Object) (*ResourceEntity, error) {
+	parsed, err := resources.Parse(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	docID, err := GenerateCosmosDBKey(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	partitionKey, err := GetPartitionKey(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceEntity{
+		ID:           docID,
+		ResourceID:   strings.ToLower(parsed.String()),
+		RootScope:    strings.ToLower(parsed.RootScope()),
+		PartitionKey: partitionKey,
+		Entity:       obj.Data,
+	}, nil
+}
+
 // GetPartitionKey returns a partition key based on the given ID, normalizing the subscription ID and normalizing the
 // plane namespace if the ID is UCP-qualified.
 // Examples:
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cosmosdb
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/vippsas/go-cosmosdb/cosmosapi"
+)
+
+const (
+	// transactSprocName is the name of the stored procedure used to execute transactions.
+	transactSprocName = "ucpTransact"
+
+	// transactSproc applies the operations in order. CosmosDB runs a stored procedure in a transaction scoped to a
+	// single partition key, and an exception thrown by the stored procedure rolls back all of its writes.
+	transactSproc = `function ucpTransact(ops) {
+    var collection = getContext().getCollection();
+    var etags = [];
+
+    function next(i) {
+        if (i >= ops.length) {
+            getContext().getResponse().setBody(etags);
+            return;
+        }
+
+        var op = ops[i];
+        var link = collection.getAltLink() + "/docs/" + op.id;
+        var options = op.etag ? { etag: op.etag } : {};
+        var callback = function (err, resource) {
+            if (err && (err.number === 412 || (err.number === 404 && op.etag))) {
+                throw new Error("UCPTransactConflict");
+            } else if (err && err.number === 404) {
+                throw new Error("UCPTransactNotFound[" + i + "]");
+            } else if (err) {
+                throw err;
+            }
+
+            etags.push(resource ? resource._etag : "");
+            next(i + 1);
+        };
+
+        var accepted;
+        if (op.type === "Delete") {
+            accepted = collection.deleteDocument(link, options, callback);
+        } else if (op.etag) {
+            accepted = collection.replaceDocument(link, op.document, options, callback);
+        } else {
+            accepted = collection.upsertDocument(collection.getSelfLink(), op.document, callback);
+        }
+
+        if (!accepted) {
+            throw new Error("UCPTransactNotAccepted");
+        }
+    }
+
+    next(0);
+}`
+)
+
+var errTransactNotFound = regexp.MustCompile(`UCPTransactNotFound\[(\d+)\]`)
+
+var _ store.Transactor = (*CosmosDBStorageClient)(nil)
+
+// transactOp is the representation of a store.Op passed to the stored procedure.
+type transactOp struct {
+	Type     store.OpType    `json:"type"`
+	ID       string          `json:"id"`
+	ETag     string          `json:"etag,omitempty"`
+	Document *ResourceEntity `json:"document,omitempty"`
+}
+
+// Transact commits the operations as a single CosmosDB stored procedure execution, so either all of the writes are
+// applied or none of them are.
+//
+// CosmosDB transactions are scoped to a single partition of a single collection. All of the operations must have the
+// same partition key, and all of the documents are written to the collection of this client regardless of their
+// resource type.
+func (c *CosmosDBStorageClient) Transact(ctx context.Context, ops []store.Op) error {
+	if err := store.ValidateOps(ctx, ops); err != nil {
+		return err
+	}
+
+	if len(ops) == 0 {
+		return nil
+	}
+
+	partitionKey := ""
+	args := make([]transactOp, len(ops))
+	for i, op := range ops {
+		parsed, err := resources.Parse(op.ResourceID())
+		if err != nil {
+			return err
+		}
+
+		pk, err := GetPartitionKey(parsed)
+		if err != nil {
+			return err
+		}
+
+		if i == 0 {
+			partitionKey = pk
+		} else if pk != partitionKey {
+			return &store.ErrInvalid{Message: "invalid argument. all operations in a transaction must have the same partition key"}
+		}
+
+		docID, err := GenerateCosmosDBKey(parsed)
+		if err != nil {
+			return err
+		}
+
+		args[i] = transactOp{Type: op.Type, ID: docID, ETag: op.ETag}
+		if op.Type == store.OpSave {
+			args[i].Document, err = newResourceEntity(op.Object)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	etags := []string{}
+	options := cosmosapi.ExecuteStoredProcedureOptions{PartitionKeyValue: partitionKey}
+	err := c.client.ExecuteStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, transactSprocName, options, &etags, args)
+
+	// TODO: use the response code when switching to official SDK.
+	if err != nil && strings.Contains(err.Error(), "UCPTransactConflict") {
+		return &store.ErrConcurrency{}
+	} else if err != nil {
+		if match := errTransactNotFound.FindStringSubmatch(err.Error()); match != nil {
+			if i, convErr := strconv.Atoi(match[1]); convErr == nil && i < len(ops) {
+				return &store.ErrNotFound{ID: ops[i].ID}
+			}
+		}
+		return err
+	}
+
+	for i, op := range ops {
+		if op.Type == store.OpSave && i < len(etags) {
+			op.Object.ETag = etags[i]
+		}
+	}
+
+	return nil
+}
+
+// createStoredProcedures installs the stored procedures used by the client, replacing them if they already exist so
+// that changes to their implementation are picked up.
+func (c *CosmosDBStorageClient) createStoredProcedures(ctx context.Context) error {
+	_, err := c.client.CreateStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, transactSprocName, transactSproc)
+	if err != nil && strings.EqualFold(err.Error(), errIDConflictMsg) {
+		_, err = c.client.ReplaceStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, transactSprocName, transactSproc)
+	}
+
+	return err
+}
@@ -220,7 +220,8 @@ func (c *ETCDClient) Delete(ctx context.Context, id string, options ...store.Del
 }
 
 // Save checks the context and object parameters, parses the object ID, marshals the object into JSON, saves the object to
-// the store, and sets the object's ETag. If an ETag is provided, a transaction is executed to ensure concurrency.
+// the store, and sets the object's ETag. If an ETag is provided or CreateOnly is set, a transaction is executed to ensure
+// concurrency.
 func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
 	if ctx == nil {
 		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
@@ -243,6 +244,25 @@ func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...sto
 	key := keyFromID(parsed)
 	config := store.NewSaveConfig(options...)
 
+	// If the object must not exist then we execute a transaction that requires the key to not exist.
+	if config.CreateOnly {
+		txn, err := c.client.Txn(ctx).
+			If(etcdclient.Compare(etcdclient.CreateRevision(key), "=", 0)).
+			Then(etcdclient.OpPut(key, string(b))).
+			Commit()
+		if err != nil {
+			return err
+		}
+
+		if !txn.Succeeded {
+			return &store.ErrConcurrency{}
+		}
+
+		response := txn.Responses[0].GetResponsePut()
+		obj.ETag = etag.NewFromRevision(response.Header.Revision)
+		return nil
+	}
+
 	// If we have an ETag then we do to execute a transaction.
 	if config.ETag != "" {
 		revision, err := etag.ParseRevision(config.ETag)
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdstore
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	etcdclient "go.etcd.io/etcd/client/v3"
+)
+
+var _ store.Transactor = (*ETCDClient)(nil)
+
+// Transact commits the operations in a single etcd transaction. Every ETag and the existence of every object to delete
+// is checked as part of the transaction, so either all of the writes are applied at the same revision or none of them are.
+func (c *ETCDClient) Transact(ctx context.Context, ops []store.Op) error {
+	if err := store.ValidateOps(ctx, ops); err != nil {
+		return err
+	}
+
+	if len(ops) == 0 {
+		return nil
+	}
+
+	compares := []etcdclient.Cmp{}
+	then := []etcdclient.Op{}
+	orElse := []etcdclient.Op{}
+	for _, op := range ops {
+		parsed, err := resources.Parse(op.ResourceID())
+		if err != nil {
+			return err
+		}
+
+		key := keyFromID(parsed)
+
+		if op.ETag != "" {
+			revision, err := etag.ParseRevision(op.ETag)
+			if err != nil {
+				// Treat an invalid ETag as a concurrency failure, since it will never match.
+				return &store.ErrConcurrency{}
+			}
+			compares = append(compares, etcdclient.Compare(etcdclient.ModRevision(key), "=", revision))
+		} else if op.Type == store.OpDelete {
+			// A delete without an ETag still requires the object to exist.
+			compares = append(compares, etcdclient.Compare(etcdclient.CreateRevision(key), ">", 0))
+		}
+
+		switch op.Type {
+		case store.OpSave:
+			b, err := json.Marshal(op.Object)
+			if err != nil {
+				return err
+			}
+			then = append(then, etcdclient.OpPut(key, string(b)))
+		case store.OpDelete:
+			then = append(then, etcdclient.OpDelete(key))
+		}
+
+		// When the transaction fails we read the current state of each key so we can report the right error.
+		orElse = append(orElse, etcdclient.OpGet(key, etcdclient.WithCountOnly()))
+	}
+
+	txn, err := c.client.Txn(ctx).If(compares...).Then(then...).Else(orElse...).Commit()
+	if err != nil {
+		return err
+	}
+
+	if !txn.Succeeded {
+		for i, op := range ops {
+			response := txn.Responses[i].GetResponseRange()
+			if op.Type == store.OpDelete && op.ETag == "" && response.Count == 0 {
+				return &store.ErrNotFound{ID: op.ID}
+			}
+		}
+
+		return &store.ErrConcurrency{}
+	}
+
+	for _, op := range ops {
+		if op.Type == store.OpSave {
+			op.Object.ETag = etag.NewFromRevision(txn.Header.Revision)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdstore
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	etcdclient "go.etcd.io/etcd/client/v3"
+)
+
+var _ store.Watcher = (*ETCDClient)(nil)
+
+// Watch streams the changes to objects that match the query using a native etcd watch. The resume token of each
+// event is the etcd revision of the change, so a watch can be resumed as long as the revision has not been compacted.
+func (c *ETCDClient) Watch(ctx context.Context, query store.Query, options ...store.WatchOptions) (<-chan store.WatchEvent, error) {
+	if err := store.ValidateWatchQuery(ctx, query); err != nil {
+		return nil, err
+	}
+
+	key := keyFromQuery(query)
+	config := store.NewWatchConfig(options...)
+
+	var revision int64
+	if config.ResumeToken != "" {
+		var err error
+		revision, err = strconv.ParseInt(config.ResumeToken, 10, 64)
+		if err != nil {
+			return nil, &store.ErrInvalid{Message: "invalid argument. 'ResumeToken' is invalid"}
+		}
+	} else {
+		// Pin the start of the watch to the current revision so that changes made after Watch returns
+		// can't be missed while the watch is being established.
+		response, err := c.client.Get(ctx, key, etcdclient.WithPrefix(), etcdclient.WithCountOnly())
+		if err != nil {
+			return nil, err
+		}
+		revision = response.Header.Revision
+	}
+
+	watchChan := c.client.Watch(ctx, key, etcdclient.WithPrefix(), etcdclient.WithPrevKV(), etcdclient.WithRev(revision+1))
+
+	ch := make(chan store.WatchEvent)
+	go func() {
+		defer close(ch)
+
+		for response := range watchChan {
+			if err := response.Err(); err != nil {
+				store.SendWatchError(ctx, ch, err)
+				return
+			}
+
+			for _, event := range response.Events {
+				if !keyMatchesQuery(event.Kv.Key, query) {
+					continue
+				}
+
+				converted, err := convertEvent(event)
+				if err != nil {
+					store.SendWatchError(ctx, ch, err)
+					return
+				}
+
+				match, err := converted.Object.MatchesFilters(query.Filters)
+				if err != nil {
+					store.SendWatchError(ctx, ch, err)
+					return
+				} else if !match {
+					continue
+				}
+
+				if !store.SendWatchEvent(ctx, ch, *converted) {
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func convertEvent(event *etcdclient.Event) (*store.WatchEvent, error) {
+	result := store.WatchEvent{
+		ResumeToken: strconv.FormatInt(event.Kv.ModRevision, 10),
+	}
+
+	value := event.Kv.Value
+	switch {
+	case event.Type == etcdclient.EventTypeDelete:
+		result.Type = store.WatchEventDeleted
+		value = nil
+		if event.PrevKv != nil {
+			value = event.PrevKv.Value
+		}
+	case event.IsCreate():
+		result.Type = store.WatchEventCreated
+	default:
+		result.Type = store.WatchEventUpdated
+	}
+
+	if value == nil {
+		// The previous value is not available (eg: it has been compacted), so we can only report the id.
+		id, err := idFromKey(event.Kv.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Object.ID = id.String()
+		return &result, nil
+	}
+
+	if err := json.Unmarshal(value, &result.Object); err != nil {
+		return nil, err
+	}
+
+	if result.Type == store.WatchEventDeleted {
+		result.Object.ETag = etag.NewFromRevision(event.PrevKv.ModRevision)
+	} else {
+		result.Object.ETag = etag.NewFromRevision(event.Kv.ModRevision)
+	}
+
+	return &result, nil
+}
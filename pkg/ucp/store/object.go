@@ -18,6 +18,7 @@ package store
 
 import (
 	"context"
+	"time"
 )
 
 type ETag = string
@@ -36,6 +37,17 @@ type Object struct {
 	Data any
 }
 
+// tombstone is the part of the data of a soft-deleted resource which marks it as deleted.
+type tombstone struct {
+	DeletedTime *time.Time `json:"deletedTime,omitempty"`
+}
+
+// IsDeleted returns true if the object is the tombstone of a soft-deleted resource.
+func (o *Object) IsDeleted() bool {
+	t := &tombstone{}
+	return o.As(t) == nil && t.DeletedTime != nil
+}
+
 // ObjectQueryResult represents the result of Query().
 type ObjectQueryResult struct {
 	// PaginationToken represents the token for pagination, such as continuation token.
@@ -49,11 +61,27 @@ func (o *Object) As(out any) error {
 	return DecodeMap(o.Data, out)
 }
 
-// GetResource gets the resource data from StorageClient for id.
+// GetLiveObject gets the object for id from StorageClient. The tombstone of a soft-deleted resource is reported as
+// ErrNotFound, so that readers do not load a deleted resource as a live one.
+func GetLiveObject(ctx context.Context, client StorageClient, id string) (*Object, error) {
+	obj, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.IsDeleted() {
+		return nil, &ErrNotFound{ID: id}
+	}
+
+	return obj, nil
+}
+
+// GetResource gets the resource data from StorageClient for id. The tombstone of a soft-deleted resource is reported
+// as ErrNotFound.
 func GetResource[T any](ctx context.Context, client StorageClient, id string) (*T, error) {
 	var out T
 
-	obj, err := client.Get(ctx, id)
+	obj, err := GetLiveObject(ctx, client, id)
 	if err != nil {
 		return nil, err
 	}
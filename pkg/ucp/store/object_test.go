@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const testObjectID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+
+func TestGetLiveObject(t *testing.T) {
+	tests := []struct {
+		desc   string
+		data   map[string]any
+		getErr error
+		err    error
+	}{
+		{
+			desc: "live resource",
+			data: map[string]any{"id": testObjectID},
+		},
+		{
+			desc: "tombstone",
+			data: map[string]any{"id": testObjectID, "deletedTime": time.Now().UTC().Format(time.RFC3339Nano)},
+			err:  &ErrNotFound{ID: testObjectID},
+		},
+		{
+			desc:   "not found",
+			getErr: &ErrNotFound{ID: testObjectID},
+			err:    &ErrNotFound{ID: testObjectID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			client := NewMockStorageClient(gomock.NewController(t))
+			if tt.getErr != nil {
+				client.EXPECT().Get(gomock.Any(), testObjectID).Return(nil, tt.getErr).Times(1)
+			} else {
+				client.EXPECT().Get(gomock.Any(), testObjectID).Return(&Object{Metadata: Metadata{ID: testObjectID}, Data: tt.data}, nil).Times(1)
+			}
+
+			obj, err := GetLiveObject(context.Background(), client, testObjectID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.Nil(t, obj)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testObjectID, obj.ID)
+		})
+	}
+}
@@ -16,6 +16,8 @@ limitations under the License.
 
 package store
 
+import "time"
+
 type (
 	// QueryOptions applies an option to Query().
 	QueryOptions interface {
@@ -60,6 +62,16 @@ type (
 		SaveOptions
 		DeleteOptions
 	}
+
+	// WatchOptions applies an option to Watch().
+	WatchOptions interface {
+		ApplyWatchOption(StoreConfig) StoreConfig
+
+		// A private method to prevent users implementing the
+		// interface and so future additions to it will not
+		// violate compatibility.
+		private()
+	}
 )
 
 // Store Config represents the configurations of storageclient APIs.
@@ -72,6 +84,19 @@ type StoreConfig struct {
 
 	// ETag represents the entity tag for optimistic consistency control.
 	ETag ETag
+
+	// CreateOnly represents that Save() only succeeds when the object does not already exist. ETag is ignored when
+	// CreateOnly is set.
+	CreateOnly bool
+
+	// ResumeToken represents the token returned by a previous watch event. The watch resumes after that event.
+	ResumeToken string
+
+	// PollingInterval represents the interval between queries when a watch is implemented by polling.
+	PollingInterval time.Duration
+
+	// PollingMaxObjects represents the maximum number of objects that a watch implemented by polling lists per query.
+	PollingMaxObjects int
 }
 
 // Query Options
@@ -150,6 +175,62 @@ func WithETag(etag ETag) MutatingOptions {
 	}
 }
 
+// WithCreateOnly sets the CreateOnly field in the StoreConfig struct. Save() returns ErrConcurrency if the object
+// already exists.
+func WithCreateOnly() SaveOptions {
+	return &saveOptions{
+		fn: func(cfg StoreConfig) StoreConfig {
+			cfg.CreateOnly = true
+			return cfg
+		},
+	}
+}
+
+// WatchOptions
+type watchOptions struct {
+	fn func(StoreConfig) StoreConfig
+}
+
+var _ WatchOptions = (*watchOptions)(nil)
+
+// ApplyWatchOption applies a watch option to a StoreConfig.
+func (w *watchOptions) ApplyWatchOption(cfg StoreConfig) StoreConfig {
+	return w.fn(cfg)
+}
+
+func (w watchOptions) private() {}
+
+// WithResumeToken sets the resume token for Watch().
+func WithResumeToken(token string) WatchOptions {
+	return &watchOptions{
+		fn: func(cfg StoreConfig) StoreConfig {
+			cfg.ResumeToken = token
+			return cfg
+		},
+	}
+}
+
+// WithPollingInterval sets the polling interval for Watch() when the storage client does not support watching natively.
+func WithPollingInterval(interval time.Duration) WatchOptions {
+	return &watchOptions{
+		fn: func(cfg StoreConfig) StoreConfig {
+			cfg.PollingInterval = interval
+			return cfg
+		},
+	}
+}
+
+// WithPollingMaxObjects sets the maximum number of objects that Watch() lists per query when the storage client does
+// not support watching natively.
+func WithPollingMaxObjects(max int) WatchOptions {
+	return &watchOptions{
+		fn: func(cfg StoreConfig) StoreConfig {
+			cfg.PollingMaxObjects = max
+			return cfg
+		},
+	}
+}
+
 // NewQueryConfig applies a set of QueryOptions to a StoreConfig and returns the modified StoreConfig for Query().
 func NewQueryConfig(opts ...QueryOptions) StoreConfig {
 	cfg := StoreConfig{}
@@ -176,3 +257,12 @@ func NewSaveConfig(opts ...SaveOptions) StoreConfig {
 	}
 	return cfg
 }
+
+// NewWatchConfig applies a set of WatchOptions to a StoreConfig and returns the modified StoreConfig for Watch().
+func NewWatchConfig(opts ...WatchOptions) StoreConfig {
+	cfg := StoreConfig{}
+	for _, opt := range opts {
+		cfg = opt.ApplyWatchOption(cfg)
+	}
+	return cfg
+}
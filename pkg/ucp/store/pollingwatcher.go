@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPollingInterval is the default interval between queries of the PollingWatcher.
+	DefaultPollingInterval = 5 * time.Second
+
+	// DefaultPollingMaxObjects is the default maximum number of objects that the PollingWatcher lists per query.
+	DefaultPollingMaxObjects = 1000
+)
+
+var _ Watcher = (*PollingWatcher)(nil)
+
+// PollingWatcher implements Watcher for storage clients that cannot stream changes natively. Changes are
+// detected by periodically querying the storage client and comparing the ETags of the results.
+//
+// Every poll lists all of the objects that match the query and keeps them in memory until the next poll, so the cost
+// of a watch grows with the size of the watched scope. Watches should use a narrow query, for example a single resource
+// type in a resource group. The watch fails with an error if the query matches more objects than the limit set with
+// WithPollingMaxObjects(), which is DefaultPollingMaxObjects by default.
+//
+// The resume tokens of the PollingWatcher are only used to detect that the watch is being resumed. Changes made while
+// no watch was running cannot be reconstructed, so a resumed watch reports every existing object as updated so that
+// the consumer can reconcile.
+type PollingWatcher struct {
+	client StorageClient
+}
+
+// NewPollingWatcher creates a new PollingWatcher for the given storage client.
+func NewPollingWatcher(client StorageClient) *PollingWatcher {
+	return &PollingWatcher{client: client}
+}
+
+// Watch streams the changes to objects that match the query until the context is cancelled.
+func (w *PollingWatcher) Watch(ctx context.Context, query Query, options ...WatchOptions) (<-chan WatchEvent, error) {
+	if err := ValidateWatchQuery(ctx, query); err != nil {
+		return nil, err
+	}
+
+	config := NewWatchConfig(options...)
+	interval := config.PollingInterval
+	if interval <= 0 {
+		interval = DefaultPollingInterval
+	}
+	maxObjects := config.PollingMaxObjects
+	if maxObjects <= 0 {
+		maxObjects = DefaultPollingMaxObjects
+	}
+
+	var generation uint64
+	if config.ResumeToken != "" {
+		var err error
+		generation, err = strconv.ParseUint(config.ResumeToken, 10, 64)
+		if err != nil {
+			return nil, &ErrInvalid{Message: "invalid argument. 'ResumeToken' is invalid"}
+		}
+	}
+
+	// Take the initial snapshot before returning so that any change made after Watch returns is observed.
+	snapshot, err := w.list(ctx, query, maxObjects)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan WatchEvent)
+	go func() {
+		defer close(ch)
+
+		send := func(eventType WatchEventType, obj Object) bool {
+			generation++
+			return SendWatchEvent(ctx, ch, WatchEvent{Type: eventType, Object: obj, ResumeToken: strconv.FormatUint(generation, 10)})
+		}
+
+		if config.ResumeToken != "" {
+			for _, key := range sortedKeys(snapshot) {
+				if !send(WatchEventUpdated, snapshot[key]) {
+					return
+				}
+			}
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			current, err := w.list(ctx, query, maxObjects)
+			if err != nil {
+				SendWatchError(ctx, ch, err)
+				return
+			}
+
+			for _, key := range sortedKeys(current) {
+				previous, ok := snapshot[key]
+				if !ok {
+					if !send(WatchEventCreated, current[key]) {
+						return
+					}
+				} else if previous.ETag != current[key].ETag {
+					if !send(WatchEventUpdated, current[key]) {
+						return
+					}
+				}
+			}
+
+			for _, key := range sortedKeys(snapshot) {
+				if _, ok := current[key]; !ok {
+					if !send(WatchEventDeleted, snapshot[key]) {
+						return
+					}
+				}
+			}
+
+			snapshot = current
+		}
+	}()
+
+	return ch, nil
+}
+
+// list returns all objects matching the query keyed by their case-insensitive id. An error is returned if the query
+// matches more than maxObjects objects.
+func (w *PollingWatcher) list(ctx context.Context, query Query, maxObjects int) (map[string]Object, error) {
+	results := map[string]Object{}
+	token := ""
+	for {
+		result, err := w.client.Query(ctx, query, WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			results[strings.ToLower(item.ID)] = item
+		}
+
+		if len(results) > maxObjects {
+			return nil, &ErrInvalid{Message: fmt.Sprintf("the watched query matches more than %d objects. Use a narrower query", maxObjects)}
+		}
+
+		if result.PaginationToken == "" {
+			return results, nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+func sortedKeys(objects map[string]Object) []string {
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
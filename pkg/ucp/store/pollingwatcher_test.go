@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+
+func newTestObject(etag string) Object {
+	return Object{Metadata: Metadata{ID: testResourceID, ETag: etag}, Data: map[string]any{}}
+}
+
+func receive(t *testing.T, events <-chan WatchEvent) WatchEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "timed out waiting for watch event")
+		return WatchEvent{}
+	}
+}
+
+func Test_PollingWatcher(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	ctrl := gomock.NewController(t)
+	client := NewMockStorageClient(ctrl)
+
+	query := Query{RootScope: "/planes/radius/local/resourceGroups/test-group"}
+	results := []*ObjectQueryResult{
+		{},
+		{Items: []Object{newTestObject("1")}},
+		{Items: []Object{newTestObject("2")}},
+		{},
+	}
+
+	client.EXPECT().
+		Query(gomock.Any(), query, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query Query, options ...QueryOptions) (*ObjectQueryResult, error) {
+			result := results[0]
+			if len(results) > 1 {
+				results = results[1:]
+			}
+			return result, nil
+		}).
+		AnyTimes()
+
+	events, err := NewPollingWatcher(client).Watch(ctx, query, WithPollingInterval(time.Millisecond))
+	require.NoError(t, err)
+
+	event := receive(t, events)
+	require.Equal(t, WatchEventCreated, event.Type)
+	require.Equal(t, "1", event.Object.ETag)
+	require.Equal(t, "1", event.ResumeToken)
+
+	event = receive(t, events)
+	require.Equal(t, WatchEventUpdated, event.Type)
+	require.Equal(t, "2", event.Object.ETag)
+	require.Equal(t, "2", event.ResumeToken)
+
+	event = receive(t, events)
+	require.Equal(t, WatchEventDeleted, event.Type)
+	require.Equal(t, testResourceID, event.Object.ID)
+	require.Equal(t, "3", event.ResumeToken)
+}
+
+func Test_PollingWatcher_Resume(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	ctrl := gomock.NewController(t)
+	client := NewMockStorageClient(ctrl)
+
+	query := Query{RootScope: "/planes/radius/local/resourceGroups/test-group"}
+	client.EXPECT().
+		Query(gomock.Any(), query, gomock.Any()).
+		Return(&ObjectQueryResult{Items: []Object{newTestObject("1")}}, nil).
+		AnyTimes()
+
+	events, err := NewPollingWatcher(client).Watch(ctx, query, WithResumeToken("5"))
+	require.NoError(t, err)
+
+	event := receive(t, events)
+	require.Equal(t, WatchEventUpdated, event.Type)
+	require.Equal(t, "6", event.ResumeToken)
+}
+
+func Test_PollingWatcher_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := NewMockStorageClient(ctrl)
+
+	_, err := NewPollingWatcher(client).Watch(context.Background(), Query{})
+	require.ErrorIs(t, err, &ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"})
+
+	_, err = NewPollingWatcher(client).Watch(context.Background(), Query{RootScope: "/planes"}, WithResumeToken("not-a-number"))
+	require.ErrorIs(t, err, &ErrInvalid{Message: "invalid argument. 'ResumeToken' is invalid"})
+}
+
+func Test_PollingWatcher_MaxObjects(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	ctrl := gomock.NewController(t)
+	client := NewMockStorageClient(ctrl)
+
+	query := Query{RootScope: "/planes/radius/local/resourceGroups/test-group"}
+	other := Object{Metadata: Metadata{ID: testResourceID + "-other", ETag: "1"}, Data: map[string]any{}}
+	results := []*ObjectQueryResult{
+		{Items: []Object{newTestObject("1")}},
+		{Items: []Object{newTestObject("1"), other}},
+	}
+
+	client.EXPECT().
+		Query(gomock.Any(), query, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query Query, options ...QueryOptions) (*ObjectQueryResult, error) {
+			result := results[0]
+			if len(results) > 1 {
+				results = results[1:]
+			}
+			return result, nil
+		}).
+		AnyTimes()
+
+	events, err := NewPollingWatcher(client).Watch(ctx, query, WithPollingInterval(time.Millisecond), WithPollingMaxObjects(1))
+	require.NoError(t, err)
+
+	event := receive(t, events)
+	require.Equal(t, WatchEventError, event.Type)
+	require.ErrorIs(t, event.Err, &ErrInvalid{Message: "the watched query matches more than 1 objects. Use a narrower query"})
+
+	_, ok := <-events
+	require.False(t, ok)
+
+	// The initial query fails the watch right away when it matches too many objects.
+	_, err = NewPollingWatcher(client).Watch(ctx, query, WithPollingMaxObjects(1))
+	require.ErrorIs(t, err, &ErrInvalid{Message: "the watched query matches more than 1 objects. Use a narrower query"})
+}
+
+func Test_Watch_FallsBackToPolling(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := NewMockStorageClient(ctrl)
+
+	// The mock does not implement Watcher, so Watch falls back to polling which does an initial query.
+	client.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ObjectQueryResult{}, nil).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := Watch(ctx, client, Query{RootScope: "/planes"})
+	require.NoError(t, err)
+
+	cancel()
+	_, ok := <-events
+	require.False(t, ok)
+}
@@ -0,0 +1,425 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package postgresstore stores resources using PostgreSQL. The layout of the data mirrors the etcd store:
+// every resource or scope is stored as a single row keyed by its storage key, with the storage parts
+// (prefix, root scope, routing scope and resource type) stored as separate columns so that queries can be
+// executed by the database rather than by client-side filtering.
+//
+// Keys are structured like the following example:
+//
+//	scope|/planes/radius/local/|/resourcegroups/cool-group/
+//	resource|/planes/radius/local/resourcegroups/cool-group/|/applications.core/applications/cool-app/
+//
+// ETags are derived from a database-wide sequence that is advanced on every write. This gives us the same
+// semantics as the etcd mod revision: a deleted and recreated resource never reuses an ETag.
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+)
+
+const (
+	// SectionSeparator is the separator used between the sections of a storage key.
+	SectionSeparator = "|"
+
+	// likeEscape is the escape character used for LIKE patterns.
+	likeEscape = `\`
+)
+
+const schema = `
+CREATE SEQUENCE IF NOT EXISTS resources_revision_seq;
+
+CREATE TABLE IF NOT EXISTS resources (
+	key           TEXT PRIMARY KEY,
+	kind          TEXT NOT NULL,
+	root_scope    TEXT NOT NULL,
+	routing_scope TEXT NOT NULL,
+	resource_type TEXT NOT NULL,
+	revision      BIGINT NOT NULL,
+	data          JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS resources_kind_root_scope_idx ON resources (kind, root_scope text_pattern_ops);
+`
+
+// NewPostgreSQLClient creates a new PostgreSQLClient instance with the given database handle.
+func NewPostgreSQLClient(db *sql.DB) *PostgreSQLClient {
+	return &PostgreSQLClient{db: db}
+}
+
+var _ store.StorageClient = (*PostgreSQLClient)(nil)
+
+// PostgreSQLClient implements store.StorageClient using PostgreSQL.
+type PostgreSQLClient struct {
+	db *sql.DB
+}
+
+// Init creates the tables, indexes and sequences used by the store if they do not already exist.
+func (c *PostgreSQLClient) Init(ctx context.Context) error {
+	_, err := c.db.ExecContext(ctx, schema)
+	return err
+}
+
+// Query retrieves objects from the store that match the given query and filters, and returns them in a store.ObjectQueryResult.
+// When MaxQueryItemCount is specified the result will contain at most that many items and a pagination token that can be used
+// to retrieve the next page.
+func (c *PostgreSQLClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	config := store.NewQueryConfig(options...)
+
+	after := ""
+	if config.PaginationToken != "" {
+		b, err := base64.StdEncoding.DecodeString(config.PaginationToken)
+		if err != nil {
+			return nil, &store.ErrInvalid{Message: "invalid argument. 'PaginationToken' is invalid"}
+		}
+		after = string(b)
+	}
+
+	statement, args := queryStatement(query, after)
+	rows, err := c.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := store.ObjectQueryResult{}
+	last := ""
+	for rows.Next() {
+		var key string
+		var revision int64
+		var data []byte
+		if err := rows.Scan(&key, &revision, &data); err != nil {
+			return nil, err
+		}
+
+		value := store.Object{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+
+		match, err := value.MatchesFilters(query.Filters)
+		if err != nil {
+			return nil, err
+		} else if !match {
+			continue
+		}
+
+		// We have found a matching item beyond the requested page size, so there is at least one more page.
+		if config.MaxQueryItemCount > 0 && len(results.Items) == config.MaxQueryItemCount {
+			results.PaginationToken = base64.StdEncoding.EncodeToString([]byte(last))
+			break
+		}
+
+		value.ETag = etag.NewFromRevision(revision)
+		results.Items = append(results.Items, value)
+		last = key
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+// Get checks if the provided context, id and options are valid, then retrieves the corresponding object from
+// the store and returns it, or an error if the object is not found or an error occurs.
+func (c *PostgreSQLClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var revision int64
+	var data []byte
+	row := c.db.QueryRowContext(ctx, "SELECT revision, data FROM resources WHERE key = $1", keyFromID(parsed))
+	err = row.Scan(&revision, &data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &store.ErrNotFound{ID: id}
+	} else if err != nil {
+		return nil, err
+	}
+
+	value := store.Object{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	value.ETag = etag.NewFromRevision(revision)
+
+	return &value, nil
+}
+
+// Delete checks if the given resource ID is valid, and if so, deletes it from the store, returning an error if the
+// resource does not exist or if an ETag is provided and does not match.
+func (c *PostgreSQLClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	return deleteKey(ctx, c.db, id, keyFromID(parsed), store.NewDeleteConfig(options...).ETag)
+}
+
+// Save checks the context and object parameters, parses the object ID, marshals the object into JSON, saves the object to
+// the store, and sets the object's ETag. If an ETag is provided, the write only succeeds when the stored revision matches.
+// If CreateOnly is set, the write only succeeds when the object does not exist.
+func (c *PostgreSQLClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	parsed, err := resources.Parse(obj.Metadata.ID)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	config := store.NewSaveConfig(options...)
+	var revision int64
+	if config.CreateOnly {
+		revision, err = create(ctx, c.db, parsed, b)
+	} else {
+		revision, err = save(ctx, c.db, parsed, b, config.ETag)
+	}
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = etag.NewFromRevision(revision)
+	return nil
+}
+
+// queryer is implemented by both *sql.DB and *sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// DB returns the sql.DB instance stored in the PostgreSQLClient struct.
+func (c *PostgreSQLClient) DB() *sql.DB {
+	return c.db
+}
+
+// deleteKey deletes the row for key using q, which is either the database or a transaction.
+func deleteKey(ctx context.Context, q queryer, id string, key string, ifMatch store.ETag) error {
+	// If we have an ETag then the delete only applies when the revision matches.
+	if ifMatch != "" {
+		revision, err := etag.ParseRevision(ifMatch)
+		if err != nil {
+			// Treat an invalid ETag as a concurrency failure, since it will never match.
+			return &store.ErrConcurrency{}
+		}
+
+		result, err := q.ExecContext(ctx, "DELETE FROM resources WHERE key = $1 AND revision = $2", key, revision)
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		// A missing resource will never match the ETag, this is a concurrency failure just like a mismatch.
+		if affected == 0 {
+			return &store.ErrConcurrency{}
+		}
+
+		return nil
+	}
+
+	result, err := q.ExecContext(ctx, "DELETE FROM resources WHERE key = $1", key)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return &store.ErrNotFound{ID: id}
+	}
+
+	return nil
+}
+
+// save upserts the row for the resource using q, which is either the database or a transaction, and returns the new
+// revision. b is the marshaled object.
+func save(ctx context.Context, q queryer, parsed resources.ID, b []byte, ifMatch store.ETag) (int64, error) {
+	prefix, rootScope, routingScope, resourceType := storeutil.ExtractStorageParts(parsed)
+	key := keyFromID(parsed)
+
+	var revision int64
+
+	// If we have an ETag then we only update the row when the revision matches.
+	if ifMatch != "" {
+		expected, err := etag.ParseRevision(ifMatch)
+		if err != nil {
+			// Treat an invalid ETag as a concurrency failure, since it will never match.
+			return 0, &store.ErrConcurrency{}
+		}
+
+		row := q.QueryRowContext(ctx,
+			"UPDATE resources SET data = $2, revision = nextval('resources_revision_seq') WHERE key = $1 AND revision = $3 RETURNING revision",
+			key, b, expected)
+		err = row.Scan(&revision)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, &store.ErrConcurrency{}
+		} else if err != nil {
+			return 0, err
+		}
+
+		return revision, nil
+	}
+
+	// If we don't have an ETag then this is an upsert.
+	row := q.QueryRowContext(ctx,
+		`INSERT INTO resources (key, kind, root_scope, routing_scope, resource_type, revision, data)
+		VALUES ($1, $2, $3, $4, $5, nextval('resources_revision_seq'), $6)
+		ON CONFLICT (key) DO UPDATE SET data = EXCLUDED.data, revision = EXCLUDED.revision
+		RETURNING revision`,
+		key, prefix, rootScope, routingScope, resourceType, b)
+	if err := row.Scan(&revision); err != nil {
+		return 0, err
+	}
+
+	return revision, nil
+}
+
+func parseID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// keyFromID returns the key to use for an ID. They key should be used as an exact match.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+}
+
+// queryStatement builds the SQL statement and arguments for a query. Results are ordered by key so that
+// the key of the last item returned can be used as the pagination token.
+func queryStatement(query store.Query, after string) (string, []any) {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	conditions := []string{"kind = $1"}
+	args := []any{prefix}
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	rootScope := storeutil.NormalizePart(query.RootScope)
+	if query.ScopeRecursive {
+		add(`root_scope LIKE $%d ESCAPE '`+likeEscape+`'`, escapeLike(rootScope)+"%")
+	} else {
+		add("root_scope = $%d", rootScope)
+	}
+
+	if query.RoutingScopePrefix != "" {
+		add(`routing_scope LIKE $%d ESCAPE '`+likeEscape+`'`, escapeLike(storeutil.NormalizePart(query.RoutingScopePrefix))+"%")
+	}
+
+	if query.ResourceType != "" {
+		add("resource_type = $%d", strings.ToLower(query.ResourceType))
+	}
+
+	if after != "" {
+		add("key > $%d", after)
+	}
+
+	return "SELECT key, revision, data FROM resources WHERE " + strings.Join(conditions, " AND ") + " ORDER BY key", args
+}
+
+// escapeLike escapes the special characters of a LIKE pattern so the value is matched literally.
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(likeEscape, likeEscape+likeEscape, "%", likeEscape+"%", "_", likeEscape+"_")
+	return replacer.Replace(value)
+}
+
+// create inserts the row for the object using q, which is either the database or a transaction. ErrConcurrency is
+// returned if the row already exists.
+func create(ctx context.Context, q queryer, parsed resources.ID, b []byte) (int64, error) {
+	prefix, rootScope, routingScope, resourceType := storeutil.ExtractStorageParts(parsed)
+
+	var revision int64
+	row := q.QueryRowContext(ctx,
+		`INSERT INTO resources (key, kind, root_scope, routing_scope, resource_type, revision, data)
+		VALUES ($1, $2, $3, $4, $5, nextval('resources_revision_seq'), $6)
+		ON CONFLICT (key) DO NOTHING
+		RETURNING revision`,
+		keyFromID(parsed), prefix, rootScope, routingScope, resourceType, b)
+	err := row.Scan(&revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, &store.ErrConcurrency{}
+	} else if err != nil {
+		return 0, err
+	}
+
+	return revision, nil
+}
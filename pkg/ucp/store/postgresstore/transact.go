@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresstore
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+)
+
+var _ store.Transactor = (*PostgreSQLClient)(nil)
+
+// Transact commits the operations in a single SQL transaction. The transaction is rolled back if any of the operations
+// fails, so either all of the writes are visible or none of them are.
+func (c *PostgreSQLClient) Transact(ctx context.Context, ops []store.Op) (err error) {
+	if err := store.ValidateOps(ctx, ops); err != nil {
+		return err
+	}
+
+	if len(ops) == 0 {
+		return nil
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	revisions := make([]int64, len(ops))
+	for i, op := range ops {
+		parsed, err := resources.Parse(op.ResourceID())
+		if err != nil {
+			return err
+		}
+
+		if op.Type == store.OpDelete {
+			if err := deleteKey(ctx, tx, op.ID, keyFromID(parsed), op.ETag); err != nil {
+				return err
+			}
+			continue
+		}
+
+		b, err := json.Marshal(op.Object)
+		if err != nil {
+			return err
+		}
+
+		revisions[i], err = save(ctx, tx, parsed, b, op.ETag)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	for i, op := range ops {
+		if op.Type == store.OpSave {
+			op.Object.ETag = etag.NewFromRevision(revisions[i])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// OpType represents the type of write performed by an Op.
+type OpType string
+
+const (
+	// OpSave saves an object, like Save().
+	OpSave OpType = "Save"
+
+	// OpDelete deletes an object, like Delete().
+	OpDelete OpType = "Delete"
+)
+
+// Op represents a single write that is part of a transaction passed to Transact().
+type Op struct {
+	// Type is the type of write.
+	Type OpType
+
+	// Object is the object to save. Object is only used for OpSave, and its ETag is updated when the
+	// transaction is committed.
+	Object *Object
+
+	// ID is the id of the object to delete. ID is only used for OpDelete.
+	ID string
+
+	// ETag is the optional ETag that the stored object must match for the transaction to be committed.
+	ETag ETag
+}
+
+// SaveOp returns an Op that saves the object as part of a transaction.
+func SaveOp(obj *Object, options ...SaveOptions) Op {
+	config := NewSaveConfig(options...)
+	return Op{Type: OpSave, Object: obj, ETag: config.ETag}
+}
+
+// DeleteOp returns an Op that deletes the object with the given id as part of a transaction.
+func DeleteOp(id string, options ...DeleteOptions) Op {
+	config := NewDeleteConfig(options...)
+	return Op{Type: OpDelete, ID: id, ETag: config.ETag}
+}
+
+// ResourceID returns the id of the object written by the Op.
+func (op Op) ResourceID() string {
+	if op.Type == OpSave && op.Object != nil {
+		return op.Object.ID
+	}
+
+	return op.ID
+}
+
+// Transactor is an optional interface implemented by storage clients that can commit several writes atomically. Use
+// the Transact() function rather than type-asserting a StorageClient to fall back to sequential writes automatically.
+type Transactor interface {
+	// Transact commits all of the operations or none of them. If the ETag of any operation does not match then
+	// ErrConcurrency is returned, and if an object to delete does not exist then ErrNotFound is returned.
+	Transact(ctx context.Context, ops []Op) error
+}
+
+// Transact commits the operations using the client. If the client implements Transactor then the operations are
+// committed atomically, otherwise they are applied one at a time in order and the first error is returned. Callers
+// that cannot tolerate a partial write should check for Transactor themselves.
+func Transact(ctx context.Context, client StorageClient, ops []Op) error {
+	if transactor, ok := client.(Transactor); ok {
+		return transactor.Transact(ctx, ops)
+	}
+
+	if err := ValidateOps(ctx, ops); err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		var err error
+		switch op.Type {
+		case OpSave:
+			err = client.Save(ctx, op.Object, WithETag(op.ETag))
+		case OpDelete:
+			err = client.Delete(ctx, op.ID, WithETag(op.ETag))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateOps validates the arguments to Transact(). This is shared by the Transactor implementations.
+func ValidateOps(ctx context.Context, ops []Op) error {
+	if ctx == nil {
+		return &ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	seen := map[string]bool{}
+	for _, op := range ops {
+		switch op.Type {
+		case OpSave:
+			if op.Object == nil {
+				return &ErrInvalid{Message: "invalid argument. 'op.Object' is required"}
+			}
+		case OpDelete:
+		default:
+			return &ErrInvalid{Message: "invalid argument. 'op.Type' must be Save or Delete"}
+		}
+
+		id, err := resources.Parse(op.ResourceID())
+		if err != nil {
+			return &ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+		}
+		if id.IsResourceCollection() || id.IsScopeCollection() {
+			return &ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+		}
+
+		// Every backend keys objects case-insensitively, so two operations on the same object would conflict.
+		key := strings.ToLower(id.String())
+		if seen[key] {
+			return &ErrInvalid{Message: "invalid argument. each object can only be written once in a transaction"}
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Transact_FallsBackToSequentialWrites(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	ctrl := gomock.NewController(t)
+	client := NewMockStorageClient(ctrl)
+
+	obj := newTestObject("")
+	otherID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/other-app"
+
+	gomock.InOrder(
+		client.EXPECT().
+			Save(gomock.Any(), &obj, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *Object, options ...SaveOptions) error {
+				require.Equal(t, "1", NewSaveConfig(options...).ETag)
+				return nil
+			}),
+		client.EXPECT().
+			Delete(gomock.Any(), otherID, gomock.Any()).
+			Return(errors.New("delete failed")),
+	)
+
+	err := Transact(ctx, client, []Op{SaveOp(&obj, WithETag("1")), DeleteOp(otherID)})
+	require.EqualError(t, err, "delete failed")
+}
+
+func Test_ValidateOps(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	obj := newTestObject("")
+	tests := []struct {
+		desc string
+		ops  []Op
+		err  error
+	}{
+		{
+			desc: "valid",
+			ops:  []Op{SaveOp(&obj), DeleteOp("/planes/radius/local/resourceGroups/test-group")},
+		},
+		{
+			desc: "missing_object",
+			ops:  []Op{{Type: OpSave}},
+			err:  &ErrInvalid{Message: "invalid argument. 'op.Object' is required"},
+		},
+		{
+			desc: "invalid_type",
+			ops:  []Op{{Type: "Patch", ID: testResourceID}},
+			err:  &ErrInvalid{Message: "invalid argument. 'op.Type' must be Save or Delete"},
+		},
+		{
+			desc: "invalid_id",
+			ops:  []Op{DeleteOp("not-an-id")},
+			err:  &ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"},
+		},
+		{
+			desc: "duplicate_id_different_case",
+			ops:  []Op{SaveOp(&obj), DeleteOp(strings.Replace(testResourceID, "test-app", "Test-App", 1))},
+			err:  &ErrInvalid{Message: "invalid argument. each object can only be written once in a transaction"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := ValidateOps(ctx, tt.ops)
+			if tt.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+)
+
+// WatchEventType represents the type of change reported by Watch().
+type WatchEventType string
+
+const (
+	// WatchEventCreated is reported when an object is created.
+	WatchEventCreated WatchEventType = "Created"
+
+	// WatchEventUpdated is reported when an object is updated.
+	WatchEventUpdated WatchEventType = "Updated"
+
+	// WatchEventDeleted is reported when an object is deleted.
+	WatchEventDeleted WatchEventType = "Deleted"
+
+	// WatchEventError is reported when the watch fails. The channel is closed after an error is reported.
+	WatchEventError WatchEventType = "Error"
+)
+
+// WatchEvent represents a single change reported by Watch().
+type WatchEvent struct {
+	// Type is the type of change.
+	Type WatchEventType
+
+	// Object is the object that changed. For a delete this is the last known state of the object when the
+	// storage client has it, otherwise only the ID is set.
+	Object Object
+
+	// ResumeToken can be passed to WithResumeToken() to start a new watch after this event. ResumeTokens are
+	// specific to the storage client that produced them.
+	ResumeToken string
+
+	// Err is the error that caused the watch to fail. Err is only set for WatchEventError.
+	Err error
+}
+
+// Watcher is an optional interface implemented by storage clients that can stream changes natively. Use
+// the Watch() function rather than type-asserting a StorageClient to fall back to polling automatically.
+type Watcher interface {
+	// Watch streams the changes to objects that match the query until the context is cancelled. The returned
+	// channel is closed when the watch ends.
+	//
+	// Watch only reports changes made after it is called, unless a resume token is provided with WithResumeToken().
+	Watch(ctx context.Context, query Query, options ...WatchOptions) (<-chan WatchEvent, error)
+}
+
+// Watch streams the changes to objects that match the query until the context is cancelled. If the client
+// implements Watcher then its native implementation is used, otherwise the client is polled.
+func Watch(ctx context.Context, client StorageClient, query Query, options ...WatchOptions) (<-chan WatchEvent, error) {
+	if watcher, ok := client.(Watcher); ok {
+		return watcher.Watch(ctx, query, options...)
+	}
+
+	return NewPollingWatcher(client).Watch(ctx, query, options...)
+}
+
+// ValidateWatchQuery validates the arguments to Watch(). This is shared by the Watcher implementations.
+func ValidateWatchQuery(ctx context.Context, query Query) error {
+	if ctx == nil {
+		return &ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return &ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return &ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	return nil
+}
+
+// SendWatchEvent sends an event to the channel unless the context is cancelled first. Returns false if the context
+// was cancelled.
+func SendWatchEvent(ctx context.Context, ch chan<- WatchEvent, event WatchEvent) bool {
+	select {
+	case ch <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// SendWatchError sends an error event to the channel unless the context is cancelled first. Cancellation of the context
+// is not reported as an error since it is the normal way to end a watch.
+func SendWatchError(ctx context.Context, ch chan<- WatchEvent, err error) {
+	if errors.Is(err, context.Canceled) || ctx.Err() != nil {
+		return
+	}
+
+	_ = SendWatchEvent(ctx, ch, WatchEvent{Type: WatchEventError, Err: err})
+}
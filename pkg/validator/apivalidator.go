@@ -25,6 +25,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
@@ -73,6 +74,12 @@ func APIValidator(options Options) func(h http.Handler) http.Handler {
 				return
 			}
 
+			// Skip validation for operation history requests.
+			if isOperationHistoryRoute(r) {
+				h.ServeHTTP(w, r)
+				return
+			}
+
 			if options.ResourceTypeGetter == nil {
 				panic("options.ResourceType must be specified")
 			}
@@ -130,6 +137,22 @@ func isCatchAllRoute(r *http.Request) bool {
 	return false
 }
 
+// isOperationHistoryRoute returns true if the request lists the operation history of a resource. The operation history
+// is served for every resource type and is not described in the OpenAPI spec of the resource types.
+func isOperationHistoryRoute(r *http.Request) bool {
+	if r.Method != http.MethodGet {
+		return false
+	}
+
+	id, err := resources.Parse(r.URL.Path)
+	if err != nil || !id.IsResourceCollection() {
+		return false
+	}
+
+	types := id.TypeSegments()
+	return len(types) > 1 && strings.EqualFold(types[len(types)-1].Type, audit.OperationsTypeSegment)
+}
+
 func invalidResourceIDResponse(id string) rest.Response {
 	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
 		Error: v1.ErrorDetails{
@@ -37,6 +37,7 @@ const (
 	resourceGroupResource             = "/resourceGroups/{resourceGroupName}"
 	environmentCollectionRoute        = "/providers/applications.core/environments"
 	environmentResourceRoute          = "/providers/applications.core/environments/{environmentName}"
+	extenderResourceRoute             = "/providers/applications.core/extenders/{extenderName}"
 	armResourceGroupScopedResourceURL = "http://localhost:8080/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/environments/env0"
 	ucpResourceGroupScopedResourceURL = "http://localhost:8080/planes/radius/local/resourceGroups/radius-test-rg/providers/applications.core/environments/env0"
 	longARMResourceURL                = "http://localhost:8080/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/environments/largeEnvName14161820222426283032343638404244464850525456586062646668707274767880828486889092949698100102104106108120122124126128130"
@@ -121,6 +122,50 @@ func runTest(t *testing.T, resourceIDUrl, targetScope, planeRootScope string, pr
 			responseCode:  http.StatusAccepted,
 			validationErr: nil,
 		},
+		{
+			desc:          "skip validation of operation history",
+			method:        http.MethodGet,
+			rootScope:     planeRootScope + resourceGroupResource,
+			route:         environmentResourceRoute + "/operations",
+			apiVersion:    "2023-10-01-preview",
+			url:           resourceIDUrl + "/operations",
+			responseCode:  http.StatusAccepted,
+			validationErr: nil,
+		},
+		{
+			desc:            "valid plan-recipe of extender",
+			method:          http.MethodPost,
+			rootScope:       planeRootScope + resourceGroupResource,
+			route:           extenderResourceRoute + "/planRecipe",
+			apiVersion:      "2023-10-01-preview",
+			contentFilePath: "post-extenders-planrecipe-valid.json",
+			url:             strings.Replace(resourceIDUrl, "environments/env0", "extenders/extender0", 1) + "/planRecipe",
+			responseCode:    http.StatusAccepted,
+			validationErr:   nil,
+		},
+		{
+			desc:            "invalid plan-recipe of extender with missing location",
+			method:          http.MethodPost,
+			rootScope:       planeRootScope + resourceGroupResource,
+			route:           extenderResourceRoute + "/planRecipe",
+			apiVersion:      "2023-10-01-preview",
+			contentFilePath: "post-extenders-planrecipe-invalid-missing-location.json",
+			url:             strings.Replace(resourceIDUrl, "environments/env0", "extenders/extender0", 1) + "/planRecipe",
+			responseCode:    http.StatusBadRequest,
+			validationErr: &v1.ErrorResponse{
+				Error: v1.ErrorDetails{
+					Code:    "HttpRequestPayloadAPISpecValidationFailed",
+					Target:  "applications.core/extenders",
+					Message: "HTTP request payload failed validation against API specification with one or more errors. Please see details for more information.",
+					Details: []v1.ErrorDetails{
+						{
+							Code:    "InvalidProperties",
+							Message: "$.location in $ is required",
+						},
+					},
+				},
+			},
+		},
 		{
 			desc:            "valid environment resource",
 			method:          http.MethodPut,
@@ -240,3 +240,153 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.Equal(t, msgCount, recvCnt)
 	})
 }
+
+// RunEnqueueOptionsTest tests that the client dequeues messages with a higher priority first and that the visibility
+// of a message can be delayed when it is enqueued.
+func RunEnqueueOptionsTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	t.Run("dequeue higher priority first", func(t *testing.T) {
+		clear(t)
+
+		err := cli.Enqueue(ctx, client.NewMessage(&testQueueMessage{ID: "low"}))
+		require.NoError(t, err)
+		err = cli.Enqueue(ctx, client.NewMessage(&testQueueMessage{ID: "high"}), client.WithPriority(10))
+		require.NoError(t, err)
+		err = cli.Enqueue(ctx, client.NewMessage(&testQueueMessage{ID: "low-2"}))
+		require.NoError(t, err)
+
+		for _, expected := range []string{"high", "low", "low-2"} {
+			msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+			require.NoError(t, err)
+
+			result := &testQueueMessage{}
+			err = json.Unmarshal(msg.Data, result)
+			require.NoError(t, err)
+			require.Equal(t, expected, result.ID)
+		}
+	})
+
+	t.Run("delayed message is invisible", func(t *testing.T) {
+		clear(t)
+
+		err := cli.Enqueue(ctx, client.NewMessage(&testQueueMessage{ID: "delayed"}), client.WithDelay(TestMessageLockTime))
+		require.NoError(t, err)
+
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		require.Eventually(t, func() bool {
+			_, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+			return err == nil
+		}, TestMessageLockTime*5, pollingInterval)
+	})
+}
+
+// RunDeadLetterTest tests the client's DeadLetterMessage, ListDeadLetterMessages, ReplayDeadLetterMessage and
+// DeleteDeadLetterMessage methods. The client must implement client.DeadLetterClient.
+func RunDeadLetterTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	dl, ok := cli.(client.DeadLetterClient)
+	require.True(t, ok, "client must implement DeadLetterClient")
+
+	t.Run("nil message", func(t *testing.T) {
+		err := dl.DeadLetterMessage(ctx, nil, "reason")
+		require.ErrorIs(t, err, client.ErrEmptyMessage)
+	})
+
+	t.Run("dead-lettered message is not dequeued", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = client.DeadLetter(ctx, cli, msg, "too many retries")
+		require.NoError(t, err)
+
+		// The message must not come back even after the lock expires.
+		time.Sleep(TestMessageLockTime + pollingInterval)
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		messages, err := dl.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, msg.ID, messages[0].ID)
+		require.Equal(t, msg.Data, messages[0].Data)
+		require.Equal(t, "too many retries", messages[0].DeadLetterReason)
+	})
+
+	t.Run("dead-letter message dequeued by other client", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		stale := *msg
+		stale.DequeueCount--
+		err = dl.DeadLetterMessage(ctx, &stale, "reason")
+		require.ErrorIs(t, err, client.ErrDequeuedMessage)
+	})
+
+	t.Run("replay dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = dl.DeadLetterMessage(ctx, msg, "reason")
+		require.NoError(t, err)
+
+		err = dl.ReplayDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+
+		messages, err := dl.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, messages)
+
+		replayed, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, msg.ID, replayed.ID)
+		require.Equal(t, 1, replayed.DequeueCount)
+		require.Empty(t, replayed.DeadLetterReason)
+
+		err = dl.ReplayDeadLetterMessage(ctx, msg.ID)
+		require.ErrorIs(t, err, client.ErrInvalidMessage)
+	})
+
+	t.Run("delete dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = dl.DeadLetterMessage(ctx, msg, "reason")
+		require.NoError(t, err)
+
+		err = dl.DeleteDeadLetterMessage(ctx, msg.ID)
+		require.NoError(t, err)
+
+		messages, err := dl.ListDeadLetterMessages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, messages)
+
+		err = dl.DeleteDeadLetterMessage(ctx, msg.ID)
+		require.ErrorIs(t, err, client.ErrInvalidMessage)
+	})
+}
@@ -18,8 +18,11 @@ limitations under the License.
 package storetest
 
 import (
+	"context"
 	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
@@ -287,6 +290,54 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 		require.Nil(t, obj1Get)
 	})
 
+	t.Run("save_create_only_can_create", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1, store.WithCreateOnly())
+		require.NoError(t, err)
+		require.NotEmpty(t, obj1.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+	})
+
+	t.Run("save_create_only_cannot_update", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource1ID, Data2)
+		err = client.Save(ctx, &obj2, store.WithCreateOnly())
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+	})
+
+	t.Run("save_create_only_can_create_after_delete", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		err = client.Delete(ctx, Resource1ID.String())
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource1ID, Data2)
+		err = client.Save(ctx, &obj2, store.WithCreateOnly())
+		require.NoError(t, err)
+
+		obj2Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj2, obj2Get)
+	})
+
 	t.Run("save_and_get_scope_only", func(t *testing.T) {
 		clear(t)
 
@@ -589,3 +640,210 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 		})
 	})
 }
+
+// RunWatchTest tests the Watch capability of the StorageClient by making changes to objects and checking the events
+// that are reported. Clients that do not implement store.Watcher are tested using the polling fallback.
+func RunWatchTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	// Used for the polling fallback, ignored by native implementations.
+	pollingInterval := store.WithPollingInterval(10 * time.Millisecond)
+
+	next := func(t *testing.T, events <-chan store.WatchEvent) store.WatchEvent {
+		t.Helper()
+
+		select {
+		case event, ok := <-events:
+			require.True(t, ok, "watch was closed unexpectedly")
+			require.NoError(t, event.Err)
+			return event
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "timed out waiting for watch event")
+			return store.WatchEvent{}
+		}
+	}
+
+	t.Run("watch_requires_root_scope", func(t *testing.T) {
+		_, err := store.Watch(ctx, client, store.Query{})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+
+	t.Run("watch_create_update_delete", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		defer watchCancel()
+
+		events, err := store.Watch(watchCtx, client, store.Query{RootScope: ResourceGroup1Scope}, pollingInterval)
+		require.NoError(t, err)
+
+		// This object doesn't match the query and should not be reported.
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event := next(t, events)
+		require.Equal(t, store.WatchEventCreated, event.Type)
+		require.NotEmpty(t, event.ResumeToken)
+		compareObjects(t, &obj1, &event.Object)
+
+		obj1.Data = Data2
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event = next(t, events)
+		require.Equal(t, store.WatchEventUpdated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+
+		err = client.Delete(ctx, Resource1ID.String())
+		require.NoError(t, err)
+
+		event = next(t, events)
+		require.Equal(t, store.WatchEventDeleted, event.Type)
+		require.True(t, strings.EqualFold(Resource1ID.String(), event.Object.ID))
+
+		watchCancel()
+		for range events {
+			// Drain the channel, it must be closed when the context is cancelled.
+		}
+	})
+
+	t.Run("watch_resume", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, watchCancel := context.WithCancel(ctx)
+
+		events, err := store.Watch(watchCtx, client, store.Query{RootScope: ResourceGroup1Scope}, pollingInterval)
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		event := next(t, events)
+		require.Equal(t, store.WatchEventCreated, event.Type)
+
+		watchCancel()
+		for range events {
+		}
+
+		// Make a change while nothing is watching.
+		obj1.Data = Data2
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		events, err = store.Watch(ctx, client, store.Query{RootScope: ResourceGroup1Scope}, pollingInterval, store.WithResumeToken(event.ResumeToken))
+		require.NoError(t, err)
+
+		event = next(t, events)
+		require.Equal(t, store.WatchEventUpdated, event.Type)
+		compareObjects(t, &obj1, &event.Object)
+	})
+}
+
+// RunTransactTest tests the Transact capability of the StorageClient by committing transactions and checking that
+// either all or none of their writes are applied. The client must implement store.Transactor.
+func RunTransactTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	_, ok := client.(store.Transactor)
+	require.True(t, ok, "client must implement store.Transactor")
+
+	t.Run("transact_invalid_duplicate", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := store.Transact(ctx, client, []store.Op{store.SaveOp(&obj1), store.DeleteOp(Resource1ID.String())})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+
+	t.Run("transact_save_multiple", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		nested1 := createObject(NestedResource1ID, NestedData1)
+		err := store.Transact(ctx, client, []store.Op{store.SaveOp(&obj1), store.SaveOp(&nested1)})
+		require.NoError(t, err)
+		require.NotEmpty(t, obj1.ETag)
+		require.NotEmpty(t, nested1.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+		require.Equal(t, obj1.ETag, obj1Get.ETag)
+
+		nested1Get, err := client.Get(ctx, NestedResource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &nested1, nested1Get)
+		require.Equal(t, nested1.ETag, nested1Get.ETag)
+	})
+
+	t.Run("transact_save_and_delete_with_etag", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		nested1 := createObject(NestedResource1ID, NestedData1)
+		err = client.Save(ctx, &nested1)
+		require.NoError(t, err)
+
+		obj1.Data = Data2
+		err = store.Transact(ctx, client, []store.Op{
+			store.SaveOp(&obj1, store.WithETag(obj1.ETag)),
+			store.DeleteOp(NestedResource1ID.String(), store.WithETag(nested1.ETag)),
+		})
+		require.NoError(t, err)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+
+		_, err = client.Get(ctx, NestedResource1ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: NestedResource1ID.String()})
+	})
+
+	t.Run("transact_etag_mismatch_rolls_back", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+		etag := obj1.ETag
+
+		// Update the object so the ETag no longer matches.
+		obj1.Data = Data2
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		updated := createObject(Resource1ID, Data1)
+		err = store.Transact(ctx, client, []store.Op{store.SaveOp(&obj2), store.SaveOp(&updated, store.WithETag(etag))})
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		_, err = client.Get(ctx, Resource2ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource2ID.String()})
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+	})
+
+	t.Run("transact_delete_not_found_rolls_back", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := store.Transact(ctx, client, []store.Op{store.SaveOp(&obj1), store.DeleteOp(Resource2ID.String())})
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource2ID.String()})
+
+		_, err = client.Get(ctx, Resource1ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource1ID.String()})
+	})
+}